@@ -0,0 +1,126 @@
+package api
+
+import (
+	"financial-bot/engine"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type accountBalance struct {
+	Name    string `json:"name"`
+	Balance int64  `json:"balance"`
+}
+
+// getBalance handles GET /balance: the total and the balance of every
+// account in the ledger.
+func (s *Server) getBalance(c *gin.Context) {
+	accounts, err := engine.AccountBalances(c.Request.Context(), s.db, ledgerID(c))
+	if err != nil {
+		internalError(c, "fetching balance", err)
+		return
+	}
+	balances := make([]accountBalance, 0, len(accounts))
+	var total int64
+	for _, b := range accounts {
+		balances = append(balances, accountBalance{Name: b.Name, Balance: b.Balance})
+		total += b.Balance
+	}
+	c.JSON(http.StatusOK, gin.H{"total": total, "accounts": balances})
+}
+
+// reportTotal is the income and expense of one category or member.
+// Expenses are negative, like the amounts they add up.
+type reportTotal struct {
+	Name    string `json:"name"`
+	Income  int64  `json:"income"`
+	Expense int64  `json:"expense"`
+}
+
+type report struct {
+	From       string        `json:"from"`
+	To         string        `json:"to"`
+	Income     int64         `json:"income"`
+	Expense    int64         `json:"expense"`
+	Net        int64         `json:"net"`
+	Categories []reportTotal `json:"categories"`
+	Members    []reportTotal `json:"members"`
+}
+
+// getReport handles GET /reports?from=YYYY-MM-DD&to=YYYY-MM-DD. Both dates
+// are included and default to the current month. Transfers are left out.
+func (s *Server) getReport(c *gin.Context) {
+	start, end, err := s.dateRange(c)
+	if err != nil {
+		fail(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	now := s.now().In(s.loc)
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, s.loc)
+	if start.IsZero() {
+		start = monthStart.UTC()
+	}
+	if end.IsZero() {
+		end = monthStart.AddDate(0, 1, 0).UTC()
+	}
+	if !start.Before(end) {
+		fail(c, http.StatusBadRequest, "from must not be after to")
+		return
+	}
+
+	r := report{
+		From: start.In(s.loc).Format("2006-01-02"),
+		To:   end.In(s.loc).AddDate(0, 0, -1).Format("2006-01-02"),
+	}
+	if r.Categories, err = s.reportTotals(c, `COALESCE(c.name, 'uncategorized')`, start, end); err != nil {
+		internalError(c, "fetching report", err)
+		return
+	}
+	// Members are grouped like the chat's member report
+	members, err := engine.MemberTotals(c.Request.Context(), s.db, ledgerID(c), start, end)
+	if err != nil {
+		internalError(c, "fetching report", err)
+		return
+	}
+	sort.Slice(members, func(i, j int) bool { return members[i].Name < members[j].Name })
+	r.Members = make([]reportTotal, 0, len(members))
+	for _, m := range members {
+		r.Members = append(r.Members, reportTotal{Name: m.Name, Income: m.Income, Expense: -m.Expense})
+	}
+	for _, ct := range r.Categories {
+		r.Income += ct.Income
+		r.Expense += ct.Expense
+	}
+	r.Net = r.Income + r.Expense
+	c.JSON(http.StatusOK, r)
+}
+
+// reportTotals sums income and expenses in [start, end) grouped by the SQL
+// expression group, ordered by name.
+func (s *Server) reportTotals(c *gin.Context, group string, start, end time.Time) ([]reportTotal, error) {
+	rows, err := s.db.QueryContext(c.Request.Context(), `
+		SELECT `+group+`,
+			COALESCE(SUM(CASE WHEN t.type = 'income' THEN t.amount END), 0),
+			COALESCE(SUM(CASE WHEN t.type = 'expense' THEN t.amount END), 0)
+		FROM transactions t
+		LEFT JOIN categories c ON c.id = t.category_id
+		WHERE t.ledger_id = ? AND t.type IN ('income', 'expense') AND t.created_at >= ? AND t.created_at < ?
+		GROUP BY 1
+		ORDER BY 1`, ledgerID(c), start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	totals := []reportTotal{}
+	for rows.Next() {
+		var t reportTotal
+		if err := rows.Scan(&t.Name, &t.Income, &t.Expense); err != nil {
+			return nil, err
+		}
+		totals = append(totals, t)
+	}
+	return totals, rows.Err()
+}
@@ -0,0 +1,180 @@
+// Package api serves the ledgers over HTTP so dashboards and scripts can
+// work on the same data as the chat bot. Every request must carry the
+// configured token as "Authorization: Bearer <token>", and works on the
+// ledger named by the ledger query parameter, the household ledger by
+// default.
+package api
+
+import (
+	"context"
+	"crypto/subtle"
+	"database/sql"
+	"errors"
+	"financial-bot/engine"
+	"financial-bot/models"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ledgerKey holds the ID of the request's ledger in the gin context.
+const ledgerKey = "ledger"
+
+// Server handles the HTTP API.
+type Server struct {
+	db             *sql.DB
+	now            func() time.Time
+	token          string
+	loc            *time.Location
+	defaultAccount string
+}
+
+// Option customises a Server created by New.
+type Option func(*Server)
+
+// WithLocation sets the household's timezone, in which report dates and
+// date filters are interpreted. The default is time.Local.
+func WithLocation(loc *time.Location) Option {
+	return func(s *Server) {
+		if loc != nil {
+			s.loc = loc
+		}
+	}
+}
+
+// WithDefaultAccount sets the account new transactions go to when the
+// request does not name one.
+func WithDefaultAccount(name string) Option {
+	return func(s *Server) {
+		if name != "" {
+			s.defaultAccount = strings.ToLower(name)
+		}
+	}
+}
+
+// New returns a Server backed by db that accepts requests bearing token.
+// now is the clock used for new transactions and default report periods;
+// nil means time.Now. Gin runs in release mode unless the GIN_MODE
+// environment variable asks for another.
+func New(db *sql.DB, now func() time.Time, token string, opts ...Option) *Server {
+	if now == nil {
+		now = time.Now
+	}
+	if os.Getenv(gin.EnvGinMode) == "" && gin.Mode() == gin.DebugMode {
+		gin.SetMode(gin.ReleaseMode)
+	}
+	s := &Server{db: db, now: now, token: token, loc: time.Local, defaultAccount: engine.DefaultAccountName}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Handler returns the router serving every endpoint.
+func (s *Server) Handler() http.Handler {
+	r := gin.New()
+	r.Use(gin.Logger(), gin.Recovery(), s.authenticate, s.selectLedger)
+
+	r.GET("/transactions", s.listTransactions)
+	r.POST("/transactions", s.createTransaction)
+	r.GET("/transactions/:id", s.getTransaction)
+	r.PUT("/transactions/:id", s.updateTransaction)
+	r.DELETE("/transactions/:id", s.deleteTransaction)
+	r.GET("/balance", s.getBalance)
+	r.GET("/reports", s.getReport)
+	return r
+}
+
+// ListenAndServe serves the API on addr until ctx is cancelled.
+func (s *Server) ListenAndServe(ctx context.Context, addr string) error {
+	srv := &http.Server{Addr: addr, Handler: s.Handler()}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			log.Println("Error shutting down HTTP server:", err)
+		}
+	}()
+
+	log.Println("HTTP API listening on", addr)
+	if err := srv.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// authenticate rejects requests without the configured bearer token. An
+// empty token locks the API entirely.
+func (s *Server) authenticate(c *gin.Context) {
+	token, ok := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+	if !ok || s.token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) != 1 {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid or missing token"})
+		return
+	}
+	c.Next()
+}
+
+// selectLedger looks up the ledger named by the ledger query parameter.
+func (s *Server) selectLedger(c *gin.Context) {
+	name := strings.ToLower(c.DefaultQuery("ledger", engine.DefaultLedgerName))
+	ledger, err := models.Ledgers(models.LedgerWhere.Name.EQ(name)).One(c.Request.Context(), s.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		fail(c, http.StatusNotFound, "unknown ledger "+name)
+		return
+	}
+	if err != nil {
+		internalError(c, "fetching ledger", err)
+		return
+	}
+	c.Set(ledgerKey, ledger.ID.Int64)
+	c.Next()
+}
+
+// ledgerID returns the ID of the ledger selected for the request.
+func ledgerID(c *gin.Context) int64 {
+	return c.GetInt64(ledgerKey)
+}
+
+// fail aborts the request with status and message.
+func fail(c *gin.Context, status int, message string) {
+	c.AbortWithStatusJSON(status, gin.H{"error": message})
+}
+
+// internalError logs err and aborts the request without exposing details.
+func internalError(c *gin.Context, action string, err error) {
+	log.Printf("Error %s: %v", action, err)
+	fail(c, http.StatusInternalServerError, "error "+action)
+}
+
+// parseDate reads a YYYY-MM-DD date as midnight in the household's
+// timezone.
+func (s *Server) parseDate(value string) (time.Time, error) {
+	return time.ParseInLocation("2006-01-02", value, s.loc)
+}
+
+// dateRange reads the inclusive from and to query parameters as the
+// half-open UTC range [start, end). A missing bound is returned as the
+// zero time.
+func (s *Server) dateRange(c *gin.Context) (start, end time.Time, err error) {
+	if from := c.Query("from"); from != "" {
+		if start, err = s.parseDate(from); err != nil {
+			return start, end, errors.New("from must be a YYYY-MM-DD date")
+		}
+		start = start.UTC()
+	}
+	if to := c.Query("to"); to != "" {
+		if end, err = s.parseDate(to); err != nil {
+			return start, end, errors.New("to must be a YYYY-MM-DD date")
+		}
+		end = end.AddDate(0, 0, 1).UTC()
+	}
+	if !start.IsZero() && !end.IsZero() && !start.Before(end) {
+		return start, end, errors.New("from must not be after to")
+	}
+	return start, end, nil
+}
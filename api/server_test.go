@@ -0,0 +1,256 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"financial-bot/database"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const testToken = "secret"
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+// newTestServer returns a handler on a fresh database with a "food"
+// category and a "bca" account, in Jakarta time.
+func newTestServer(t *testing.T, now time.Time) http.Handler {
+	t.Helper()
+	h, _ := newTestServerDB(t, now)
+	return h
+}
+
+// newTestServerDB is newTestServer that also returns the database, for
+// tests that need rows the API cannot create.
+func newTestServerDB(t *testing.T, now time.Time) (http.Handler, *sql.DB) {
+	t.Helper()
+	db, err := database.Open(filepath.Join(t.TempDir(), "app.db"))
+	if err != nil {
+		t.Fatalf("open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if _, err := db.Exec(`INSERT INTO categories (name) VALUES ('food'); INSERT INTO accounts (name) VALUES ('bca')`); err != nil {
+		t.Fatalf("seed database: %v", err)
+	}
+	return New(db, func() time.Time { return now }, testToken, WithLocation(time.FixedZone("WIB", 7*60*60))).Handler(), db
+}
+
+func do(t *testing.T, h http.Handler, method, path, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer "+testToken)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func decode(t *testing.T, rec *httptest.ResponseRecorder, v any) {
+	t.Helper()
+	if err := json.Unmarshal(rec.Body.Bytes(), v); err != nil {
+		t.Fatalf("decode %q: %v", rec.Body.String(), err)
+	}
+}
+
+func TestAuthentication(t *testing.T) {
+	h := newTestServer(t, time.Now())
+	for _, header := range []string{"", "Bearer wrong", "secret"} {
+		req := httptest.NewRequest(http.MethodGet, "/balance", nil)
+		if header != "" {
+			req.Header.Set("Authorization", header)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("Authorization %q: status %d, want 401", header, rec.Code)
+		}
+	}
+	if rec := do(t, h, http.MethodGet, "/balance", ""); rec.Code != http.StatusOK {
+		t.Errorf("valid token: status %d, want 200", rec.Code)
+	}
+}
+
+func TestTransactionsCRUD(t *testing.T) {
+	// 20:00 UTC on 30 September is 1 October in Jakarta
+	h := newTestServer(t, time.Date(2026, time.September, 30, 20, 0, 0, 0, time.UTC))
+
+	rec := do(t, h, http.MethodPost, "/transactions", `{"type":"expense","description":"bread","amount":25000,"category":"food"}`)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("create: status %d: %s", rec.Code, rec.Body)
+	}
+	var created transactionJSON
+	decode(t, rec, &created)
+	if created.Amount != -25000 || created.Account != "main" || created.Category != "food" || created.Sender != apiSender {
+		t.Errorf("unexpected transaction: %+v", created)
+	}
+	if got := created.CreatedAt.Format(time.RFC3339); got != "2026-10-01T03:00:00+07:00" {
+		t.Errorf("created_at = %s, want local time", got)
+	}
+
+	do(t, h, http.MethodPost, "/transactions", `{"type":"income","description":"salary","amount":1000000,"account":"bca"}`)
+
+	for _, tt := range []struct{ body, want string }{
+		{`{"type":"transfer","description":"x","amount":1}`, "type must be income or expense"},
+		{`{"type":"income","amount":1}`, "description is required"},
+		{`{"type":"income","description":"x","amount":-5}`, "amount must be positive"},
+		{`{"type":"income","description":"x","amount":5,"account":"ovo"}`, "unknown account ovo"},
+		{`{"type":"income","description":"x","amount":5,"category":"fun"}`, "unknown category fun"},
+	} {
+		if rec := do(t, h, http.MethodPost, "/transactions", tt.body); rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), tt.want) {
+			t.Errorf("POST %s: %d %s, want 400 %q", tt.body, rec.Code, rec.Body, tt.want)
+		}
+	}
+
+	rec = do(t, h, http.MethodPut, "/transactions/1", `{"amount":30000,"category":""}`)
+	var updated transactionJSON
+	decode(t, rec, &updated)
+	if rec.Code != http.StatusOK || updated.Amount != -30000 || updated.Category != "" || updated.Description != "bread" {
+		t.Errorf("update: %d %+v", rec.Code, updated)
+	}
+
+	rec = do(t, h, http.MethodGet, "/transactions?account=bca", "")
+	var list struct{ Transactions []transactionJSON }
+	decode(t, rec, &list)
+	if len(list.Transactions) != 1 || list.Transactions[0].Description != "salary" {
+		t.Errorf("filtered list: %+v", list)
+	}
+
+	rec = do(t, h, http.MethodGet, "/balance", "")
+	var balance struct {
+		Total    int64
+		Accounts []accountBalance
+	}
+	decode(t, rec, &balance)
+	if balance.Total != 970000 || len(balance.Accounts) != 2 {
+		t.Errorf("balance: %+v", balance)
+	}
+
+	if rec := do(t, h, http.MethodDelete, "/transactions/1", ""); rec.Code != http.StatusNoContent {
+		t.Errorf("delete: status %d", rec.Code)
+	}
+	if rec := do(t, h, http.MethodGet, "/transactions/1", ""); rec.Code != http.StatusNotFound {
+		t.Errorf("get deleted: status %d", rec.Code)
+	}
+}
+
+func TestUpdateKeepsSpecialTransactions(t *testing.T) {
+	h, db := newTestServerDB(t, time.Date(2026, time.September, 30, 20, 0, 0, 0, time.UTC))
+	if _, err := db.Exec(`INSERT INTO goals (ledger_id, name, target) VALUES (1, 'laptop', 5000000);
+		INSERT INTO transactions (type, description, amount, account_id, ledger_id, goal_id)
+		VALUES ('saving', 'save laptop', -500000, (SELECT id FROM accounts WHERE name = 'main'), 1, 1);
+		INSERT INTO counterparties (ledger_id, name) VALUES (1, 'budi');
+		INSERT INTO transactions (type, description, amount, account_id, ledger_id, counterparty_id)
+		VALUES ('repayment', 'repaid to budi', -200000, (SELECT id FROM accounts WHERE name = 'main'), 1, 1)`); err != nil {
+		t.Fatalf("seed database: %v", err)
+	}
+
+	for _, body := range []string{`{"description":"save new laptop"}`, `{"amount":600000}`} {
+		if rec := do(t, h, http.MethodPut, "/transactions/1", body); rec.Code != http.StatusConflict || !strings.Contains(rec.Body.String(), "savings can only be deleted") {
+			t.Errorf("PUT %s on a saving: %d %s, want 409", body, rec.Code, rec.Body)
+		}
+	}
+	if rec := do(t, h, http.MethodPut, "/transactions/2", `{"description":"repaid"}`); rec.Code != http.StatusConflict || !strings.Contains(rec.Body.String(), "loans and repayments can only be deleted") {
+		t.Errorf("PUT on a repayment: %d %s, want 409", rec.Code, rec.Body)
+	}
+
+	for id, want := range map[string]int64{"1": -500000, "2": -200000} {
+		var tx transactionJSON
+		decode(t, do(t, h, http.MethodGet, "/transactions/"+id, ""), &tx)
+		if tx.Amount != want {
+			t.Errorf("transaction %s changed: %+v", id, tx)
+		}
+	}
+}
+
+func TestReports(t *testing.T) {
+	h := newTestServer(t, time.Date(2026, time.October, 17, 3, 0, 0, 0, time.UTC))
+	for _, body := range []string{
+		`{"type":"income","description":"salary","amount":1000000,"created_at":"2026-10-01T00:30:00+07:00"}`,
+		`{"type":"expense","description":"bread","amount":25000,"category":"food"}`,
+		`{"type":"expense","description":"old","amount":5000,"created_at":"2026-09-30T23:30:00+07:00"}`,
+	} {
+		if rec := do(t, h, http.MethodPost, "/transactions", body); rec.Code != http.StatusCreated {
+			t.Fatalf("create: status %d: %s", rec.Code, rec.Body)
+		}
+	}
+
+	rec := do(t, h, http.MethodGet, "/reports", "")
+	var r report
+	decode(t, rec, &r)
+	if r.From != "2026-10-01" || r.To != "2026-10-31" || r.Income != 1000000 || r.Expense != -25000 || r.Net != 975000 {
+		t.Errorf("this month: %+v", r)
+	}
+	if len(r.Categories) != 2 || r.Categories[0] != (reportTotal{Name: "food", Expense: -25000}) {
+		t.Errorf("categories: %+v", r.Categories)
+	}
+	if len(r.Members) != 1 || r.Members[0].Name != "API" {
+		t.Errorf("members: %+v", r.Members)
+	}
+
+	rec = do(t, h, http.MethodGet, "/reports?from=2026-09-30&to=2026-09-30", "")
+	decode(t, rec, &r)
+	if r.Expense != -5000 || r.Income != 0 {
+		t.Errorf("30 September: %+v", r)
+	}
+
+	if rec := do(t, h, http.MethodGet, "/reports?from=2026-10-02&to=2026-10-01", ""); rec.Code != http.StatusBadRequest {
+		t.Errorf("reversed range: status %d", rec.Code)
+	}
+}
+
+func TestReportMembers(t *testing.T) {
+	h, db := newTestServerDB(t, time.Date(2026, time.October, 17, 3, 0, 0, 0, time.UTC))
+	// Budi wrote from two devices and changed his profile name in between
+	if _, err := db.Exec(`INSERT INTO transactions (type, description, amount, account_id, ledger_id, sender, sender_name, created_at) VALUES
+		('expense', 'fuel', -100000, (SELECT id FROM accounts WHERE name = 'main'), 1, '6281111@s.whatsapp.net', 'Budi', '2026-10-10 00:00:00+00:00'),
+		('income', 'salary', 1000000, (SELECT id FROM accounts WHERE name = 'main'), 1, '6281111:7@s.whatsapp.net', 'Pak Budi', '2026-10-11 00:00:00+00:00')`); err != nil {
+		t.Fatalf("seed database: %v", err)
+	}
+
+	var r report
+	decode(t, do(t, h, http.MethodGet, "/reports", ""), &r)
+	if len(r.Members) != 1 || r.Members[0] != (reportTotal{Name: "Pak Budi", Income: 1000000, Expense: -100000}) {
+		t.Errorf("members: %+v", r.Members)
+	}
+}
+
+func TestLedgerParameter(t *testing.T) {
+	db, err := database.Open(filepath.Join(t.TempDir(), "app.db"))
+	if err != nil {
+		t.Fatalf("open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if _, err := db.Exec(`INSERT INTO ledgers (name) VALUES ('trip')`); err != nil {
+		t.Fatalf("seed database: %v", err)
+	}
+	h := New(db, time.Now, testToken).Handler()
+
+	do(t, h, http.MethodPost, "/transactions", `{"type":"income","description":"salary","amount":1000000}`)
+	if rec := do(t, h, http.MethodPost, "/transactions?ledger=trip", `{"type":"expense","description":"hotel","amount":300000}`); rec.Code != http.StatusCreated {
+		t.Fatalf("create in trip: status %d: %s", rec.Code, rec.Body)
+	}
+
+	var balance struct{ Total int64 }
+	decode(t, do(t, h, http.MethodGet, "/balance?ledger=trip", ""), &balance)
+	if balance.Total != -300000 {
+		t.Errorf("trip balance = %d, want -300000", balance.Total)
+	}
+	decode(t, do(t, h, http.MethodGet, "/balance", ""), &balance)
+	if balance.Total != 1000000 {
+		t.Errorf("household balance = %d, want 1000000", balance.Total)
+	}
+	if rec := do(t, h, http.MethodGet, "/transactions/2", ""); rec.Code != http.StatusNotFound {
+		t.Errorf("trip transaction from the household ledger: status %d, want 404", rec.Code)
+	}
+	if rec := do(t, h, http.MethodGet, "/balance?ledger=shop", ""); rec.Code != http.StatusNotFound {
+		t.Errorf("unknown ledger: status %d, want 404", rec.Code)
+	}
+}
@@ -0,0 +1,388 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"financial-bot/models"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultLimit = 100
+	maxLimit     = 1000
+
+	// apiSender is recorded as the sender of transactions created over HTTP.
+	apiSender = "api"
+)
+
+// transactionJSON is a transaction as returned by the API. Amount is signed:
+// expenses and outgoing transfers are negative.
+type transactionJSON struct {
+	ID          int64     `json:"id"`
+	Type        string    `json:"type"`
+	Description string    `json:"description"`
+	Amount      int64     `json:"amount"`
+	Account     string    `json:"account"`
+	Category    string    `json:"category,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	Sender      string    `json:"sender,omitempty"`
+	SenderName  string    `json:"sender_name,omitempty"`
+}
+
+// transactionRequest is the body of POST and PUT /transactions. Amount is
+// always positive; the type decides its sign. Fields left out of a PUT keep
+// their current value, and an empty category removes the category.
+type transactionRequest struct {
+	Type        *string    `json:"type"`
+	Description *string    `json:"description"`
+	Amount      *int64     `json:"amount"`
+	Account     *string    `json:"account"`
+	Category    *string    `json:"category"`
+	CreatedAt   *time.Time `json:"created_at"`
+}
+
+// names maps account and category IDs to their names.
+type names struct {
+	accounts, categories map[int64]string
+}
+
+func (s *Server) loadNames(c *gin.Context) (names, error) {
+	n := names{accounts: map[int64]string{}, categories: map[int64]string{}}
+	accounts, err := models.Accounts().All(c.Request.Context(), s.db)
+	if err != nil {
+		return n, err
+	}
+	for _, a := range accounts {
+		n.accounts[a.ID.Int64] = a.Name
+	}
+	categories, err := models.Categories().All(c.Request.Context(), s.db)
+	if err != nil {
+		return n, err
+	}
+	for _, cat := range categories {
+		n.categories[cat.ID.Int64] = cat.Name
+	}
+	return n, nil
+}
+
+func (s *Server) toJSON(tx *models.Transaction, n names) transactionJSON {
+	return transactionJSON{
+		ID:          tx.ID.Int64,
+		Type:        tx.Type,
+		Description: tx.Description.String,
+		Amount:      tx.Amount,
+		Account:     n.accounts[tx.AccountID],
+		Category:    n.categories[tx.CategoryID.Int64],
+		CreatedAt:   tx.CreatedAt.In(s.loc),
+		Sender:      tx.Sender.String,
+		SenderName:  tx.SenderName.String,
+	}
+}
+
+// listTransactions handles GET /transactions, newest first. It accepts the
+// from, to, type, account, category, limit and offset query parameters.
+func (s *Server) listTransactions(c *gin.Context) {
+	start, end, err := s.dateRange(c)
+	if err != nil {
+		fail(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	limit, err := queryInt(c, "limit", defaultLimit)
+	if err != nil || limit < 1 || limit > maxLimit {
+		fail(c, http.StatusBadRequest, "limit must be between 1 and "+strconv.Itoa(maxLimit))
+		return
+	}
+	offset, err := queryInt(c, "offset", 0)
+	if err != nil || offset < 0 {
+		fail(c, http.StatusBadRequest, "offset must not be negative")
+		return
+	}
+
+	mods := []qm.QueryMod{
+		models.TransactionWhere.LedgerID.EQ(ledgerID(c)),
+		qm.OrderBy("created_at DESC, id DESC"), qm.Limit(limit), qm.Offset(offset),
+	}
+	if !start.IsZero() {
+		mods = append(mods, models.TransactionWhere.CreatedAt.GTE(start))
+	}
+	if !end.IsZero() {
+		mods = append(mods, models.TransactionWhere.CreatedAt.LT(end))
+	}
+	if txType := c.Query("type"); txType != "" {
+		mods = append(mods, models.TransactionWhere.Type.EQ(txType))
+	}
+	if name := c.Query("account"); name != "" {
+		account, err := models.Accounts(models.AccountWhere.Name.EQ(strings.ToLower(name))).One(c.Request.Context(), s.db)
+		if errors.Is(err, sql.ErrNoRows) {
+			fail(c, http.StatusBadRequest, "unknown account "+name)
+			return
+		}
+		if err != nil {
+			internalError(c, "fetching account", err)
+			return
+		}
+		mods = append(mods, models.TransactionWhere.AccountID.EQ(account.ID.Int64))
+	}
+	if name := c.Query("category"); name != "" {
+		category, err := models.Categories(models.CategoryWhere.Name.EQ(strings.ToLower(name))).One(c.Request.Context(), s.db)
+		if errors.Is(err, sql.ErrNoRows) {
+			fail(c, http.StatusBadRequest, "unknown category "+name)
+			return
+		}
+		if err != nil {
+			internalError(c, "fetching category", err)
+			return
+		}
+		mods = append(mods, models.TransactionWhere.CategoryID.EQ(category.ID))
+	}
+
+	transactions, err := models.Transactions(mods...).All(c.Request.Context(), s.db)
+	if err != nil {
+		internalError(c, "fetching transactions", err)
+		return
+	}
+	n, err := s.loadNames(c)
+	if err != nil {
+		internalError(c, "fetching transactions", err)
+		return
+	}
+
+	result := make([]transactionJSON, 0, len(transactions))
+	for _, tx := range transactions {
+		result = append(result, s.toJSON(tx, n))
+	}
+	c.JSON(http.StatusOK, gin.H{"transactions": result})
+}
+
+// getTransaction handles GET /transactions/:id.
+func (s *Server) getTransaction(c *gin.Context) {
+	tx := s.findTransaction(c)
+	if tx == nil {
+		return
+	}
+	s.respondTransaction(c, http.StatusOK, tx)
+}
+
+// createTransaction handles POST /transactions for income and expenses.
+// Transfers are recorded from the chat, which keeps both legs together.
+func (s *Server) createTransaction(c *gin.Context) {
+	var req transactionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		fail(c, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+	if req.Type == nil || (*req.Type != "income" && *req.Type != "expense") {
+		fail(c, http.StatusBadRequest, "type must be income or expense")
+		return
+	}
+	if req.Description == nil || strings.TrimSpace(*req.Description) == "" {
+		fail(c, http.StatusBadRequest, "description is required")
+		return
+	}
+	if req.Amount == nil {
+		fail(c, http.StatusBadRequest, "amount is required")
+		return
+	}
+	if req.Account == nil {
+		req.Account = &s.defaultAccount
+	}
+
+	tx := &models.Transaction{
+		CreatedAt:  s.now().UTC(),
+		LedgerID:   ledgerID(c),
+		Sender:     null.StringFrom(apiSender),
+		SenderName: null.StringFrom("API"),
+	}
+	if !s.apply(c, tx, req) {
+		return
+	}
+	if err := tx.Insert(c.Request.Context(), s.db, boil.Infer()); err != nil {
+		internalError(c, "saving transaction", err)
+		return
+	}
+	s.respondTransaction(c, http.StatusCreated, tx)
+}
+
+// updateTransaction handles PUT /transactions/:id. Only income and expenses
+// can be changed; transfers, savings, loans and repayments can only be
+// deleted, since their sign means something the request cannot express.
+func (s *Server) updateTransaction(c *gin.Context) {
+	var req transactionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		fail(c, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+
+	tx := s.findTransaction(c)
+	if tx == nil {
+		return
+	}
+	switch tx.Type {
+	case "transfer":
+		fail(c, http.StatusConflict, "transfers can only be deleted")
+		return
+	case "saving":
+		fail(c, http.StatusConflict, "savings can only be deleted")
+		return
+	case "loan", "repayment":
+		fail(c, http.StatusConflict, "loans and repayments can only be deleted")
+		return
+	}
+	if req.Type != nil && *req.Type != "income" && *req.Type != "expense" {
+		fail(c, http.StatusBadRequest, "type must be income or expense")
+		return
+	}
+	if req.Description != nil && strings.TrimSpace(*req.Description) == "" {
+		fail(c, http.StatusBadRequest, "description must not be empty")
+		return
+	}
+	if !s.apply(c, tx, req) {
+		return
+	}
+	if _, err := tx.Update(c.Request.Context(), s.db, boil.Infer()); err != nil {
+		internalError(c, "updating transaction", err)
+		return
+	}
+	s.respondTransaction(c, http.StatusOK, tx)
+}
+
+// deleteTransaction handles DELETE /transactions/:id. Deleting either leg
+// of a transfer deletes both.
+func (s *Server) deleteTransaction(c *gin.Context) {
+	tx := s.findTransaction(c)
+	if tx == nil {
+		return
+	}
+
+	transactions := models.TransactionSlice{tx}
+	if tx.Type == "transfer" && tx.BatchID.Valid {
+		var err error
+		transactions, err = models.Transactions(models.TransactionWhere.BatchID.EQ(tx.BatchID)).All(c.Request.Context(), s.db)
+		if err != nil {
+			internalError(c, "deleting transaction", err)
+			return
+		}
+	}
+
+	dbTx, err := s.db.BeginTx(c.Request.Context(), nil)
+	if err != nil {
+		internalError(c, "deleting transaction", err)
+		return
+	}
+	defer dbTx.Rollback()
+	if _, err := transactions.DeleteAll(c.Request.Context(), dbTx); err != nil {
+		internalError(c, "deleting transaction", err)
+		return
+	}
+	if err := dbTx.Commit(); err != nil {
+		internalError(c, "deleting transaction", err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// apply copies the fields set in req onto tx, resolving account and
+// category names. It reports false after aborting the request when a
+// field is invalid.
+func (s *Server) apply(c *gin.Context, tx *models.Transaction, req transactionRequest) bool {
+	if req.Type != nil {
+		tx.Type = *req.Type
+	}
+	if req.Description != nil {
+		tx.Description = null.StringFrom(strings.TrimSpace(*req.Description))
+	}
+	if req.Amount != nil {
+		if *req.Amount <= 0 {
+			fail(c, http.StatusBadRequest, "amount must be positive")
+			return false
+		}
+		tx.Amount = *req.Amount
+	}
+	// Keep the sign in line with the type, which may have changed
+	if (tx.Type == "expense") != (tx.Amount < 0) {
+		tx.Amount = -tx.Amount
+	}
+
+	if req.Account != nil {
+		name := strings.ToLower(strings.TrimSpace(*req.Account))
+		account, err := models.Accounts(models.AccountWhere.Name.EQ(name)).One(c.Request.Context(), s.db)
+		if errors.Is(err, sql.ErrNoRows) {
+			fail(c, http.StatusBadRequest, "unknown account "+name)
+			return false
+		}
+		if err != nil {
+			internalError(c, "fetching account", err)
+			return false
+		}
+		tx.AccountID = account.ID.Int64
+	}
+	if req.Category != nil {
+		name := strings.ToLower(strings.TrimSpace(*req.Category))
+		if name == "" {
+			tx.CategoryID = null.Int64{}
+		} else {
+			category, err := models.Categories(models.CategoryWhere.Name.EQ(name)).One(c.Request.Context(), s.db)
+			if errors.Is(err, sql.ErrNoRows) {
+				fail(c, http.StatusBadRequest, "unknown category "+name)
+				return false
+			}
+			if err != nil {
+				internalError(c, "fetching category", err)
+				return false
+			}
+			tx.CategoryID = category.ID
+		}
+	}
+	if req.CreatedAt != nil {
+		tx.CreatedAt = req.CreatedAt.UTC()
+	}
+	return true
+}
+
+// findTransaction loads the transaction named by the :id parameter from the
+// request's ledger. When it cannot, it aborts the request and returns nil.
+func (s *Server) findTransaction(c *gin.Context) *models.Transaction {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		fail(c, http.StatusBadRequest, "invalid transaction id")
+		return nil
+	}
+	tx, err := models.Transactions(
+		models.TransactionWhere.ID.EQ(null.Int64From(id)),
+		models.TransactionWhere.LedgerID.EQ(ledgerID(c)),
+	).One(c.Request.Context(), s.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		fail(c, http.StatusNotFound, "transaction not found")
+		return nil
+	}
+	if err != nil {
+		internalError(c, "fetching transaction", err)
+		return nil
+	}
+	return tx
+}
+
+func (s *Server) respondTransaction(c *gin.Context, status int, tx *models.Transaction) {
+	n, err := s.loadNames(c)
+	if err != nil {
+		internalError(c, "fetching transaction", err)
+		return
+	}
+	c.JSON(status, s.toJSON(tx, n))
+}
+
+func queryInt(c *gin.Context, key string, def int) (int, error) {
+	value := c.Query(key)
+	if value == "" {
+		return def, nil
+	}
+	return strconv.Atoi(value)
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"context"
+	"financial-bot/engine"
+	"log"
+	"slices"
+	"sync"
+	"time"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// catchUpTimeout is how long after connecting messages are held back when
+// WhatsApp never reports that it delivered every missed message.
+const catchUpTimeout = 2 * time.Minute
+
+// catchUp holds back the messages WhatsApp delivers right after connecting,
+// which were sent while the bot was offline, until all of them arrived.
+// They are then handled together, and each chat gets one summary instead
+// of a reply to every message.
+var catchUp struct {
+	sync.Mutex
+	active bool
+	// since is when the last message processed before going offline was
+	// sent, or zero on a fresh database
+	since    time.Time
+	commands []engine.Command
+	timer    *time.Timer
+}
+
+// startCatchUp holds back messages from now on, until the missed messages
+// have been delivered after the next connect.
+func startCatchUp() {
+	since, err := bot.LastProcessed(context.Background())
+	if err != nil {
+		log.Println("Error fetching last processed message:", err)
+	}
+	catchUp.Lock()
+	defer catchUp.Unlock()
+	if !catchUp.active {
+		catchUp.active, catchUp.since = true, since
+	}
+}
+
+// armCatchUp ends the catch-up after catchUpTimeout, in case WhatsApp does
+// not report the end of the missed messages.
+func armCatchUp() {
+	catchUp.Lock()
+	defer catchUp.Unlock()
+	if catchUp.timer != nil {
+		catchUp.timer.Stop()
+	}
+	catchUp.timer = time.AfterFunc(catchUpTimeout, finishCatchUp)
+}
+
+// holdBack keeps cmd for the catch-up, if one is in progress, and reports
+// whether it did.
+func holdBack(cmd engine.Command) bool {
+	catchUp.Lock()
+	defer catchUp.Unlock()
+	if catchUp.active {
+		catchUp.commands = append(catchUp.commands, cmd)
+	}
+	return catchUp.active
+}
+
+// amendHeldBack applies an edit or deletion of a message that is held back
+// to the held command, and reports whether there was one.
+func amendHeldBack(protocol *waProto.ProtocolMessage) bool {
+	catchUp.Lock()
+	defer catchUp.Unlock()
+	i := slices.IndexFunc(catchUp.commands, func(cmd engine.Command) bool {
+		return cmd.MessageID == protocol.GetKey().GetID()
+	})
+	if !catchUp.active || i < 0 {
+		return false
+	}
+	switch protocol.GetType() {
+	case waProto.ProtocolMessage_MESSAGE_EDIT:
+		catchUp.commands[i].Text, catchUp.commands[i].QuotedID = messageText(protocol.GetEditedMessage())
+	case waProto.ProtocolMessage_REVOKE:
+		catchUp.commands = slices.Delete(catchUp.commands, i, i+1)
+	}
+	return true
+}
+
+// finishCatchUp handles the messages held back and posts the summaries.
+func finishCatchUp() {
+	catchUp.Lock()
+	if !catchUp.active {
+		catchUp.Unlock()
+		return
+	}
+	commands := catchUp.commands
+	catchUp.active, catchUp.commands = false, nil
+	if catchUp.timer != nil {
+		catchUp.timer.Stop()
+	}
+	catchUp.Unlock()
+
+	log.Printf("Caught up on %d messages sent while offline", len(commands))
+	for _, notice := range bot.CatchUp(context.Background(), commands) {
+		deliverNotice(notice)
+	}
+}
+
+// handleHistorySync catches up on the messages of a history sync that were
+// sent after the last message processed before going offline. Older
+// history, and all of it on a fresh database, is left alone; as with live
+// messages, access control decides which chats and senders are heard.
+func handleHistorySync(evt *events.HistorySync) {
+	ctx := context.Background()
+	catchUp.Lock()
+	since := catchUp.since
+	catchUp.Unlock()
+	if since.IsZero() {
+		return
+	}
+
+	var commands []engine.Command
+	for _, conversation := range evt.Data.GetConversations() {
+		chat, err := types.ParseJID(conversation.GetID())
+		if err != nil {
+			log.Printf("Invalid chat %q in history sync: %v", conversation.GetID(), err)
+			continue
+		}
+		for _, item := range conversation.GetMessages() {
+			msg, err := client.ParseWebMessage(chat, item.GetMessage())
+			if err != nil {
+				log.Println("Error parsing history sync message:", err)
+				continue
+			}
+			// The bot's own replies are in the history too
+			if msg.Info.IsFromMe || !msg.Info.Timestamp.After(since) {
+				continue
+			}
+			if cmd, ok := commandOf(ctx, msg); ok {
+				commands = append(commands, cmd)
+			}
+		}
+	}
+	if len(commands) == 0 {
+		return
+	}
+	log.Printf("Catching up on %d messages from history sync", len(commands))
+	for _, notice := range bot.CatchUp(ctx, commands) {
+		deliverNotice(notice)
+	}
+}
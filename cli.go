@@ -0,0 +1,146 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"financial-bot/config"
+	"financial-bot/database"
+	"financial-bot/engine"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// subcommands run instead of the bot when named as the first argument.
+// They work on the database directly without connecting to WhatsApp.
+var subcommands = map[string]func(args []string) error{
+	"export": runExport,
+	"import": runImport,
+}
+
+// openDatabase loads the config and opens the finance database it names.
+func openDatabase() (*config.Config, *sql.DB, error) {
+	cfg, err := config.Load(os.Getenv("CONFIG_FILE"))
+	if err != nil {
+		return nil, nil, err
+	}
+	db, err := database.Open(cfg.Database.Path)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cfg, db, nil
+}
+
+// parseFlags parses args with flags, reporting -h as success.
+func parseFlags(flags *flag.FlagSet, args []string) (help bool, err error) {
+	err = flags.Parse(args)
+	if errors.Is(err, flag.ErrHelp) {
+		return true, nil
+	}
+	return false, err
+}
+
+// runExport implements
+//
+//	financial-bot export [-ledger name] [-format csv|xlsx] [-o file] [period]
+//
+// which writes the period's transactions to a file. The period is anything
+// the chat accepts, such as 2026-09 or "last month", and defaults to the
+// current month.
+func runExport(args []string) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	ledger := flags.String("ledger", "", "ledger to export (default the household ledger)")
+	format := flags.String("format", "csv", "file format: csv or xlsx")
+	output := flags.String("o", "", "file to write (default a name based on the ledger and period)")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: financial-bot export [flags] [period]")
+		flags.PrintDefaults()
+	}
+	if help, err := parseFlags(flags, args); help || err != nil {
+		return err
+	}
+
+	cfg, db, err := openDatabase()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	doc, count, err := newEngine(cfg, db).Export(context.Background(), *ledger, strings.Join(flags.Args(), " "), *format)
+	if err != nil {
+		return err
+	}
+
+	path := *output
+	if path == "" {
+		path = doc.FileName
+	}
+	if err := os.WriteFile(path, doc.Data, 0o644); err != nil {
+		return err
+	}
+	fmt.Printf("Wrote %d transactions to %s\n", count, path)
+	return nil
+}
+
+// runImport implements
+//
+//	financial-bot import -bank bca|mandiri|generic|... [-ledger name] [-account name] [-dry-run] file.csv
+//
+// which records the transactions of a bank statement that are not in the
+// ledger yet.
+func runImport(args []string) error {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	bank := flags.String("bank", "", "column mapping of the statement: bca, mandiri, generic or one from the config file")
+	ledger := flags.String("ledger", "", "ledger to import into (default the household ledger)")
+	account := flags.String("account", "", "account to record the transactions in (default the default account)")
+	dryRun := flags.Bool("dry-run", false, "only report what would be imported")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: financial-bot import -bank <name> [flags] <statement.csv>")
+		flags.PrintDefaults()
+	}
+	if help, err := parseFlags(flags, args); help || err != nil {
+		return err
+	}
+	if *bank == "" || flags.NArg() != 1 {
+		flags.Usage()
+		return errors.New("a bank and exactly one statement file are required")
+	}
+
+	data, err := os.ReadFile(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	cfg, db, err := openDatabase()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	result, err := newEngine(cfg, db).Import(context.Background(), data, engine.ImportOptions{
+		Ledger: *ledger, Account: *account, Bank: *bank, DryRun: *dryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	if *dryRun {
+		fmt.Printf("Would import %d transactions\n", len(result.New))
+	} else {
+		fmt.Printf("Imported %d transactions\n", len(result.New))
+	}
+	for _, line := range result.New {
+		fmt.Println("  " + line)
+	}
+	if result.Duplicates > 0 {
+		fmt.Printf("Skipped %d already recorded\n", result.Duplicates)
+	}
+	if len(result.Skipped) > 0 {
+		fmt.Println("Lines that are not transactions:")
+		for _, line := range result.Skipped {
+			fmt.Println("  " + line)
+		}
+	}
+	return nil
+}
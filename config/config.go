@@ -0,0 +1,271 @@
+// Package config loads the bot's settings from a config file, with
+// environment variables overriding individual keys.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// Member roles, from most to least privileged.
+const (
+	RoleAdmin  = "admin"
+	RoleWriter = "writer"
+	RoleViewer = "viewer"
+)
+
+// Member is a household member allowed to talk to the bot. The config
+// file's members seed the household ledger on first start; after that
+// admins manage members through the chat, per ledger.
+type Member struct {
+	Name string `mapstructure:"name"`
+	// Phone is the member's number in international format without the
+	// "+", e.g. 6281234567890.
+	Phone string `mapstructure:"phone"`
+	Role  string `mapstructure:"role"`
+}
+
+// ImportMapping names the columns of a bank statement CSV for the import
+// command, for banks other than the built-in bca, mandiri and generic.
+type ImportMapping struct {
+	Date string `mapstructure:"date"`
+	// DateLayouts are Go time layouts such as "02/01/2006".
+	DateLayouts []string `mapstructure:"date_layouts"`
+	Description string   `mapstructure:"description"`
+	// Amount holds signed amounts; statements with separate money out and
+	// money in columns use Debit and Credit instead.
+	Amount string `mapstructure:"amount"`
+	Debit  string `mapstructure:"debit"`
+	Credit string `mapstructure:"credit"`
+}
+
+// Config holds every setting of the bot.
+type Config struct {
+	Database struct {
+		// Path is the finance database.
+		Path string `mapstructure:"path"`
+		// WhatsAppPath is where whatsmeow keeps the device session.
+		WhatsAppPath string `mapstructure:"whatsapp_path"`
+		// ReceiptsPath is the directory receipt photos are kept in.
+		ReceiptsPath string `mapstructure:"receipts_path"`
+	} `mapstructure:"database"`
+
+	// Timezone is the household's IANA timezone, e.g. Asia/Jakarta.
+	Timezone string `mapstructure:"timezone"`
+	// Currency is the prefix amounts are shown with.
+	Currency string `mapstructure:"currency"`
+	// DefaultAccount receives lines that do not name an account.
+	DefaultAccount string `mapstructure:"default_account"`
+
+	Members []Member `mapstructure:"members"`
+
+	HTTP struct {
+		Port  int    `mapstructure:"port"`
+		Token string `mapstructure:"token"`
+	} `mapstructure:"http"`
+
+	Import struct {
+		// Mappings are keyed by the bank name used in "import <bank>".
+		Mappings map[string]ImportMapping `mapstructure:"mappings"`
+	} `mapstructure:"import"`
+
+	Features struct {
+		// HTTPAPI serves the REST API on HTTP.Port.
+		HTTPAPI bool `mapstructure:"http_api"`
+		// Recurring records recurring transactions when they are due.
+		// Summaries and debt reminders are sent either way.
+		Recurring bool `mapstructure:"recurring"`
+	} `mapstructure:"features"`
+
+	// Location is Timezone, loaded by Validate.
+	Location *time.Location `mapstructure:"-"`
+}
+
+// defaults are used for every key the config file and environment leave
+// unset. Registering them also lets viper match environment variables.
+var defaults = map[string]any{
+	"database.path":          "data/app.db",
+	"database.whatsapp_path": "whatsapp.db",
+	"database.receipts_path": "data/receipts",
+	"timezone":               "Asia/Jakarta",
+	"currency":               "Rp",
+	"default_account":        "main",
+	"http.port":              8080,
+	"http.token":             "",
+	"features.http_api":      false,
+	"features.recurring":     true,
+}
+
+// Load reads the config file at path, or config.yaml in the working
+// directory or data/ when path is empty, and applies environment
+// overrides. Each key can be overridden by its upper-cased name with dots
+// replaced by underscores, e.g. HTTP_PORT or DATABASE_PATH. The config
+// file is optional. The members list can only be set in it, except that
+// without one the ME and YOU variables of older deployments are used.
+func Load(path string) (*Config, error) {
+	v := viper.New()
+	for key, value := range defaults {
+		v.SetDefault(key, value)
+	}
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	v.AutomaticEnv()
+	// Names used before the config file existed
+	v.BindEnv("http.port", "HTTP_PORT", "PORT")
+	v.BindEnv("http.token", "HTTP_TOKEN", "API_TOKEN")
+
+	if path != "" {
+		v.SetConfigFile(path)
+	} else {
+		v.SetConfigName("config")
+		v.SetConfigType("yaml")
+		v.AddConfigPath(".")
+		v.AddConfigPath("data")
+	}
+	if err := v.ReadInConfig(); err != nil {
+		var notFound viper.ConfigFileNotFoundError
+		if path != "" || !errors.As(err, &notFound) {
+			return nil, fmt.Errorf("reading config file: %w", err)
+		}
+	}
+
+	var cfg Config
+	if err := v.Unmarshal(&cfg); err != nil {
+		return nil, fmt.Errorf("parsing config: %w", err)
+	}
+	if len(cfg.Members) == 0 {
+		cfg.Members = legacyMembers()
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// legacyMembers returns the members named by the ME and YOU environment
+// variables, which authorised the bot's users before the members list
+// existed. Both were allowed everything, so both become admins.
+func legacyMembers() []Member {
+	var members []Member
+	for _, env := range []struct{ key, name string }{{"ME", "Me"}, {"YOU", "You"}} {
+		value := strings.TrimSpace(os.Getenv(env.key))
+		if value == "" {
+			continue
+		}
+		// The numbers were often given as JIDs
+		phone, _, _ := strings.Cut(value, "@")
+		phone, _, _ = strings.Cut(phone, ":")
+		members = append(members, Member{Name: env.name, Phone: phone, Role: RoleAdmin})
+	}
+	return members
+}
+
+// Validate checks every setting, normalises member phone numbers and loads
+// Location. It reports all problems at once.
+func (c *Config) Validate() error {
+	var errs []error
+
+	if c.Database.Path == "" {
+		errs = append(errs, errors.New("database.path must not be empty"))
+	}
+	if c.Database.WhatsAppPath == "" {
+		errs = append(errs, errors.New("database.whatsapp_path must not be empty"))
+	}
+	loc, err := time.LoadLocation(c.Timezone)
+	if err != nil || c.Timezone == "" {
+		errs = append(errs, fmt.Errorf("timezone %q is not a known IANA timezone such as Asia/Jakarta", c.Timezone))
+	}
+	c.Location = loc
+	if strings.TrimSpace(c.Currency) == "" {
+		errs = append(errs, errors.New("currency must not be empty"))
+	}
+	if c.DefaultAccount == "" {
+		errs = append(errs, errors.New("default_account must not be empty"))
+	}
+
+	if len(c.Members) == 0 {
+		errs = append(errs, errors.New("members: at least one household member is required, in the config file or the ME and YOU environment variables"))
+	}
+	seen := map[string]bool{}
+	admins := 0
+	for i := range c.Members {
+		m := &c.Members[i]
+		label := fmt.Sprintf("members[%d]", i)
+		if m.Name == "" {
+			errs = append(errs, fmt.Errorf("%s: name is required", label))
+		} else {
+			label += " (" + m.Name + ")"
+		}
+		phone, err := NormalisePhone(m.Phone)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", label, err))
+		} else if seen[phone] {
+			errs = append(errs, fmt.Errorf("%s: phone %s is listed twice", label, phone))
+		}
+		m.Phone, seen[phone] = phone, true
+		if m.Role == "" {
+			m.Role = RoleWriter
+		}
+		if !ValidRole(m.Role) {
+			errs = append(errs, fmt.Errorf("%s: role %q must be admin, writer or viewer", label, m.Role))
+		}
+		if m.Role == RoleAdmin {
+			admins++
+		}
+	}
+	if len(c.Members) > 0 && admins == 0 {
+		errs = append(errs, errors.New("members: at least one member must be an admin"))
+	}
+
+	for name, m := range c.Import.Mappings {
+		label := "import.mappings." + name
+		if m.Date == "" || m.Description == "" {
+			errs = append(errs, fmt.Errorf("%s: date and description columns are required", label))
+		}
+		if (m.Amount == "") == (m.Debit == "" && m.Credit == "") {
+			errs = append(errs, fmt.Errorf("%s: set either amount or debit and credit", label))
+		}
+	}
+
+	if c.HTTP.Port < 1 || c.HTTP.Port > 65535 {
+		errs = append(errs, fmt.Errorf("http.port %d must be between 1 and 65535", c.HTTP.Port))
+	}
+	if c.Features.HTTPAPI && c.HTTP.Token == "" {
+		errs = append(errs, errors.New("http.token is required when features.http_api is enabled"))
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid config:\n%w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// ValidRole reports whether role is one of the known member roles.
+func ValidRole(role string) bool {
+	return role == RoleAdmin || role == RoleWriter || role == RoleViewer
+}
+
+// NormalisePhone turns a number such as "+62 812-3456-7890" into the
+// digits-only international form WhatsApp uses, 6281234567890.
+func NormalisePhone(phone string) (string, error) {
+	digits := strings.NewReplacer("+", "", " ", "", "-", "").Replace(phone)
+	if digits == "" {
+		return "", errors.New("phone is required")
+	}
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return "", fmt.Errorf("phone %q may only contain digits", phone)
+		}
+	}
+	if strings.HasPrefix(digits, "0") {
+		return "", fmt.Errorf("phone %q must be in international format, e.g. 62812...", phone)
+	}
+	if len(digits) < 8 || len(digits) > 15 {
+		return "", fmt.Errorf("phone %q must have 8 to 15 digits", phone)
+	}
+	return digits, nil
+}
@@ -0,0 +1,140 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	path := writeConfig(t, `
+timezone: Asia/Makassar
+members:
+  - name: Fikri
+    phone: "+62 812-3456-7890"
+    role: admin
+  - name: Sari
+    phone: "6281298765432"
+features:
+  http_api: true
+http:
+  token: from-file
+import:
+  mappings:
+    Jago:
+      date: Tanggal
+      date_layouts: ["2006-01-02"]
+      description: Catatan
+      amount: Jumlah
+`)
+	t.Setenv("HTTP_TOKEN", "from-env")
+	t.Setenv("PORT", "9090")
+	t.Setenv("CURRENCY", "IDR")
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if cfg.Location.String() != "Asia/Makassar" || cfg.Database.Path != "data/app.db" || cfg.Currency != "IDR" {
+		t.Errorf("unexpected settings: %+v", cfg)
+	}
+	if cfg.HTTP.Token != "from-env" || cfg.HTTP.Port != 9090 || !cfg.Features.HTTPAPI || !cfg.Features.Recurring {
+		t.Errorf("unexpected HTTP settings: %+v %+v", cfg.HTTP, cfg.Features)
+	}
+	want := []Member{
+		{Name: "Fikri", Phone: "6281234567890", Role: RoleAdmin},
+		{Name: "Sari", Phone: "6281298765432", Role: RoleWriter},
+	}
+	if len(cfg.Members) != len(want) || cfg.Members[0] != want[0] || cfg.Members[1] != want[1] {
+		t.Errorf("members = %+v, want %+v", cfg.Members, want)
+	}
+	jago := cfg.Import.Mappings["jago"]
+	if jago.Date != "Tanggal" || len(jago.DateLayouts) != 1 || jago.Amount != "Jumlah" {
+		t.Errorf("import mappings = %+v", cfg.Import.Mappings)
+	}
+}
+
+func TestLoadReportsEveryProblem(t *testing.T) {
+	path := writeConfig(t, `
+timezone: Mars/Olympus
+currency: ""
+members:
+  - name: Fikri
+    phone: "0812345678"
+  - phone: "6281234567890"
+    role: owner
+features:
+  http_api: true
+import:
+  mappings:
+    jago:
+      date: Tanggal
+      description: Catatan
+`)
+	_, err := Load(path)
+	if err == nil {
+		t.Fatal("Load succeeded, want error")
+	}
+	for _, want := range []string{
+		`timezone "Mars/Olympus" is not a known IANA timezone`,
+		"currency must not be empty",
+		`members[0] (Fikri): phone "0812345678" must be in international format`,
+		"members[1]: name is required",
+		`members[1]: role "owner" must be admin, writer or viewer`,
+		"http.token is required when features.http_api is enabled",
+		"at least one member must be an admin",
+		"import.mappings.jago: set either amount or debit and credit",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not mention %q", err, want)
+		}
+	}
+}
+
+func TestLoadRequiresMembers(t *testing.T) {
+	t.Setenv("ME", "")
+	t.Setenv("YOU", "")
+	_, err := Load(writeConfig(t, "timezone: Asia/Jakarta\n"))
+	if err == nil || !strings.Contains(err.Error(), "at least one household member is required") {
+		t.Errorf("Load error = %v, want missing members", err)
+	}
+	if _, err := Load(filepath.Join(t.TempDir(), "missing.yaml")); err == nil {
+		t.Error("Load of a missing explicit file succeeded")
+	}
+}
+
+func TestLoadLegacyMembers(t *testing.T) {
+	t.Setenv("ME", "6281234567890@s.whatsapp.net")
+	t.Setenv("YOU", "+62 812-9876-5432")
+	cfg, err := Load(writeConfig(t, "timezone: Asia/Jakarta\n"))
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	want := []Member{
+		{Name: "Me", Phone: "6281234567890", Role: RoleAdmin},
+		{Name: "You", Phone: "6281298765432", Role: RoleAdmin},
+	}
+	if !reflect.DeepEqual(cfg.Members, want) {
+		t.Errorf("Members = %+v, want %+v", cfg.Members, want)
+	}
+
+	// A configured members list wins over the variables
+	cfg, err = Load(writeConfig(t, "members:\n  - name: Fikri\n    phone: \"6281111111111\"\n    role: admin\n"))
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if len(cfg.Members) != 1 || cfg.Members[0].Name != "Fikri" {
+		t.Errorf("Members = %+v, want only Fikri", cfg.Members)
+	}
+}
@@ -0,0 +1,32 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// Open opens the finance database at path, creating its directory if
+// needed, and applies any pending migrations.
+func Open(path string) (*sql.DB, error) {
+	// Create directory if needed
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create data directory: %w", err)
+	}
+
+	// Open database connection
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	if err := Migrate(db, "app"); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("database migration failed: %w", err)
+	}
+
+	return db, nil
+}
@@ -0,0 +1,521 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+)
+
+// Migration is a single schema change applied inside a transaction.
+type Migration struct {
+	Version int
+	Up      func(*sql.Tx) error // Now takes transaction
+}
+
+// migrations are applied in order; append new ones with the next version.
+var migrations = []Migration{
+	{
+		Version: 1,
+		Up: func(tx *sql.Tx) error {
+			_, err := tx.Exec(`CREATE TABLE IF NOT EXISTS transactions (
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				type TEXT NOT NULL CHECK(type IN ('income', 'expense')),
+				description TEXT NOT NULL,
+				amount INTEGER NOT NULL,
+				created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+			)`)
+			return err
+		},
+	},
+	{
+		Version: 2,
+		Up: func(tx *sql.Tx) error {
+			if _, err := tx.Exec(`CREATE TABLE IF NOT EXISTS categories (
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				name TEXT NOT NULL UNIQUE,
+				created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+			)`); err != nil {
+				return err
+			}
+			_, err := tx.Exec(`ALTER TABLE transactions ADD COLUMN category_id INTEGER REFERENCES categories(id)`)
+			return err
+		},
+	},
+	{
+		Version: 3,
+		Up: func(tx *sql.Tx) error {
+			_, err := tx.Exec(`CREATE TABLE IF NOT EXISTS budgets (
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				category_id INTEGER NOT NULL UNIQUE REFERENCES categories(id),
+				amount INTEGER NOT NULL,
+				created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+			)`)
+			return err
+		},
+	},
+	{
+		Version: 4,
+		Up: func(tx *sql.Tx) error {
+			// Rebuild transactions so the type check allows transfers and
+			// every row belongs to an account; existing rows go to "main".
+			statements := []string{
+				`CREATE TABLE IF NOT EXISTS accounts (
+					id INTEGER PRIMARY KEY AUTOINCREMENT,
+					name TEXT NOT NULL UNIQUE,
+					created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+				)`,
+				`INSERT OR IGNORE INTO accounts (name) VALUES ('main')`,
+				`CREATE TABLE transactions_new (
+					id INTEGER PRIMARY KEY AUTOINCREMENT,
+					type TEXT NOT NULL CHECK(type IN ('income', 'expense', 'transfer')),
+					description TEXT,
+					amount INTEGER NOT NULL,
+					created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+					category_id INTEGER REFERENCES categories(id),
+					account_id INTEGER NOT NULL REFERENCES accounts(id)
+				)`,
+				`INSERT INTO transactions_new (id, type, description, amount, created_at, category_id, account_id)
+				SELECT id, type, description, amount, COALESCE(created_at, CURRENT_TIMESTAMP), category_id,
+					(SELECT id FROM accounts WHERE name = 'main')
+				FROM transactions`,
+				`DROP TABLE transactions`,
+				`ALTER TABLE transactions_new RENAME TO transactions`,
+			}
+			for _, stmt := range statements {
+				if _, err := tx.Exec(stmt); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+	{
+		Version: 5,
+		Up: func(tx *sql.Tx) error {
+			_, err := tx.Exec(`CREATE TABLE IF NOT EXISTS recurring_transactions (
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				type TEXT NOT NULL CHECK(type IN ('income', 'expense')),
+				description TEXT NOT NULL,
+				amount INTEGER NOT NULL,
+				account_id INTEGER NOT NULL REFERENCES accounts(id),
+				category_id INTEGER REFERENCES categories(id),
+				frequency TEXT NOT NULL CHECK(frequency IN ('weekly', 'monthly', 'yearly')),
+				day INTEGER NOT NULL,
+				month INTEGER NOT NULL DEFAULT 0,
+				next_run DATETIME NOT NULL,
+				chat TEXT NOT NULL,
+				created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+			)`)
+			return err
+		},
+	},
+	{
+		Version: 6,
+		Up: func(tx *sql.Tx) error {
+			// A batch groups the transactions recorded by one message so
+			// they can be undone together.
+			if _, err := tx.Exec(`CREATE TABLE IF NOT EXISTS batches (
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				sender TEXT NOT NULL,
+				created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+			)`); err != nil {
+				return err
+			}
+			_, err := tx.Exec(`ALTER TABLE transactions ADD COLUMN batch_id INTEGER REFERENCES batches(id)`)
+			return err
+		},
+	},
+	{
+		Version: 7,
+		Up: func(tx *sql.Tx) error {
+			statements := []string{
+				`ALTER TABLE transactions ADD COLUMN sender TEXT`,
+				`ALTER TABLE transactions ADD COLUMN sender_name TEXT`,
+				`UPDATE transactions SET sender = (SELECT sender FROM batches WHERE batches.id = transactions.batch_id)`,
+			}
+			for _, stmt := range statements {
+				if _, err := tx.Exec(stmt); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+	{
+		Version: 8,
+		Up: func(tx *sql.Tx) error {
+			// Timestamps used to be stored with the offset of whichever zone
+			// recorded them, which breaks comparisons between rows. Rewrite
+			// every one that carries a non-UTC offset as UTC.
+			columns := []struct{ table, column string }{
+				{"transactions", "created_at"},
+				{"categories", "created_at"},
+				{"budgets", "created_at"},
+				{"budgets", "updated_at"},
+				{"accounts", "created_at"},
+				{"recurring_transactions", "next_run"},
+				{"recurring_transactions", "created_at"},
+				{"batches", "created_at"},
+			}
+			for _, c := range columns {
+				stmt := fmt.Sprintf(`UPDATE %[1]s SET %[2]s = strftime('%%Y-%%m-%%d %%H:%%M:%%f', %[2]s) || '+00:00'
+					WHERE %[2]s GLOB '*[+-][0-9][0-9]:[0-9][0-9]' AND %[2]s NOT LIKE '%%+00:00'`, c.table, c.column)
+				if _, err := tx.Exec(stmt); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+	{
+		Version: 9,
+		Up: func(tx *sql.Tx) error {
+			_, err := tx.Exec(`CREATE TABLE IF NOT EXISTS members (
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				phone TEXT NOT NULL UNIQUE,
+				name TEXT NOT NULL DEFAULT '',
+				role TEXT NOT NULL CHECK(role IN ('admin', 'writer', 'viewer')),
+				created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+			)`)
+			return err
+		},
+	},
+	{
+		Version: 10,
+		Up: func(tx *sql.Tx) error {
+			// A ledger is a separate set of books, such as the household,
+			// a small business or a holiday trip. Each chat is bound to one;
+			// chats that are not use the default ledger, which every
+			// existing row moves into. Budgets and members become per
+			// ledger, so their tables are rebuilt with new unique keys.
+			statements := []string{
+				`CREATE TABLE IF NOT EXISTS ledgers (
+					id INTEGER PRIMARY KEY AUTOINCREMENT,
+					name TEXT NOT NULL UNIQUE,
+					created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+				)`,
+				`INSERT OR IGNORE INTO ledgers (id, name) VALUES (1, 'household')`,
+				`CREATE TABLE IF NOT EXISTS chats (
+					id INTEGER PRIMARY KEY AUTOINCREMENT,
+					jid TEXT NOT NULL UNIQUE,
+					ledger_id INTEGER NOT NULL REFERENCES ledgers(id),
+					created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+				)`,
+
+				`CREATE TABLE transactions_new (
+					id INTEGER PRIMARY KEY AUTOINCREMENT,
+					type TEXT NOT NULL CHECK(type IN ('income', 'expense', 'transfer')),
+					description TEXT,
+					amount INTEGER NOT NULL,
+					created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+					category_id INTEGER REFERENCES categories(id),
+					account_id INTEGER NOT NULL REFERENCES accounts(id),
+					batch_id INTEGER REFERENCES batches(id),
+					sender TEXT,
+					sender_name TEXT,
+					ledger_id INTEGER NOT NULL REFERENCES ledgers(id)
+				)`,
+				`INSERT INTO transactions_new (id, type, description, amount, created_at, category_id, account_id, batch_id, sender, sender_name, ledger_id)
+				SELECT id, type, description, amount, created_at, category_id, account_id, batch_id, sender, sender_name, 1
+				FROM transactions`,
+				`DROP TABLE transactions`,
+				`ALTER TABLE transactions_new RENAME TO transactions`,
+
+				`ALTER TABLE batches ADD COLUMN ledger_id INTEGER REFERENCES ledgers(id)`,
+				`UPDATE batches SET ledger_id = 1`,
+
+				`CREATE TABLE recurring_transactions_new (
+					id INTEGER PRIMARY KEY AUTOINCREMENT,
+					type TEXT NOT NULL CHECK(type IN ('income', 'expense')),
+					description TEXT NOT NULL,
+					amount INTEGER NOT NULL,
+					account_id INTEGER NOT NULL REFERENCES accounts(id),
+					category_id INTEGER REFERENCES categories(id),
+					frequency TEXT NOT NULL CHECK(frequency IN ('weekly', 'monthly', 'yearly')),
+					day INTEGER NOT NULL,
+					month INTEGER NOT NULL DEFAULT 0,
+					next_run DATETIME NOT NULL,
+					chat TEXT NOT NULL,
+					created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+					ledger_id INTEGER NOT NULL REFERENCES ledgers(id)
+				)`,
+				`INSERT INTO recurring_transactions_new (id, type, description, amount, account_id, category_id, frequency, day, month, next_run, chat, created_at, ledger_id)
+				SELECT id, type, description, amount, account_id, category_id, frequency, day, month, next_run, chat, created_at, 1
+				FROM recurring_transactions`,
+				`DROP TABLE recurring_transactions`,
+				`ALTER TABLE recurring_transactions_new RENAME TO recurring_transactions`,
+
+				`CREATE TABLE budgets_new (
+					id INTEGER PRIMARY KEY AUTOINCREMENT,
+					ledger_id INTEGER NOT NULL REFERENCES ledgers(id),
+					category_id INTEGER NOT NULL REFERENCES categories(id),
+					amount INTEGER NOT NULL,
+					created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+					updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+					UNIQUE(ledger_id, category_id)
+				)`,
+				`INSERT INTO budgets_new (id, ledger_id, category_id, amount, created_at, updated_at)
+				SELECT id, 1, category_id, amount, created_at, updated_at FROM budgets`,
+				`DROP TABLE budgets`,
+				`ALTER TABLE budgets_new RENAME TO budgets`,
+
+				`CREATE TABLE members_new (
+					id INTEGER PRIMARY KEY AUTOINCREMENT,
+					ledger_id INTEGER NOT NULL REFERENCES ledgers(id),
+					phone TEXT NOT NULL,
+					name TEXT NOT NULL DEFAULT '',
+					role TEXT NOT NULL CHECK(role IN ('admin', 'writer', 'viewer')),
+					created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+					UNIQUE(ledger_id, phone)
+				)`,
+				`INSERT INTO members_new (id, ledger_id, phone, name, role, created_at)
+				SELECT id, 1, phone, name, role, created_at FROM members`,
+				`DROP TABLE members`,
+				`ALTER TABLE members_new RENAME TO members`,
+			}
+			for _, stmt := range statements {
+				if _, err := tx.Exec(stmt); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+	{
+		Version: 11,
+		Up: func(tx *sql.Tx) error {
+			// Savings goals. Money put towards a goal is recorded as a
+			// saving transaction linked to it, which takes it out of the
+			// spendable balance; the type check needs a rebuild for that.
+			statements := []string{
+				`CREATE TABLE IF NOT EXISTS goals (
+					id INTEGER PRIMARY KEY AUTOINCREMENT,
+					ledger_id INTEGER NOT NULL REFERENCES ledgers(id),
+					name TEXT NOT NULL,
+					target INTEGER NOT NULL,
+					deadline DATETIME,
+					created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+					UNIQUE(ledger_id, name)
+				)`,
+				`CREATE TABLE transactions_new (
+					id INTEGER PRIMARY KEY AUTOINCREMENT,
+					type TEXT NOT NULL CHECK(type IN ('income', 'expense', 'transfer', 'saving')),
+					description TEXT,
+					amount INTEGER NOT NULL,
+					created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+					category_id INTEGER REFERENCES categories(id),
+					account_id INTEGER NOT NULL REFERENCES accounts(id),
+					batch_id INTEGER REFERENCES batches(id),
+					sender TEXT,
+					sender_name TEXT,
+					ledger_id INTEGER NOT NULL REFERENCES ledgers(id),
+					goal_id INTEGER REFERENCES goals(id)
+				)`,
+				`INSERT INTO transactions_new (id, type, description, amount, created_at, category_id, account_id, batch_id, sender, sender_name, ledger_id)
+				SELECT id, type, description, amount, created_at, category_id, account_id, batch_id, sender, sender_name, ledger_id
+				FROM transactions`,
+				`DROP TABLE transactions`,
+				`ALTER TABLE transactions_new RENAME TO transactions`,
+			}
+			for _, stmt := range statements {
+				if _, err := tx.Exec(stmt); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+	{
+		Version: 12,
+		Up: func(tx *sql.Tx) error {
+			// Debts and receivables. Lending, borrowing and repaying are
+			// transactions linked to the counterparty, so the balance moves
+			// with the money and the outstanding amount is their sum. The
+			// counterparty carries the optional due date and the chat the
+			// reminder goes to.
+			statements := []string{
+				`CREATE TABLE IF NOT EXISTS counterparties (
+					id INTEGER PRIMARY KEY AUTOINCREMENT,
+					ledger_id INTEGER NOT NULL REFERENCES ledgers(id),
+					name TEXT NOT NULL,
+					due_date DATETIME,
+					chat TEXT NOT NULL DEFAULT '',
+					reminded_at DATETIME,
+					created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+					UNIQUE(ledger_id, name)
+				)`,
+				`CREATE TABLE transactions_new (
+					id INTEGER PRIMARY KEY AUTOINCREMENT,
+					type TEXT NOT NULL CHECK(type IN ('income', 'expense', 'transfer', 'saving', 'loan', 'repayment')),
+					description TEXT,
+					amount INTEGER NOT NULL,
+					created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+					category_id INTEGER REFERENCES categories(id),
+					account_id INTEGER NOT NULL REFERENCES accounts(id),
+					batch_id INTEGER REFERENCES batches(id),
+					sender TEXT,
+					sender_name TEXT,
+					ledger_id INTEGER NOT NULL REFERENCES ledgers(id),
+					goal_id INTEGER REFERENCES goals(id),
+					counterparty_id INTEGER REFERENCES counterparties(id)
+				)`,
+				`INSERT INTO transactions_new (id, type, description, amount, created_at, category_id, account_id, batch_id, sender, sender_name, ledger_id, goal_id)
+				SELECT id, type, description, amount, created_at, category_id, account_id, batch_id, sender, sender_name, ledger_id, goal_id
+				FROM transactions`,
+				`DROP TABLE transactions`,
+				`ALTER TABLE transactions_new RENAME TO transactions`,
+			}
+			for _, stmt := range statements {
+				if _, err := tx.Exec(stmt); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+	{
+		Version: 13,
+		Up: func(tx *sql.Tx) error {
+			// Scheduled summaries: an end-of-day digest and a month-end
+			// summary, each switched on per chat with its own time of day.
+			_, err := tx.Exec(`CREATE TABLE IF NOT EXISTS summaries (
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				chat TEXT NOT NULL,
+				kind TEXT NOT NULL CHECK(kind IN ('daily', 'monthly')),
+				minute INTEGER NOT NULL,
+				next_run DATETIME NOT NULL,
+				created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				UNIQUE(chat, kind)
+			)`)
+			return err
+		},
+	},
+	{
+		Version: 14,
+		Up: func(tx *sql.Tx) error {
+			// Receipt photos and documents sent along with transactions.
+			// The file lives on disk, named after its hash; the same file
+			// sent twice is stored once.
+			statements := []string{
+				`CREATE TABLE IF NOT EXISTS receipts (
+					id INTEGER PRIMARY KEY AUTOINCREMENT,
+					hash TEXT NOT NULL UNIQUE,
+					file_name TEXT NOT NULL,
+					mime_type TEXT NOT NULL,
+					created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+				)`,
+				`ALTER TABLE transactions ADD COLUMN receipt_id INTEGER REFERENCES receipts(id)`,
+			}
+			for _, stmt := range statements {
+				if _, err := tx.Exec(stmt); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+	{
+		Version: 15,
+		Up: func(tx *sql.Tx) error {
+			// Chat messages linked to the batch of transactions they
+			// recorded or reported on, so a reply quoting one can refer to
+			// exactly those transactions.
+			_, err := tx.Exec(`CREATE TABLE IF NOT EXISTS messages (
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				chat TEXT NOT NULL,
+				message_id TEXT NOT NULL,
+				batch_id INTEGER NOT NULL REFERENCES batches(id),
+				created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				UNIQUE(chat, message_id)
+			)`)
+			return err
+		},
+	},
+	{
+		Version: 16,
+		Up: func(tx *sql.Tx) error {
+			// Every incoming message handled, so one WhatsApp delivers
+			// again after a reconnect is not handled twice.
+			_, err := tx.Exec(`CREATE TABLE IF NOT EXISTS processed_messages (
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				chat TEXT NOT NULL,
+				message_id TEXT NOT NULL,
+				outcome TEXT NOT NULL CHECK(outcome IN ('processing', 'ignored', 'replied', 'recorded')),
+				duplicates INTEGER NOT NULL DEFAULT 0,
+				processed_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				UNIQUE(chat, message_id)
+			)`)
+			return err
+		},
+	},
+	{
+		Version: 17,
+		Up: func(tx *sql.Tx) error {
+			// When each message was sent, so messages sent while the bot
+			// was offline can be caught up on from the last one processed.
+			if _, err := tx.Exec(`ALTER TABLE processed_messages ADD COLUMN sent_at DATETIME`); err != nil {
+				return err
+			}
+			_, err := tx.Exec(`UPDATE processed_messages SET sent_at = processed_at`)
+			return err
+		},
+	},
+}
+
+// Migrate brings db up to the latest schema version, recording each applied
+// migration in schema_migrations.
+func Migrate(db *sql.DB, dbName string) error {
+	// Enable foreign keys
+	if _, err := db.Exec("PRAGMA foreign_keys = ON;"); err != nil {
+		return fmt.Errorf("failed to enable foreign keys: %w", err)
+	}
+
+	// Create migration tracking table
+	if _, err := db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		version INTEGER PRIMARY KEY,
+		applied_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	)`); err != nil {
+		return fmt.Errorf("failed to create migrations table: %w", err)
+	}
+
+	// Get current version - handle NULL
+	var version int
+	err := db.QueryRow(`
+		SELECT COALESCE(MAX(version), 0) 
+		FROM schema_migrations
+	`).Scan(&version)
+	if err != nil && err != sql.ErrNoRows {
+		return fmt.Errorf("failed to get current migration version: %w", err)
+	}
+
+	// Apply pending migrations
+	for _, migration := range migrations {
+		if migration.Version > version {
+			log.Printf("Applying migration %d for %s", migration.Version, dbName)
+
+			tx, err := db.Begin()
+			if err != nil {
+				return fmt.Errorf("failed to begin transaction: %w", err)
+			}
+
+			if err := migration.Up(tx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("migration %d failed: %w", migration.Version, err)
+			}
+
+			if _, err := tx.Exec("INSERT INTO schema_migrations (version) VALUES (?)", migration.Version); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to record migration %d: %w", migration.Version, err)
+			}
+
+			if err := tx.Commit(); err != nil {
+				return fmt.Errorf("failed to commit migration %d: %w", migration.Version, err)
+			}
+
+			log.Printf("Successfully applied migration %d", migration.Version)
+		}
+	}
+
+	return nil
+}
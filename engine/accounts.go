@@ -0,0 +1,222 @@
+package engine
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"financial-bot/models"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+)
+
+// DefaultAccountName is the account existing transactions were migrated
+// into and the default target of income and expense lines.
+const DefaultAccountName = "main"
+
+var (
+	accountTagPattern = regexp.MustCompile(`@([a-z0-9_-]+)|account:\s*([a-z0-9_-]+)`)
+	transferPattern   = regexp.MustCompile(`^(\S+)\s*->\s*(\S+)\s*=(.+)$`)
+)
+
+// extractAccount removes an "@name" or "account: name" tag from line and
+// returns the remaining text together with the account name, if any.
+func extractAccount(line string) (string, string) {
+	return extractTag(accountTagPattern, line)
+}
+
+func (e *Engine) findAccount(ctx context.Context, exec boil.ContextExecutor, name string) (*models.Account, error) {
+	return models.Accounts(models.AccountWhere.Name.EQ(name)).One(ctx, exec)
+}
+
+// resolveAccount returns the named account, or the default account when
+// name is empty. The default account is created through exec if it does
+// not exist yet, so it goes together with what the caller records.
+func (e *Engine) resolveAccount(ctx context.Context, exec boil.ContextExecutor, name string) (*models.Account, error) {
+	if name != "" {
+		return e.findAccount(ctx, exec, name)
+	}
+
+	account, err := e.findAccount(ctx, exec, e.defaultAccount)
+	if !errors.Is(err, sql.ErrNoRows) {
+		return account, err
+	}
+	account = &models.Account{Name: e.defaultAccount, CreatedAt: e.timestamp()}
+	if err := account.Insert(ctx, exec, boil.Infer()); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+func (e *Engine) addAccount(ctx context.Context, name string) Reply {
+	name = strings.TrimSpace(name)
+	if !namePattern.MatchString(name) {
+		return Reply{Text: "⚠️ Account names may only contain letters, digits, '-' and '_'"}
+	}
+
+	if _, err := e.findAccount(ctx, e.db, name); err == nil {
+		return Reply{Text: fmt.Sprintf("⚠️ Account %s already exists", name)}
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		log.Println("Error fetching account:", err)
+		return Reply{Text: "❌ Error adding account"}
+	}
+
+	account := &models.Account{Name: name, CreatedAt: e.timestamp()}
+	if err := account.Insert(ctx, e.db, boil.Infer()); err != nil {
+		log.Println("Error saving account:", err)
+		return Reply{Text: "❌ Error adding account"}
+	}
+	return Reply{Text: fmt.Sprintf("✅ Account %s added", name)}
+}
+
+// transfer handles "transfer <from> -> <to> = <amount>" by recording a
+// balanced pair of entries, so the total balance does not change.
+func (e *Engine) transfer(ctx context.Context, cmd Command, ledger int64, args string) Reply {
+	m := transferPattern.FindStringSubmatch(strings.TrimSpace(args))
+	if m == nil {
+		return Reply{Text: "⚠️ Use: transfer <from> -> <to> = <amount>"}
+	}
+	fromName, toName := strings.TrimPrefix(m[1], "@"), strings.TrimPrefix(m[2], "@")
+	if fromName == toName {
+		return Reply{Text: "⚠️ Cannot transfer to the same account"}
+	}
+	amount, err := parseAmount(m[3])
+	if err != nil {
+		return Reply{Text: "⚠️ " + capitalize(err.Error())}
+	}
+	if amount <= 0 {
+		return Reply{Text: "⚠️ Invalid transfer amount"}
+	}
+
+	var accounts []*models.Account
+	for _, name := range []string{fromName, toName} {
+		account, err := e.findAccount(ctx, e.db, name)
+		if errors.Is(err, sql.ErrNoRows) {
+			return Reply{Text: fmt.Sprintf("⚠️ Unknown account %s", name)}
+		}
+		if err != nil {
+			log.Println("Error fetching account:", err)
+			return Reply{Text: "❌ Error recording transfer"}
+		}
+		accounts = append(accounts, account)
+	}
+
+	desc := fmt.Sprintf("transfer %s -> %s", fromName, toName)
+	dbTx, err := e.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Println("Error starting transfer:", err)
+		return Reply{Text: "❌ Error recording transfer"}
+	}
+	batch, err := e.newBatch(ctx, dbTx, cmd.Sender, ledger)
+	if err != nil {
+		dbTx.Rollback()
+		log.Println("Error saving batch:", err)
+		return Reply{Text: "❌ Error recording transfer"}
+	}
+	entries := []*models.Transaction{
+		{Type: "transfer", Description: null.StringFrom(desc), Amount: -amount, AccountID: accounts[0].ID.Int64},
+		{Type: "transfer", Description: null.StringFrom(desc), Amount: amount, AccountID: accounts[1].ID.Int64},
+	}
+	for _, entry := range entries {
+		entry.BatchID = batch.ID
+		entry.CreatedAt = e.timestamp()
+		entry.LedgerID = ledger
+		entry.Sender = null.StringFrom(cmd.Sender)
+		entry.SenderName = null.StringFrom(cmd.SenderName)
+		if err := entry.Insert(ctx, dbTx, boil.Infer()); err != nil {
+			dbTx.Rollback()
+			log.Println("Error saving transfer:", err)
+			return Reply{Text: "❌ Error recording transfer"}
+		}
+	}
+	if err := dbTx.Commit(); err != nil {
+		log.Println("Error committing transfer:", err)
+		return Reply{Text: "❌ Error recording transfer"}
+	}
+
+	balances, err := e.accountBalances(ctx, ledger)
+	if err != nil {
+		log.Println("Error fetching balances:", err)
+		return Reply{Text: "❌ Error fetching balance"}
+	}
+	return Reply{Text: fmt.Sprintf("🔁 *Transfer* 🔁\n#%d %s from %s to %s\n\n%s",
+		entries[0].ID.Int64, e.money(amount), fromName, toName, e.formatBalances("New Balance", balances)), Batch: batch.ID.Int64}
+}
+
+// AccountBalance is the balance of one account in a ledger.
+type AccountBalance struct {
+	Name    string
+	Balance int64
+}
+
+func (e *Engine) accountBalances(ctx context.Context, ledger int64) ([]AccountBalance, error) {
+	return AccountBalances(ctx, e.db, ledger)
+}
+
+// AccountBalances returns the balance of every account used in ledger,
+// ordered by name. Accounts are shared by all ledgers, so those only
+// other ledgers use are left out.
+func AccountBalances(ctx context.Context, exec boil.ContextExecutor, ledger int64) ([]AccountBalance, error) {
+	rows, err := exec.QueryContext(ctx, `
+		SELECT a.name, SUM(t.amount)
+		FROM accounts a
+		JOIN transactions t ON t.account_id = a.id AND t.ledger_id = ?
+		GROUP BY a.id
+		ORDER BY a.name`, ledger)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var balances []AccountBalance
+	for rows.Next() {
+		var b AccountBalance
+		if err := rows.Scan(&b.Name, &b.Balance); err != nil {
+			return nil, err
+		}
+		balances = append(balances, b)
+	}
+	return balances, rows.Err()
+}
+
+// formatBalances renders the total balance under label followed by a
+// per-account breakdown unless the default account is the only one used.
+func (e *Engine) formatBalances(label string, balances []AccountBalance) string {
+	var total int64
+	for _, b := range balances {
+		total += b.Balance
+	}
+
+	text := fmt.Sprintf("%s: %s", label, e.money(total))
+	if len(balances) == 0 || (len(balances) == 1 && balances[0].Name == DefaultAccountName) {
+		return text
+	}
+	for _, b := range balances {
+		text += fmt.Sprintf("\n🏦 %s: %s", b.Name, e.money(b.Balance))
+	}
+	return text
+}
+
+func (e *Engine) getBalance(ctx context.Context, ledger int64) Reply {
+	balances, err := e.accountBalances(ctx, ledger)
+	if err != nil {
+		log.Println("Error fetching balances:", err)
+		return Reply{Text: "❌ Error fetching balance"}
+	}
+	text := fmt.Sprintf("💰 *Balance* 💰\nDate: %s\n%s",
+		e.localNow().Format("2006-01-02"), e.formatBalances("Total Balance", balances))
+
+	// Savings are out of the balance but still the household's money
+	var saved int64
+	err = e.db.QueryRowContext(ctx, `SELECT COALESCE(-SUM(amount), 0) FROM transactions WHERE ledger_id = ? AND type = 'saving'`, ledger).Scan(&saved)
+	if err != nil {
+		log.Println("Error fetching savings:", err)
+	} else if saved != 0 {
+		text += fmt.Sprintf("\n🐷 Saved for goals: %s", e.money(saved))
+	}
+	return Reply{Text: text}
+}
@@ -0,0 +1,106 @@
+package engine
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAccounts(t *testing.T) {
+	now := time.Date(2025, time.June, 28, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		messages []string
+		want     []string
+	}{
+		{
+			name:     "single account hides breakdown",
+			messages: []string{"income\nsalary = 100.000"},
+			want:     []string{"New Balance: Rp 100.000"},
+		},
+		{
+			name:     "lines target accounts",
+			messages: []string{"add account bca", "income\nsalary = 1.000.000 @bca\ngift = 50.000", "expense\nbread = 20.000 account: bca"},
+			want:     []string{"New Balance: Rp 1.030.000\n🏦 bca: Rp 980.000\n🏦 main: Rp 50.000"},
+		},
+		{
+			name:     "unknown account is not recorded",
+			messages: []string{"expense\nbread = 20.000 @ovo"},
+			want:     []string{"New Balance: Rp 0", "• bread = 20.000 @ovo → unknown account @ovo"},
+		},
+		{
+			name:     "transfer keeps total",
+			messages: []string{"add account bca", "add account cash", "income\nsalary = 1.000.000 @bca", "transfer bca -> cash = 500.000"},
+			want:     []string{"Rp 500.000 from bca to cash", "New Balance: Rp 1.000.000\n🏦 bca: Rp 500.000\n🏦 cash: Rp 500.000"},
+		},
+		{
+			name:     "transfer to unknown account",
+			messages: []string{"add account bca", "transfer bca -> ovo = 10.000"},
+			want:     []string{"Unknown account ovo"},
+		},
+		{
+			name:     "transfer to same account",
+			messages: []string{"add account bca", "transfer bca -> bca = 10.000"},
+			want:     []string{"same account"},
+		},
+		{
+			name:     "balance command",
+			messages: []string{"add account cash", "income\nsalary = 10.000 @cash", "balance"},
+			want:     []string{"Total Balance: Rp 10.000\n🏦 cash: Rp 10.000"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newTestEngine(t, now)
+
+			var reply string
+			for _, msg := range tt.messages {
+				reply = e.Handle(context.Background(), Command{Text: msg})[0].Text
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(reply, want) {
+					t.Errorf("reply %q does not contain %q", reply, want)
+				}
+			}
+		})
+	}
+}
+
+func TestAccountsPerLedger(t *testing.T) {
+	e := newTestEngine(t, time.Date(2025, time.June, 28, 10, 0, 0, 0, time.UTC))
+	ctx := context.Background()
+	send := func(chat, text string) string {
+		t.Helper()
+		return e.Handle(ctx, Command{Sender: "me", Chat: chat, Text: text})[0].Text
+	}
+	send("family@g.us", "add account bca")
+	send("family@g.us", "income\nsalary = 1.000.000 @bca")
+	send("trip@g.us", "use ledger trip")
+
+	// The household's accounts are not part of the trip's balance
+	if got := send("trip@g.us", "expense\nhotel = 300.000"); !strings.HasSuffix(got, "New Balance: Rp -300.000") {
+		t.Errorf("trip reply %q lists other ledgers' accounts", got)
+	}
+	if got := send("trip@g.us", "balance"); strings.Contains(got, "bca") {
+		t.Errorf("trip balance %q lists the household's bca account", got)
+	}
+	if got := send("family@g.us", "balance"); !strings.Contains(got, "Total Balance: Rp 1.000.000\n🏦 bca: Rp 1.000.000") || strings.Contains(got, "main") {
+		t.Errorf("household balance %q, want only bca", got)
+	}
+}
+
+func TestTransfersAreExcludedFromReports(t *testing.T) {
+	e := newTestEngine(t, time.Date(2025, time.June, 28, 10, 0, 0, 0, time.UTC))
+	ctx := context.Background()
+	for _, msg := range []string{"add account bca", "income\nsalary = 100.000", "transfer main -> bca = 40.000"} {
+		e.Handle(ctx, Command{Text: msg})
+	}
+
+	reply := e.Handle(ctx, Command{Text: "category report"})[0].Text
+	if strings.Contains(reply, "Expenses") {
+		t.Errorf("transfer counted as expense: %q", reply)
+	}
+}
@@ -0,0 +1,236 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// amountUnits are the shorthand suffixes an amount may end with, longest
+// first so "ribu" is not mistaken for a bare number followed by junk.
+var amountUnits = []struct {
+	suffix string
+	value  int64
+}{
+	{"ribu", 1_000},
+	{"juta", 1_000_000},
+	{"rb", 1_000},
+	{"jt", 1_000_000},
+	{"k", 1_000},
+}
+
+// errMissingAmount is returned for an empty amount.
+var errMissingAmount = errors.New("missing amount")
+
+// parseAmount reads an amount in whole rupiah. It accepts
+//
+//   - plain and grouped numbers: "25000", "25.000", "1,500,000", "Rp 25.000,-"
+//   - shorthand units: "25rb", "25 ribu", "25k", "1,5jt", "2 juta"
+//   - products: "3x15rb", "2 * 12.500"
+//
+// Decimals are accepted only when the amount comes to a whole number of
+// rupiah, as in "1,5jt". Anything else, such as "2,5" or "12.500,50", is
+// rejected, since a fraction of a rupiah was most likely meant as
+// thousands. A lone "." or "," followed by three digits is a thousands
+// separator, except before a unit, where "1.500jt" could be read either
+// way and is rejected.
+func parseAmount(s string) (int64, error) {
+	return readAmount(s, false)
+}
+
+// parseStatementAmount reads an amount like parseAmount, but rounds
+// fractions of a rupiah to the nearest rupiah, as bank statements show
+// interest and fees in cents.
+func parseStatementAmount(s string) (int64, error) {
+	return readAmount(s, true)
+}
+
+// readAmount implements parseAmount and parseStatementAmount. round
+// reports whether fractions of a rupiah are rounded rather than rejected.
+func readAmount(s string, round bool) (int64, error) {
+	original := strings.TrimSpace(s)
+	text := strings.Join(strings.Fields(strings.ToLower(original)), "")
+	text = strings.TrimPrefix(text, "rp")
+	text = strings.TrimPrefix(text, ".")
+	text = strings.TrimSuffix(strings.TrimSuffix(text, ",-"), ".-")
+	if text == "" {
+		return 0, errMissingAmount
+	}
+
+	text = strings.NewReplacer("×", "x", "*", "x").Replace(text)
+	total := big.NewRat(1, 1)
+	for _, factor := range strings.Split(text, "x") {
+		value, err := parseFactor(factor)
+		var ambiguous *ambiguousError
+		if errors.As(err, &ambiguous) {
+			return 0, fmt.Errorf("ambiguous amount: %w", err)
+		}
+		if err != nil {
+			return 0, fmt.Errorf("invalid amount %q", original)
+		}
+		total.Mul(total, value)
+	}
+	if !round && !total.IsInt() {
+		return 0, fmt.Errorf("amount %q is not a whole number of rupiah", original)
+	}
+
+	amount, ok := roundRupiah(total)
+	if !ok {
+		return 0, fmt.Errorf("amount %q is too large", original)
+	}
+	return amount, nil
+}
+
+// errAmbiguous is returned by normaliseNumber for a number whose single
+// separator could be either a decimal point or a thousands separator.
+var errAmbiguous = errors.New("ambiguous separator")
+
+// ambiguousError spells out both readings of an ambiguous number so the
+// sender can tell which one they meant.
+type ambiguousError struct {
+	text               string
+	decimal, thousands int64
+}
+
+func (e *ambiguousError) Error() string {
+	return fmt.Sprintf("%q could mean %s or %s", e.text, formatCurrency(e.decimal), formatCurrency(e.thousands))
+}
+
+// roundRupiah rounds r half up to whole rupiah, reporting false when the
+// result does not fit in an int64.
+func roundRupiah(r *big.Rat) (int64, bool) {
+	num, den := r.Num(), r.Denom()
+	twice := new(big.Int).Lsh(num, 1)
+	rounded := twice.Add(twice, den).Quo(twice, new(big.Int).Lsh(den, 1))
+	if !rounded.IsInt64() {
+		return 0, false
+	}
+	return rounded.Int64(), true
+}
+
+// parseFactor reads a single number with an optional unit, such as "15rb"
+// or "12.500,50".
+func parseFactor(factor string) (*big.Rat, error) {
+	s, unit := factor, int64(1)
+	for _, u := range amountUnits {
+		if rest, ok := strings.CutSuffix(factor, u.suffix); ok {
+			s, unit = rest, u.value
+			break
+		}
+	}
+	multiplier := new(big.Rat).SetInt64(unit)
+
+	digits, err := normaliseNumber(s, unit != 1)
+	if errors.Is(err, errAmbiguous) {
+		whole, frac, _ := strings.Cut(strings.ReplaceAll(s, ",", "."), ".")
+		decimal, _ := new(big.Rat).SetString(whole + "." + frac)
+		thousands, _ := new(big.Rat).SetString(whole + frac)
+		amb := &ambiguousError{text: factor}
+		amb.decimal, _ = roundRupiah(decimal.Mul(decimal, multiplier))
+		amb.thousands, _ = roundRupiah(thousands.Mul(thousands, multiplier))
+		return nil, amb
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	value, ok := new(big.Rat).SetString(digits)
+	if !ok {
+		return nil, fmt.Errorf("invalid number %q", s)
+	}
+	return value.Mul(value, multiplier), nil
+}
+
+// normaliseNumber rewrites a number written with Indonesian or English
+// separators as plain digits with an optional "." decimal point. beforeUnit
+// reports whether a unit such as "jt" follows the number.
+func normaliseNumber(s string, beforeUnit bool) (string, error) {
+	if s == "" || strings.Trim(s, "0123456789.,") != "" {
+		return "", fmt.Errorf("invalid number %q", s)
+	}
+
+	dots, commas := strings.Count(s, "."), strings.Count(s, ",")
+	switch {
+	case dots == 0 && commas == 0:
+		return s, nil
+
+	case dots > 0 && commas > 0:
+		// The last separator is the decimal point, the other one groups
+		// thousands: "12.500,50" or "12,500.50".
+		last := strings.LastIndexAny(s, ".,")
+		if strings.Count(s, s[last:last+1]) > 1 {
+			return "", fmt.Errorf("invalid number %q", s)
+		}
+		whole, frac := s[:last], s[last+1:]
+		if !isDigits(frac) {
+			return "", fmt.Errorf("invalid number %q", s)
+		}
+		grouped, ok := ungroup(whole, otherSeparator(s[last]))
+		if !ok {
+			return "", fmt.Errorf("invalid number %q", s)
+		}
+		return grouped + "." + frac, nil
+	}
+
+	sep := byte('.')
+	if commas > 0 {
+		sep = ','
+	}
+	if dots+commas > 1 {
+		// Only thousands separators appear more than once: "1.500.000"
+		grouped, ok := ungroup(s, sep)
+		if !ok {
+			return "", fmt.Errorf("invalid number %q", s)
+		}
+		return grouped, nil
+	}
+
+	whole, frac, _ := strings.Cut(s, string(sep))
+	if !isDigits(whole) || !isDigits(frac) {
+		return "", fmt.Errorf("invalid number %q", s)
+	}
+	switch {
+	case len(frac) <= 2:
+		return whole + "." + frac, nil
+	case len(frac) == 3 && len(whole) <= 3 && beforeUnit:
+		return "", errAmbiguous
+	case len(frac) == 3 && len(whole) <= 3:
+		return whole + frac, nil
+	}
+	return "", fmt.Errorf("invalid number %q", s)
+}
+
+// ungroup removes sep from s after checking it separates groups of three
+// digits, as in "1.500.000".
+func ungroup(s string, sep byte) (string, bool) {
+	groups := strings.Split(s, string(sep))
+	if len(groups[0]) == 0 || len(groups[0]) > 3 {
+		return "", false
+	}
+	for i, g := range groups {
+		if !isDigits(g) || (i > 0 && len(g) != 3) {
+			return "", false
+		}
+	}
+	return strings.Join(groups, ""), true
+}
+
+func otherSeparator(sep byte) byte {
+	if sep == '.' {
+		return ','
+	}
+	return '.'
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,85 @@
+package engine
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		input string
+		want  int64
+	}{
+		{"25000", 25_000},
+		{"25.000", 25_000},
+		{"25,000", 25_000},
+		{" Rp 1.500.000 ", 1_500_000},
+		{"rp25.000,-", 25_000},
+		{"1,500,000", 1_500_000},
+		{"12.500,00", 12_500},
+		{"25rb", 25_000},
+		{"25 ribu", 25_000},
+		{"25k", 25_000},
+		{"12.5k", 12_500},
+		{"1,5jt", 1_500_000},
+		{"2 juta", 2_000_000},
+		{"1,25jt", 1_250_000},
+		{"1.500.000jt", 1_500_000_000_000},
+		{"3x15rb", 45_000},
+		{"2 x 12.500", 25_000},
+		{"2*1,5jt", 3_000_000},
+		{"0", 0},
+	}
+	for _, tt := range tests {
+		got, err := parseAmount(tt.input)
+		if err != nil {
+			t.Errorf("parseAmount(%q): %v", tt.input, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parseAmount(%q) = %d, want %d", tt.input, got, tt.want)
+		}
+	}
+
+	invalid := []struct {
+		input, want string
+	}{
+		{"", "missing amount"},
+		{"abc", `invalid amount "abc"`},
+		{"25rbx", `invalid amount "25rbx"`},
+		{"1.2.3", `invalid amount "1.2.3"`},
+		{"12.500.5", `invalid amount "12.500.5"`},
+		{"1.500,000.5", `invalid amount "1.500,000.5"`},
+		{"25.0000", `invalid amount "25.0000"`},
+		{"-5000", `invalid amount "-5000"`},
+		{"3x", `invalid amount "3x"`},
+		{"1.500jt", `ambiguous amount: "1.500jt" could mean 1.500.000 or 1.500.000.000`},
+		{"2x1,250rb", `ambiguous amount: "1,250rb" could mean 1.250 or 1.250.000`},
+		{"99999999999999jt", `amount "99999999999999jt" is too large`},
+		{"2,5", `amount "2,5" is not a whole number of rupiah`},
+		{"12.500,50", `amount "12.500,50" is not a whole number of rupiah`},
+		{"3x0,5", `amount "3x0,5" is not a whole number of rupiah`},
+	}
+	for _, tt := range invalid {
+		if _, err := parseAmount(tt.input); err == nil || err.Error() != tt.want {
+			t.Errorf("parseAmount(%q) error = %v, want %q", tt.input, err, tt.want)
+		}
+	}
+}
+
+func TestAmountShorthand(t *testing.T) {
+	e := newTestEngine(t, time.Date(2025, time.June, 28, 10, 0, 0, 0, time.UTC))
+	reply := e.Handle(context.Background(), Command{Text: "expense\nbakso = 3x15rb\nsnack = 1.500jt\nrent = 1,5jt"})[0].Text
+
+	for _, want := range []string{
+		"#1 bakso: Rp -45.000\n#2 rent: Rp -1.500.000",
+		"New Balance: Rp -1.545.000",
+		`• snack = 1.500jt → ambiguous amount: "1.500jt" could mean 1.500.000 or 1.500.000.000`,
+	} {
+		if !strings.Contains(reply, want) {
+			t.Errorf("reply %q does not contain %q", reply, want)
+		}
+	}
+}
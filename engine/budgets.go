@@ -0,0 +1,212 @@
+package engine
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"financial-bot/models"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// Percentages of a budget at which an expense triggers a warning.
+const (
+	budgetWarnPercent = 80
+	budgetOverPercent = 100
+)
+
+// setBudget handles "budget <category> = <amount>" for ledger. An amount
+// of zero removes the budget.
+func (e *Engine) setBudget(ctx context.Context, ledger int64, args string) Reply {
+	parts := strings.SplitN(args, "=", 2)
+	if len(parts) < 2 {
+		return Reply{Text: "⚠️ Use: budget <category> = <amount>"}
+	}
+	name := strings.TrimPrefix(strings.TrimSpace(parts[0]), "#")
+	amount, err := parseAmount(parts[1])
+	if err != nil {
+		return Reply{Text: "⚠️ " + capitalize(err.Error())}
+	}
+
+	category, err := e.findCategory(ctx, name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Reply{Text: fmt.Sprintf("⚠️ Unknown category %s. Add it first with: add category %s", name, name)}
+	}
+	if err != nil {
+		log.Println("Error fetching category:", err)
+		return Reply{Text: "❌ Error saving budget"}
+	}
+
+	budget, err := models.Budgets(
+		models.BudgetWhere.LedgerID.EQ(ledger),
+		models.BudgetWhere.CategoryID.EQ(category.ID.Int64),
+	).One(ctx, e.db)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		log.Println("Error fetching budget:", err)
+		return Reply{Text: "❌ Error saving budget"}
+	}
+
+	if amount == 0 {
+		if budget != nil {
+			if _, err := budget.Delete(ctx, e.db); err != nil {
+				log.Println("Error deleting budget:", err)
+				return Reply{Text: "❌ Error removing budget"}
+			}
+		}
+		return Reply{Text: fmt.Sprintf("🗑️ Budget for %s removed", name)}
+	}
+
+	if budget == nil {
+		budget = &models.Budget{LedgerID: ledger, CategoryID: category.ID.Int64, Amount: amount, CreatedAt: e.timestamp(), UpdatedAt: e.timestamp()}
+		err = budget.Insert(ctx, e.db, boil.Infer())
+	} else {
+		budget.Amount = amount
+		budget.UpdatedAt = e.timestamp()
+		_, err = budget.Update(ctx, e.db, boil.Infer())
+	}
+	if err != nil {
+		log.Println("Error saving budget:", err)
+		return Reply{Text: "❌ Error saving budget"}
+	}
+
+	return Reply{Text: fmt.Sprintf("🎯 Monthly budget for %s set to %s", name, e.money(amount))}
+}
+
+// categoryBudget is a budget together with the name of its category.
+type categoryBudget struct {
+	*models.Budget
+	name string
+}
+
+// loadBudgets returns the budgets of ledger matching mods, sorted by
+// category name.
+func (e *Engine) loadBudgets(ctx context.Context, ledger int64, mods ...qm.QueryMod) ([]categoryBudget, error) {
+	budgets, err := models.Budgets(append(mods, models.BudgetWhere.LedgerID.EQ(ledger))...).All(ctx, e.db)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, 0, len(budgets))
+	for _, budget := range budgets {
+		ids = append(ids, budget.CategoryID)
+	}
+	categories, err := models.Categories(models.CategoryWhere.ID.IN(ids)).All(ctx, e.db)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[int64]string, len(categories))
+	for _, category := range categories {
+		names[category.ID.Int64] = category.Name
+	}
+
+	result := make([]categoryBudget, 0, len(budgets))
+	for _, budget := range budgets {
+		result = append(result, categoryBudget{Budget: budget, name: names[budget.CategoryID]})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].name < result[j].name })
+	return result, nil
+}
+
+// monthlySpend returns how much ledger spent on a category in the current
+// month.
+func (e *Engine) monthlySpend(ctx context.Context, ledger, categoryID int64) (int64, error) {
+	now := e.localNow()
+	month := monthPeriod(now.Year(), now.Month(), now.Location())
+	var spent int64
+	err := e.db.QueryRowContext(ctx, `
+		SELECT COALESCE(-SUM(amount), 0) FROM transactions
+		WHERE ledger_id = ? AND type = 'expense' AND category_id = ? AND created_at >= ? AND created_at < ?`,
+		ledger, categoryID, month.start, month.end).Scan(&spent)
+	return spent, err
+}
+
+// budgetAlerts returns a warning for every category whose monthly spend in
+// ledger crossed a budget threshold because of the amounts just recorded.
+// recorded maps category IDs to the amount spent in the current batch.
+func (e *Engine) budgetAlerts(ctx context.Context, ledger int64, recorded map[int64]int64) []string {
+	if len(recorded) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(recorded))
+	for id := range recorded {
+		ids = append(ids, id)
+	}
+	budgets, err := e.loadBudgets(ctx, ledger, models.BudgetWhere.CategoryID.IN(ids))
+	if err != nil {
+		log.Println("Error fetching budgets:", err)
+		return nil
+	}
+
+	var alerts []string
+	for _, budget := range budgets {
+		after, err := e.monthlySpend(ctx, ledger, budget.CategoryID)
+		if err != nil {
+			log.Println("Error fetching monthly spend:", err)
+			continue
+		}
+		before := after - recorded[budget.CategoryID]
+		name := budget.name
+
+		switch {
+		case crossed(before, after, budget.Amount, budgetOverPercent):
+			alerts = append(alerts, fmt.Sprintf("🚨 Budget for %s exceeded: %s of %s (%s)",
+				name, e.money(after), e.money(budget.Amount), formatPercent(after, budget.Amount)))
+		case crossed(before, after, budget.Amount, budgetWarnPercent):
+			alerts = append(alerts, fmt.Sprintf("⚠️ Budget for %s is at %s: %s of %s",
+				name, formatPercent(after, budget.Amount), e.money(after), e.money(budget.Amount)))
+		}
+	}
+	return alerts
+}
+
+// crossed reports whether spend moved from below to at or above percent of
+// limit.
+func crossed(before, after, limit, percent int64) bool {
+	threshold := limit * percent
+	return before*100 < threshold && after*100 >= threshold
+}
+
+func (e *Engine) getBudgetStatus(ctx context.Context, ledger int64) Reply {
+	budgets, err := e.loadBudgets(ctx, ledger)
+	if err != nil {
+		log.Println("Error fetching budgets:", err)
+		return Reply{Text: "❌ Error fetching budgets"}
+	}
+	if len(budgets) == 0 {
+		return Reply{Text: "🎯 No budgets yet. Set one with: budget <category> = <amount>"}
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("🎯 *Budget Status*\nPeriod: Month of %s\n", e.localNow().Format("January 2006")))
+	for _, budget := range budgets {
+		used, err := e.monthlySpend(ctx, ledger, budget.CategoryID)
+		if err != nil {
+			log.Println("Error fetching monthly spend:", err)
+			return Reply{Text: "❌ Error fetching budgets"}
+		}
+
+		icon := "✅"
+		switch {
+		case used*100 >= budget.Amount*budgetOverPercent:
+			icon = "🚨"
+		case used*100 >= budget.Amount*budgetWarnPercent:
+			icon = "⚠️"
+		}
+
+		sb.WriteString(fmt.Sprintf("\n%s *%s*\nUsed: %s of %s (%s)\n",
+			icon, budget.name, e.money(used), e.money(budget.Amount),
+			formatPercent(used, budget.Amount)))
+		if remaining := budget.Amount - used; remaining >= 0 {
+			sb.WriteString(fmt.Sprintf("Remaining: %s\n", e.money(remaining)))
+		} else {
+			sb.WriteString(fmt.Sprintf("Over by: %s\n", e.money(-remaining)))
+		}
+	}
+	return Reply{Text: strings.TrimSuffix(sb.String(), "\n")}
+}
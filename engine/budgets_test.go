@@ -0,0 +1,99 @@
+package engine
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBudgetAlerts(t *testing.T) {
+	now := time.Date(2025, time.June, 28, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		messages []string
+		want     []string
+		notWant  []string
+	}{
+		{
+			name:     "below threshold",
+			messages: []string{"expense\nbread = 700.000 #groceries"},
+			notWant:  []string{"Budget for"},
+		},
+		{
+			name:     "crossing 80 percent",
+			messages: []string{"expense\nbread = 700.000 #groceries", "expense\nmilk = 150.000 #groceries"},
+			want:     []string{"⚠️ Budget for groceries is at 85.0%: Rp 850.000 of Rp 1.000.000"},
+		},
+		{
+			name:     "already past 80 percent",
+			messages: []string{"expense\nbread = 850.000 #groceries", "expense\nmilk = 50.000 #groceries"},
+			notWant:  []string{"Budget for"},
+		},
+		{
+			name:     "crossing 100 percent in one batch",
+			messages: []string{"expense\nbread = 500.000 #groceries\nmeat = 600.000 #groceries"},
+			want:     []string{"🚨 Budget for groceries exceeded: Rp 1.100.000 of Rp 1.000.000 (110.0%)"},
+			notWant:  []string{"⚠️ Budget"},
+		},
+		{
+			name:     "income does not count",
+			messages: []string{"income\nrefund = 900.000 #groceries"},
+			notWant:  []string{"Budget for"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newTestEngine(t, now)
+			ctx := context.Background()
+			e.Handle(ctx, Command{Text: "add category groceries"})
+			e.Handle(ctx, Command{Text: "budget groceries = 1.000.000"})
+
+			var reply string
+			for _, msg := range tt.messages {
+				reply = e.Handle(ctx, Command{Text: msg})[0].Text
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(reply, want) {
+					t.Errorf("reply %q does not contain %q", reply, want)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(reply, notWant) {
+					t.Errorf("reply %q unexpectedly contains %q", reply, notWant)
+				}
+			}
+		})
+	}
+}
+
+func TestBudgetStatus(t *testing.T) {
+	e := newTestEngine(t, time.Date(2025, time.June, 28, 10, 0, 0, 0, time.UTC))
+	ctx := context.Background()
+
+	for _, msg := range []string{
+		"add category groceries",
+		"add category school",
+		"budget groceries = 1.000.000",
+		"budget school = 500.000",
+		"expense\nbread = 250.000 #groceries\nspp = 600.000 #school",
+	} {
+		e.Handle(ctx, Command{Text: msg})
+	}
+
+	reply := e.Handle(ctx, Command{Text: "budget status"})[0].Text
+	for _, want := range []string{
+		"✅ *groceries*\nUsed: Rp 250.000 of Rp 1.000.000 (25.0%)\nRemaining: Rp 750.000",
+		"🚨 *school*\nUsed: Rp 600.000 of Rp 500.000 (120.0%)\nOver by: Rp 100.000",
+	} {
+		if !strings.Contains(reply, want) {
+			t.Errorf("reply %q does not contain %q", reply, want)
+		}
+	}
+
+	if reply := e.Handle(ctx, Command{Text: "budget unknown = 1.000"})[0].Text; !strings.Contains(reply, "Unknown category") {
+		t.Errorf("unexpected reply for unknown category: %q", reply)
+	}
+}
@@ -0,0 +1,83 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/aarondl/null/v8"
+)
+
+// CatchUp handles messages sent while the transport was offline, in the
+// order they were sent and each only once. Commands that recorded
+// transactions are not answered one by one: every chat with such commands
+// gets a single notice saying how many there were, what they recorded and
+// the new balance. The replies of other commands, such as reports, files
+// and errors, are delivered as they are.
+func (e *Engine) CatchUp(ctx context.Context, cmds []Command) []Notice {
+	sort.SliceStable(cmds, func(i, j int) bool { return cmds[i].SentAt.Before(cmds[j].SentAt) })
+
+	var notices []Notice
+	var chats []string
+	recorded := map[string]int{}
+	batches := map[string][]int64{}
+	for _, cmd := range cmds {
+		replies := e.ProcessOnce(ctx, cmd, func() []Reply { return e.Handle(ctx, cmd) })
+		if outcomeOf(replies) != outcomeRecorded {
+			for _, reply := range replies {
+				notices = append(notices, Notice{Chat: cmd.Chat, Reply: reply})
+			}
+			continue
+		}
+		if recorded[cmd.Chat] == 0 {
+			chats = append(chats, cmd.Chat)
+		}
+		recorded[cmd.Chat]++
+		for _, reply := range replies {
+			if reply.Batch != 0 {
+				batches[cmd.Chat] = append(batches[cmd.Chat], reply.Batch)
+			}
+		}
+	}
+
+	for _, chat := range chats {
+		notices = append(notices, Notice{Chat: chat, Reply: e.caughtUp(ctx, chat, recorded[chat], batches[chat])})
+	}
+	return notices
+}
+
+// caughtUp summarises the count commands that recorded transactions in chat
+// after being offline, listing those of batches that are still recorded.
+func (e *Engine) caughtUp(ctx context.Context, chat string, count int, batches []int64) Reply {
+	noun := "messages"
+	if count == 1 {
+		noun = "message"
+	}
+	response := fmt.Sprintf("🔄 *Caught Up* 🔄\nCaught up on %d %s sent while I was offline\n\n", count, noun)
+
+	var recorded []string
+	for _, batch := range batches {
+		transactions, err := e.batchTransactions(ctx, null.Int64From(batch))
+		if err != nil {
+			log.Println("Error fetching transactions:", err)
+			continue
+		}
+		recorded = append(recorded, e.formatEntries(transactions)...)
+	}
+	if len(recorded) > 0 {
+		response += "✅ Recorded:\n" + strings.Join(recorded, "\n") + "\n\n"
+	}
+
+	ledger, err := e.ledgerOf(ctx, chat)
+	if err != nil {
+		log.Println("Error fetching ledger:", err)
+		return Reply{Text: strings.TrimSpace(response)}
+	}
+	balances, err := e.accountBalances(ctx, ledger.ID.Int64)
+	if err != nil {
+		log.Println("Error fetching balances:", err)
+	}
+	return Reply{Text: response + e.formatBalances("New Balance", balances)}
+}
@@ -0,0 +1,65 @@
+package engine
+
+import (
+	"context"
+	"financial-bot/models"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+)
+
+func TestCatchUp(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, time.October, 17, 10, 0, 0, 0, time.UTC)
+	e := newTestEngine(t, now)
+	if last, err := e.LastProcessed(ctx); err != nil || !last.IsZero() {
+		t.Fatalf("LastProcessed before any message = %v, %v", last, err)
+	}
+	first := Command{Sender: "me", Chat: "family@g.us", MessageID: "m0", SentAt: now.Add(-24 * time.Hour), Text: "expense\nbread = 20rb"}
+	e.ProcessOnce(ctx, first, func() []Reply { return e.Handle(ctx, first) })
+
+	evening := time.Date(2026, time.October, 16, 22, 0, 0, 0, time.UTC)
+	morning := time.Date(2026, time.October, 17, 8, 0, 0, 0, time.UTC)
+	notices := e.CatchUp(ctx, []Command{
+		{Sender: "me", Chat: "family@g.us", MessageID: "m3", SentAt: morning, Text: "income\nrefund = 5rb"},
+		{Sender: "me", Chat: "family@g.us", MessageID: "m2", SentAt: evening, Text: "expense\nrice = 60rb\nmilk = 15rb"},
+		{Sender: "me", Chat: "family@g.us", MessageID: "m4", SentAt: morning, Text: "good morning"},
+		{Sender: "me", Chat: "family@g.us", MessageID: "m5", SentAt: morning, Text: "balance"},
+		// Processed before going offline
+		{Sender: "me", Chat: "family@g.us", MessageID: "m0", SentAt: now.Add(-24 * time.Hour), Text: "expense\nbread = 20rb"},
+		{Sender: "me", Chat: "friends@g.us", MessageID: "f1", SentAt: evening, Text: "see you tomorrow"},
+		{Sender: "me", Chat: "trip@g.us", MessageID: "t1", SentAt: morning, Text: "balance"},
+	})
+
+	// Queries are answered, recorded transactions are summarised
+	want := []struct{ chat, text string }{
+		{"family@g.us", "Total Balance: Rp -90.000"},
+		{"trip@g.us", "Total Balance: Rp -90.000"},
+		{"family@g.us", "🔄 *Caught Up* 🔄\nCaught up on 2 messages sent while I was offline\n\n" +
+			"✅ Recorded:\n#2 rice: Rp -60.000\n#3 milk: Rp -15.000\n#4 refund: +Rp 5.000\n\nNew Balance: Rp -90.000"},
+	}
+	if len(notices) != len(want) {
+		t.Fatalf("notices = %+v, want %+v", notices, want)
+	}
+	for i, w := range want {
+		if notices[i].Chat != w.chat || !strings.Contains(notices[i].Reply.Text, w.text) {
+			t.Errorf("notice %d = %+v, want %q in %s", i, notices[i], w.text, w.chat)
+		}
+	}
+
+	// Transactions are dated when their message was sent
+	for id, sent := range map[int64]time.Time{2: evening, 3: evening, 4: morning} {
+		tx, err := models.FindTransaction(ctx, e.db, null.Int64From(id))
+		if err != nil || !tx.CreatedAt.Equal(sent) {
+			t.Errorf("transaction #%d: %+v, %v, want created at %v", id, tx, err, sent)
+		}
+	}
+	if last, err := e.LastProcessed(ctx); err != nil || !last.Equal(morning) {
+		t.Errorf("LastProcessed = %v, %v, want %v", last, err, morning)
+	}
+	if notices := e.CatchUp(ctx, nil); len(notices) != 0 {
+		t.Errorf("catching up on nothing: %+v", notices)
+	}
+}
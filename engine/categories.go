@@ -0,0 +1,208 @@
+package engine
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"financial-bot/models"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+const uncategorized = "uncategorized"
+
+var (
+	// namePattern is shared by every user-managed name: categories, accounts...
+	namePattern        = regexp.MustCompile(`^[a-z0-9_-]+$`)
+	categoryTagPattern = regexp.MustCompile(`#([a-z0-9_-]+)|category:\s*([a-z0-9_-]+)`)
+)
+
+// extractCategory removes a "#name" or "category: name" tag from line and
+// returns the remaining text together with the category name, if any.
+func extractCategory(line string) (string, string) {
+	return extractTag(categoryTagPattern, line)
+}
+
+// extractTag removes the first match of pattern from line and returns the
+// remaining text together with whichever capture group matched.
+func extractTag(pattern *regexp.Regexp, line string) (string, string) {
+	m := pattern.FindStringSubmatchIndex(line)
+	if m == nil {
+		return line, ""
+	}
+	var name string
+	for i := 2; i+1 < len(m); i += 2 {
+		if m[i] >= 0 {
+			name = line[m[i]:m[i+1]]
+			break
+		}
+	}
+	return line[:m[0]] + line[m[1]:], name
+}
+
+func (e *Engine) findCategory(ctx context.Context, name string) (*models.Category, error) {
+	return models.Categories(models.CategoryWhere.Name.EQ(name)).One(ctx, e.db)
+}
+
+func (e *Engine) listCategories(ctx context.Context) Reply {
+	categories, err := models.Categories(qm.OrderBy("name ASC")).All(ctx, e.db)
+	if err != nil {
+		log.Println("Error fetching categories:", err)
+		return Reply{Text: "❌ Error fetching categories"}
+	}
+	if len(categories) == 0 {
+		return Reply{Text: "📂 No categories yet. Add one with: add category <name>"}
+	}
+
+	var sb strings.Builder
+	sb.WriteString("📂 *Categories*\n")
+	for _, c := range categories {
+		sb.WriteString("\n• " + c.Name)
+	}
+	return Reply{Text: sb.String()}
+}
+
+func (e *Engine) addCategory(ctx context.Context, name string) Reply {
+	name = strings.TrimSpace(name)
+	if !namePattern.MatchString(name) {
+		return Reply{Text: "⚠️ Category names may only contain letters, digits, '-' and '_'"}
+	}
+
+	if _, err := e.findCategory(ctx, name); err == nil {
+		return Reply{Text: fmt.Sprintf("⚠️ Category %s already exists", name)}
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		log.Println("Error fetching category:", err)
+		return Reply{Text: "❌ Error adding category"}
+	}
+
+	category := &models.Category{Name: name, CreatedAt: e.timestamp()}
+	if err := category.Insert(ctx, e.db, boil.Infer()); err != nil {
+		log.Println("Error saving category:", err)
+		return Reply{Text: "❌ Error adding category"}
+	}
+	return Reply{Text: fmt.Sprintf("✅ Category %s added", name)}
+}
+
+func (e *Engine) removeCategory(ctx context.Context, name string) Reply {
+	name = strings.TrimSpace(name)
+	category, err := e.findCategory(ctx, name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Reply{Text: fmt.Sprintf("⚠️ Unknown category %s", name)}
+	}
+	if err != nil {
+		log.Println("Error fetching category:", err)
+		return Reply{Text: "❌ Error removing category"}
+	}
+
+	if _, err := models.Budgets(models.BudgetWhere.CategoryID.EQ(category.ID.Int64)).DeleteAll(ctx, e.db); err != nil {
+		log.Println("Error deleting budget:", err)
+		return Reply{Text: "❌ Error removing category"}
+	}
+
+	// Keep the transactions, they just become uncategorized
+	if _, err := e.db.ExecContext(ctx, "UPDATE transactions SET category_id = NULL WHERE category_id = ?", category.ID); err != nil {
+		log.Println("Error detaching category:", err)
+		return Reply{Text: "❌ Error removing category"}
+	}
+	if _, err := category.Delete(ctx, e.db); err != nil {
+		log.Println("Error deleting category:", err)
+		return Reply{Text: "❌ Error removing category"}
+	}
+	return Reply{Text: fmt.Sprintf("🗑️ Category %s removed", name)}
+}
+
+type categoryTotal struct {
+	name  string
+	total int64
+}
+
+func (e *Engine) getCategoryReport(ctx context.Context, ledger int64, p period) Reply {
+	rows, err := e.db.QueryContext(ctx, `
+		SELECT COALESCE(c.name, ?), t.type, SUM(t.amount)
+		FROM transactions t
+		LEFT JOIN categories c ON c.id = t.category_id
+		WHERE t.ledger_id = ? AND t.type IN ('income', 'expense') AND t.created_at >= ? AND t.created_at < ?
+		GROUP BY 1, 2`,
+		uncategorized, ledger, p.start, p.end)
+	if err != nil {
+		log.Println("Error fetching category report:", err)
+		return Reply{Text: "❌ Error fetching category report"}
+	}
+	defer rows.Close()
+
+	totals := map[string][]categoryTotal{}
+	for rows.Next() {
+		var ct categoryTotal
+		var txType string
+		if err := rows.Scan(&ct.name, &txType, &ct.total); err != nil {
+			log.Println("Error reading category report:", err)
+			return Reply{Text: "❌ Error fetching category report"}
+		}
+		totals[txType] = append(totals[txType], ct)
+	}
+	if err := rows.Err(); err != nil {
+		log.Println("Error reading category report:", err)
+		return Reply{Text: "❌ Error fetching category report"}
+	}
+
+	return Reply{Text: e.buildCategoryResponse(totals, p.label)}
+}
+
+func (e *Engine) buildCategoryResponse(totals map[string][]categoryTotal, period string) string {
+	if len(totals) == 0 {
+		return fmt.Sprintf("📂 *Category Report*\nPeriod: %s\n\nNo transactions found", period)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("📂 *Category Report*\nPeriod: %s", period))
+
+	sections := []struct{ txType, title string }{
+		{"expense", "Expenses"},
+		{"income", "Income"},
+	}
+	for _, section := range sections {
+		items := totals[section.txType]
+		if len(items) == 0 {
+			continue
+		}
+
+		var sum int64
+		for i := range items {
+			items[i].total = abs(items[i].total)
+			sum += items[i].total
+		}
+		sort.Slice(items, func(i, j int) bool {
+			if items[i].total != items[j].total {
+				return items[i].total > items[j].total
+			}
+			return items[i].name < items[j].name
+		})
+
+		sb.WriteString(fmt.Sprintf("\n\n*%s*: %s", section.title, e.money(sum)))
+		for _, item := range items {
+			sb.WriteString(fmt.Sprintf("\n• %s: %s (%s)",
+				item.name, e.money(item.total), formatPercent(item.total, sum)))
+		}
+	}
+	return sb.String()
+}
+
+func formatPercent(part, whole int64) string {
+	if whole == 0 {
+		return "0%"
+	}
+	return fmt.Sprintf("%.1f%%", float64(part)*100/float64(whole))
+}
+
+func abs(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,21 @@
+package engine
+
+import "testing"
+
+func TestExtractCategory(t *testing.T) {
+	tests := []struct {
+		line, wantLine, wantCategory string
+	}{
+		{"bread = 10.000", "bread = 10.000", ""},
+		{"bread = 10.000 #groceries", "bread = 10.000 ", "groceries"},
+		{"#kids2 toys = 5.000", " toys = 5.000", "kids2"},
+		{"spp = 500.000 category: school-fees", "spp = 500.000 ", "school-fees"},
+	}
+	for _, tt := range tests {
+		line, category := extractCategory(tt.line)
+		if line != tt.wantLine || category != tt.wantCategory {
+			t.Errorf("extractCategory(%q) = %q, %q, want %q, %q",
+				tt.line, line, category, tt.wantLine, tt.wantCategory)
+		}
+	}
+}
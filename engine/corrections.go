@@ -0,0 +1,217 @@
+package engine
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"financial-bot/models"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// parseRef reads a transaction reference such as "#123" or "123".
+func parseRef(s string) (int64, error) {
+	return strconv.ParseInt(strings.TrimPrefix(strings.TrimSpace(s), "#"), 10, 64)
+}
+
+// undo deletes the most recent batch recorded by sender in ledger that
+// still has transactions left, from whichever of the sender's devices it
+// was sent.
+func (e *Engine) undo(ctx context.Context, ledger int64, sender string) Reply {
+	batch, err := models.Batches(
+		sentBy(sender),
+		models.BatchWhere.LedgerID.EQ(null.Int64From(ledger)),
+		qm.Where("EXISTS (SELECT 1 FROM transactions t WHERE t.batch_id = batches.id)"),
+		qm.OrderBy("id DESC"),
+	).One(ctx, e.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Reply{Text: "⚠️ Nothing to undo"}
+	}
+	if err != nil {
+		log.Println("Error fetching batch:", err)
+		return Reply{Text: "❌ Error undoing transactions"}
+	}
+
+	transactions, err := e.batchTransactions(ctx, batch.ID)
+	if err != nil {
+		log.Println("Error fetching transactions:", err)
+		return Reply{Text: "❌ Error undoing transactions"}
+	}
+	if reply, ok := e.removable(ctx, transactions); !ok {
+		return reply
+	}
+	if err := e.deleteTransactions(ctx, transactions); err != nil {
+		log.Println("Error deleting transactions:", err)
+		return Reply{Text: "❌ Error undoing transactions"}
+	}
+
+	return e.correctionReply(ctx, ledger, "↩️ *Undone*", e.formatEntries(transactions))
+}
+
+func (e *Engine) deleteTransaction(ctx context.Context, ledger int64, ref string) Reply {
+	tx, reply := e.findTransaction(ctx, ledger, ref, "delete")
+	if tx == nil {
+		return reply
+	}
+
+	// Both legs of a transfer go together
+	transactions := models.TransactionSlice{tx}
+	if tx.Type == "transfer" && tx.BatchID.Valid {
+		var err error
+		if transactions, err = e.batchTransactions(ctx, tx.BatchID); err != nil {
+			log.Println("Error fetching transactions:", err)
+			return Reply{Text: "❌ Error deleting transaction"}
+		}
+	}
+	if reply, ok := e.removable(ctx, transactions); !ok {
+		return reply
+	}
+	if err := e.deleteTransactions(ctx, transactions); err != nil {
+		log.Println("Error deleting transactions:", err)
+		return Reply{Text: "❌ Error deleting transaction"}
+	}
+
+	return e.correctionReply(ctx, ledger, "🗑️ *Deleted*", e.formatEntries(transactions))
+}
+
+// editTransaction handles "edit #123 = 45.000", optionally with a new
+// description before the "=".
+func (e *Engine) editTransaction(ctx context.Context, ledger int64, args string) Reply {
+	ref, rest, _ := strings.Cut(strings.TrimSpace(args), " ")
+	parts := strings.SplitN(rest, "=", 2)
+	if len(parts) < 2 {
+		return Reply{Text: "⚠️ Use: edit #<id> = <amount>"}
+	}
+	amount, err := parseAmount(parts[1])
+	if err != nil {
+		return Reply{Text: "⚠️ " + capitalize(err.Error())}
+	}
+	if amount <= 0 {
+		return Reply{Text: "⚠️ Invalid amount"}
+	}
+
+	tx, reply := e.findTransaction(ctx, ledger, ref, "edit")
+	if tx == nil {
+		return reply
+	}
+	// Changing a loan or repayment could leave more repaid than was lent
+	if tx.Type == "loan" || tx.Type == "repayment" {
+		return Reply{Text: "⚠️ Loans and repayments can't be edited, delete them and record them again"}
+	}
+
+	transactions := models.TransactionSlice{tx}
+	if tx.Type == "transfer" && tx.BatchID.Valid {
+		if transactions, err = e.batchTransactions(ctx, tx.BatchID); err != nil {
+			log.Println("Error fetching transactions:", err)
+			return Reply{Text: "❌ Error editing transaction"}
+		}
+	}
+
+	desc := strings.TrimSpace(parts[0])
+	dbTx, err := e.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Println("Error starting edit:", err)
+		return Reply{Text: "❌ Error editing transaction"}
+	}
+	defer dbTx.Rollback()
+
+	var lines []string
+	var raised int64
+	for _, t := range transactions {
+		oldAmount := t.Amount
+		if t.Amount < 0 || t.Type == "expense" {
+			t.Amount = -amount
+		} else {
+			t.Amount = amount
+		}
+		if desc != "" && t.Type != "transfer" {
+			t.Description = null.StringFrom(desc)
+		}
+		if _, err := t.Update(ctx, dbTx, boil.Whitelist(models.TransactionColumns.Amount, models.TransactionColumns.Description)); err != nil {
+			log.Println("Error updating transaction:", err)
+			return Reply{Text: "❌ Error editing transaction"}
+		}
+		lines = append(lines, fmt.Sprintf("%s (was %s)", e.formatEntry(t), e.signedMoney(oldAmount)))
+		raised = oldAmount - t.Amount
+	}
+	if err := dbTx.Commit(); err != nil {
+		log.Println("Error committing edit:", err)
+		return Reply{Text: "❌ Error editing transaction"}
+	}
+
+	reply = e.correctionReply(ctx, ledger, "✏️ *Edited*", lines)
+
+	// Raising an expense can push its category over budget
+	if tx.Type == "expense" && tx.CategoryID.Valid && raised > 0 {
+		alerts := e.budgetAlerts(ctx, ledger, map[int64]int64{tx.CategoryID.Int64: raised})
+		if len(alerts) > 0 {
+			reply.Text += "\n\n" + strings.Join(alerts, "\n")
+		}
+	}
+	return reply
+}
+
+// findTransaction looks up the transaction named by ref in ledger. When it
+// cannot be found it returns nil and the reply explaining why.
+func (e *Engine) findTransaction(ctx context.Context, ledger int64, ref, action string) (*models.Transaction, Reply) {
+	id, err := parseRef(ref)
+	if err != nil {
+		return nil, Reply{Text: fmt.Sprintf("⚠️ Use: %s #<id>", action)}
+	}
+
+	tx, err := models.Transactions(
+		models.TransactionWhere.ID.EQ(null.Int64From(id)),
+		models.TransactionWhere.LedgerID.EQ(ledger),
+	).One(ctx, e.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, Reply{Text: fmt.Sprintf("⚠️ Transaction #%d not found", id)}
+	}
+	if err != nil {
+		log.Println("Error fetching transaction:", err)
+		return nil, Reply{Text: fmt.Sprintf("❌ Error fetching transaction #%d", id)}
+	}
+	return tx, Reply{}
+}
+
+func (e *Engine) batchTransactions(ctx context.Context, batchID null.Int64) (models.TransactionSlice, error) {
+	return models.Transactions(models.TransactionWhere.BatchID.EQ(batchID), qm.OrderBy("id ASC")).All(ctx, e.db)
+}
+
+func (e *Engine) deleteTransactions(ctx context.Context, transactions models.TransactionSlice) error {
+	dbTx, err := e.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer dbTx.Rollback()
+
+	for _, tx := range transactions {
+		if _, err := tx.Delete(ctx, dbTx); err != nil {
+			return err
+		}
+	}
+	return dbTx.Commit()
+}
+
+func (e *Engine) formatEntries(transactions models.TransactionSlice) []string {
+	lines := make([]string, 0, len(transactions))
+	for _, tx := range transactions {
+		lines = append(lines, e.formatEntry(tx))
+	}
+	return lines
+}
+
+// correctionReply lists the affected transactions under title, followed by
+// the new balance of ledger.
+func (e *Engine) correctionReply(ctx context.Context, ledger int64, title string, lines []string) Reply {
+	balances, err := e.accountBalances(ctx, ledger)
+	if err != nil {
+		log.Println("Error fetching balances:", err)
+	}
+	return Reply{Text: fmt.Sprintf("%s\n%s\n\n%s", title, strings.Join(lines, "\n"), e.formatBalances("New Balance", balances))}
+}
@@ -0,0 +1,130 @@
+package engine
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCorrections(t *testing.T) {
+	now := time.Date(2025, time.June, 28, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		messages []Command
+		want     []string
+		notWant  []string
+	}{
+		{
+			name:     "recorded lines get reference IDs",
+			messages: []Command{{Sender: "me", Text: "expense\nbread = 25.000\nmilk = 10.000"}},
+			want:     []string{"#1 bread: Rp -25.000\n#2 milk: Rp -10.000", "New Balance: Rp -35.000"},
+		},
+		{
+			name: "undo reverses the sender's last batch",
+			messages: []Command{
+				{Sender: "me", Text: "income\nsalary = 100.000"},
+				{Sender: "me", Text: "expense\nbread = 25.000\nmilk = 10.000"},
+				{Sender: "you", Text: "expense\ncoffee = 5.000"},
+				{Sender: "me", Text: "undo"},
+			},
+			want:    []string{"↩️ *Undone*\n#2 bread: Rp -25.000\n#3 milk: Rp -10.000", "New Balance: Rp 95.000"},
+			notWant: []string{"coffee"},
+		},
+		{
+			name: "undo twice goes further back",
+			messages: []Command{
+				{Sender: "me", Text: "income\nsalary = 100.000"},
+				{Sender: "me", Text: "expense\nbread = 25.000"},
+				{Sender: "me", Text: "undo"},
+				{Sender: "me", Text: "undo"},
+			},
+			want: []string{"#1 salary: +Rp 100.000", "New Balance: Rp 0"},
+		},
+		{
+			name: "undo finds the sender's batch from another device",
+			messages: []Command{
+				{Sender: "6281234567890:12@s.whatsapp.net", Text: "expense\nbread = 25.000"},
+				{Sender: "62812345678901@s.whatsapp.net", Text: "expense\ncoffee = 5.000"},
+				{Sender: "6281234567890@s.whatsapp.net", Text: "undo"},
+			},
+			want:    []string{"↩️ *Undone*\n#1 bread: Rp -25.000", "New Balance: Rp -5.000"},
+			notWant: []string{"coffee"},
+		},
+		{
+			name:     "nothing to undo",
+			messages: []Command{{Sender: "me", Text: "undo"}},
+			want:     []string{"Nothing to undo"},
+		},
+		{
+			name: "delete",
+			messages: []Command{
+				{Sender: "me", Text: "expense\nbread = 25.000\nmilk = 10.000"},
+				{Sender: "you", Text: "delete #1"},
+			},
+			want: []string{"🗑️ *Deleted*\n#1 bread: Rp -25.000", "New Balance: Rp -10.000"},
+		},
+		{
+			name:     "delete unknown",
+			messages: []Command{{Sender: "me", Text: "delete #42"}},
+			want:     []string{"Transaction #42 not found"},
+		},
+		{
+			name: "edit amount keeps the sign",
+			messages: []Command{
+				{Sender: "me", Text: "expense\nbread = 25.000"},
+				{Sender: "me", Text: "edit #1 = 45.000"},
+			},
+			want: []string{"#1 bread: Rp -45.000 (was Rp -25.000)", "New Balance: Rp -45.000"},
+		},
+		{
+			name: "edit description and amount",
+			messages: []Command{
+				{Sender: "me", Text: "income\nsalary = 100"},
+				{Sender: "me", Text: "edit 1 bonus = 200"},
+			},
+			want: []string{"#1 bonus: +Rp 200 (was +Rp 100)"},
+		},
+		{
+			name: "transfers are edited and deleted as a pair",
+			messages: []Command{
+				{Sender: "me", Text: "add account bca"},
+				{Sender: "me", Text: "transfer main -> bca = 100"},
+				{Sender: "me", Text: "edit #2 = 300"},
+				{Sender: "me", Text: "balance"},
+			},
+			want: []string{"🏦 bca: Rp 300\n🏦 main: Rp -300"},
+		},
+		{
+			name: "deleting one transfer leg removes both",
+			messages: []Command{
+				{Sender: "me", Text: "add account bca"},
+				{Sender: "me", Text: "transfer main -> bca = 100"},
+				{Sender: "me", Text: "delete #1"},
+			},
+			want: []string{"#1 transfer main -> bca: Rp -100\n#2 transfer main -> bca: +Rp 100"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newTestEngine(t, now)
+
+			var reply string
+			for _, cmd := range tt.messages {
+				reply = e.Handle(context.Background(), cmd)[0].Text
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(reply, want) {
+					t.Errorf("reply %q does not contain %q", reply, want)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(reply, notWant) {
+					t.Errorf("reply %q unexpectedly contains %q", reply, notWant)
+				}
+			}
+		})
+	}
+}
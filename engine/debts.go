@@ -0,0 +1,409 @@
+package engine
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"financial-bot/models"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// Directions of a loan, named after the command that records it.
+const (
+	lend   = "lend"
+	borrow = "borrow"
+)
+
+var dueDatePattern = regexp.MustCompile(`^(.*?)\s+by\s+(\S+)$`)
+
+// counterpartyDebt is a counterparty with what is outstanding between
+// them and the ledger: positive when they owe the ledger, negative when
+// the ledger owes them.
+type counterpartyDebt struct {
+	*models.Counterparty
+	outstanding int64
+}
+
+func (e *Engine) findCounterparty(ctx context.Context, ledger int64, name string) (*models.Counterparty, error) {
+	return models.Counterparties(models.CounterpartyWhere.LedgerID.EQ(ledger), models.CounterpartyWhere.Name.EQ(name)).One(ctx, e.db)
+}
+
+// outstanding returns what is owed between the ledger and counterparty,
+// signed like counterpartyDebt.outstanding.
+func (e *Engine) outstanding(ctx context.Context, counterparty null.Int64) (int64, error) {
+	var amount int64
+	err := e.db.QueryRowContext(ctx, `SELECT COALESCE(-SUM(amount), 0) FROM transactions WHERE counterparty_id = ?`, counterparty).Scan(&amount)
+	return amount, err
+}
+
+// parseDebtEntry parses "<person> = <amount> [@account] [by YYYY-MM-DD]".
+// The person's name has its spaces collapsed; due is empty when no date
+// was given.
+func parseDebtEntry(args string) (ent entry, due string, err error) {
+	args = strings.TrimSpace(args)
+	if m := dueDatePattern.FindStringSubmatch(args); m != nil {
+		args, due = m[1], m[2]
+	}
+	ent, err = parseEntry(args)
+	ent.desc = strings.Join(strings.Fields(ent.desc), " ")
+	return ent, due, err
+}
+
+// describeDebt says who owes whom, such as "budi owes you Rp 300.000".
+func (e *Engine) describeDebt(name string, outstanding int64) string {
+	switch {
+	case outstanding > 0:
+		return fmt.Sprintf("%s owes you %s", name, e.money(outstanding))
+	case outstanding < 0:
+		return fmt.Sprintf("You owe %s %s", name, e.money(-outstanding))
+	}
+	return fmt.Sprintf("✅ All settled with %s", name)
+}
+
+// recordLoan handles "lend <person> = <amount>" and "borrow <person> =
+// <amount>", both optionally with "@account" and "by YYYY-MM-DD". Lending
+// takes the money out of the account, borrowing puts it in. A due date
+// replaces the person's previous one and rearms its reminder.
+func (e *Engine) recordLoan(ctx context.Context, cmd Command, ledger int64, direction, args string) Reply {
+	ent, due, err := parseDebtEntry(args)
+	if errors.Is(err, errNoAmount) {
+		return Reply{Text: fmt.Sprintf("⚠️ Use: %s <person> = <amount> [by YYYY-MM-DD]", direction)}
+	}
+	if err != nil {
+		return Reply{Text: "⚠️ " + capitalize(err.Error())}
+	}
+	if ent.desc == "" {
+		return Reply{Text: "⚠️ Missing person"}
+	}
+	var dueDate null.Time
+	if due != "" {
+		day, err := e.parseDeadline(due)
+		if err != nil {
+			return Reply{Text: "⚠️ " + capitalize(err.Error())}
+		}
+		dueDate = null.TimeFrom(day.UTC())
+	}
+
+	// The counterparty and a new default account are saved together with
+	// the loan, so a failed insert leaves neither a new person nor a moved
+	// due date behind
+	dbTx, err := e.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Println("Error starting loan:", err)
+		return Reply{Text: "❌ Error recording loan"}
+	}
+	defer dbTx.Rollback()
+
+	account, err := e.resolveAccount(ctx, dbTx, ent.account)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Reply{Text: fmt.Sprintf("⚠️ Unknown account %s", ent.account)}
+	}
+	if err != nil {
+		log.Println("Error fetching account:", err)
+		return Reply{Text: "❌ Error recording loan"}
+	}
+
+	counterparty, err := e.findCounterparty(ctx, ledger, ent.desc)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		counterparty = &models.Counterparty{LedgerID: ledger, Name: ent.desc, DueDate: dueDate, Chat: cmd.Chat, CreatedAt: e.timestamp()}
+		err = counterparty.Insert(ctx, dbTx, boil.Infer())
+	case err == nil:
+		counterparty.Chat = cmd.Chat
+		if dueDate.Valid {
+			counterparty.DueDate = dueDate
+			counterparty.RemindedAt = null.Time{}
+		}
+		_, err = counterparty.Update(ctx, dbTx, boil.Infer())
+	}
+	if err != nil {
+		log.Println("Error saving counterparty:", err)
+		return Reply{Text: "❌ Error recording loan"}
+	}
+
+	tx := &models.Transaction{
+		Type:           "loan",
+		Description:    null.StringFrom("lent to " + ent.desc),
+		Amount:         -ent.amount,
+		CreatedAt:      e.timestamp(),
+		AccountID:      account.ID.Int64,
+		LedgerID:       ledger,
+		CounterpartyID: counterparty.ID,
+		Sender:         null.StringFrom(cmd.Sender),
+		SenderName:     null.StringFrom(cmd.SenderName),
+	}
+	if direction == borrow {
+		tx.Description = null.StringFrom("borrowed from " + ent.desc)
+		tx.Amount = ent.amount
+	}
+	if err := e.addBatch(ctx, dbTx, cmd.Sender, ledger, []*models.Transaction{tx}); err != nil {
+		log.Println("Error saving transactions:", err)
+		return Reply{Text: "❌ Error recording loan"}
+	}
+	if err := dbTx.Commit(); err != nil {
+		log.Println("Error saving transactions:", err)
+		return Reply{Text: "❌ Error recording loan"}
+	}
+	return e.debtReply(ctx, ledger, "🤝 *Loan Recorded* 🤝", tx, counterparty)
+}
+
+// repay handles "repay <person> = <amount> [@account]". The direction
+// follows from who owes whom: a person who owes the ledger pays money in,
+// a person the ledger owes is paid out.
+func (e *Engine) repay(ctx context.Context, cmd Command, ledger int64, args string) Reply {
+	ent, due, err := parseDebtEntry(args)
+	if errors.Is(err, errNoAmount) || due != "" {
+		return Reply{Text: "⚠️ Use: repay <person> = <amount>"}
+	}
+	if err != nil {
+		return Reply{Text: "⚠️ " + capitalize(err.Error())}
+	}
+
+	counterparty, err := e.findCounterparty(ctx, ledger, ent.desc)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Reply{Text: fmt.Sprintf("⚠️ Nothing outstanding with %s", ent.desc)}
+	}
+	if err != nil {
+		log.Println("Error fetching counterparty:", err)
+		return Reply{Text: "❌ Error recording repayment"}
+	}
+	owed, err := e.outstanding(ctx, counterparty.ID)
+	if err != nil {
+		log.Println("Error fetching outstanding debt:", err)
+		return Reply{Text: "❌ Error recording repayment"}
+	}
+	if owed == 0 {
+		return Reply{Text: fmt.Sprintf("⚠️ Nothing outstanding with %s", ent.desc)}
+	}
+	if ent.amount > abs(owed) {
+		return Reply{Text: fmt.Sprintf("⚠️ Only %s is outstanding with %s", e.money(abs(owed)), ent.desc)}
+	}
+	account, err := e.resolveAccount(ctx, e.db, ent.account)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Reply{Text: fmt.Sprintf("⚠️ Unknown account %s", ent.account)}
+	}
+	if err != nil {
+		log.Println("Error fetching account:", err)
+		return Reply{Text: "❌ Error recording repayment"}
+	}
+
+	tx := &models.Transaction{
+		Type:           "repayment",
+		Description:    null.StringFrom("repaid by " + ent.desc),
+		Amount:         ent.amount,
+		CreatedAt:      e.timestamp(),
+		AccountID:      account.ID.Int64,
+		LedgerID:       ledger,
+		CounterpartyID: counterparty.ID,
+		Sender:         null.StringFrom(cmd.Sender),
+		SenderName:     null.StringFrom(cmd.SenderName),
+	}
+	if owed < 0 {
+		tx.Description = null.StringFrom("repaid to " + ent.desc)
+		tx.Amount = -ent.amount
+	}
+	if err := e.insertBatch(ctx, cmd.Sender, ledger, []*models.Transaction{tx}); err != nil {
+		log.Println("Error saving transactions:", err)
+		return Reply{Text: "❌ Error recording repayment"}
+	}
+
+	// A settled debt has nothing left to be due
+	if ent.amount == abs(owed) && counterparty.DueDate.Valid {
+		counterparty.DueDate = null.Time{}
+		counterparty.RemindedAt = null.Time{}
+		if _, err := counterparty.Update(ctx, e.db, boil.Whitelist(models.CounterpartyColumns.DueDate, models.CounterpartyColumns.RemindedAt)); err != nil {
+			log.Println("Error clearing due date:", err)
+		}
+	}
+	return e.debtReply(ctx, ledger, "🤝 *Repayment Recorded* 🤝", tx, counterparty)
+}
+
+// removable checks that removing transactions leaves every loan they
+// include covering the repayments made against it, so a deleted loan does
+// not turn its repayments into a debt the other way. When it does not it
+// returns false and the reply explaining why.
+func (e *Engine) removable(ctx context.Context, transactions models.TransactionSlice) (Reply, bool) {
+	type removal struct{ loans, repaid int64 }
+	removed := map[int64]*removal{}
+	for _, tx := range transactions {
+		if !tx.CounterpartyID.Valid {
+			continue
+		}
+		r := removed[tx.CounterpartyID.Int64]
+		if r == nil {
+			r = &removal{}
+			removed[tx.CounterpartyID.Int64] = r
+		}
+		if tx.Type == "loan" {
+			r.loans += tx.Amount
+		} else {
+			r.repaid += tx.Amount
+		}
+	}
+
+	for id, r := range removed {
+		if r.loans == 0 {
+			// Removing repayments only brings the debt back
+			continue
+		}
+		var loans, repaid int64
+		err := e.db.QueryRowContext(ctx, `
+			SELECT COALESCE(SUM(CASE WHEN type = 'loan' THEN amount END), 0),
+			       COALESCE(SUM(CASE WHEN type = 'repayment' THEN amount END), 0)
+			FROM transactions WHERE counterparty_id = ?`, id).Scan(&loans, &repaid)
+		if err != nil {
+			log.Println("Error fetching outstanding debt:", err)
+			return Reply{Text: "❌ Error fetching debts"}, false
+		}
+		loans, repaid = loans-r.loans, repaid-r.repaid
+		// Repayments run against the loans, so they must have the
+		// opposite sign and be no larger
+		if repaid == 0 || (loans*repaid < 0 && abs(repaid) <= abs(loans)) {
+			continue
+		}
+		counterparty, err := models.FindCounterparty(ctx, e.db, null.Int64From(id))
+		if err != nil {
+			log.Println("Error fetching counterparty:", err)
+			return Reply{Text: "❌ Error fetching debts"}, false
+		}
+		return Reply{Text: fmt.Sprintf("⚠️ %s has repaid more than the loans that would be left, delete those repayments first", counterparty.Name)}, false
+	}
+	return Reply{}, true
+}
+
+// debtReply confirms tx with what is now outstanding with counterparty
+// and the new balance of ledger. The reply reports on tx's batch.
+func (e *Engine) debtReply(ctx context.Context, ledger int64, title string, tx *models.Transaction, counterparty *models.Counterparty) Reply {
+	owed, err := e.outstanding(ctx, counterparty.ID)
+	if err != nil {
+		log.Println("Error fetching outstanding debt:", err)
+		return Reply{Text: "❌ Error fetching debts"}
+	}
+	status := e.describeDebt(counterparty.Name, owed)
+	if owed != 0 && counterparty.DueDate.Valid {
+		status += ", due " + counterparty.DueDate.Time.In(e.loc).Format("02 Jan 2006")
+	}
+	reply := e.correctionReply(ctx, ledger, title, []string{e.formatEntry(tx), status})
+	reply.Batch = tx.BatchID.Int64
+	return reply
+}
+
+// loadDebts returns the counterparties of ledger matching mods that
+// something is outstanding with, sorted by name.
+func (e *Engine) loadDebts(ctx context.Context, ledger int64, mods ...qm.QueryMod) ([]counterpartyDebt, error) {
+	counterparties, err := models.Counterparties(append(mods, models.CounterpartyWhere.LedgerID.EQ(ledger), qm.OrderBy("name ASC"))...).All(ctx, e.db)
+	if err != nil {
+		return nil, err
+	}
+	var debts []counterpartyDebt
+	for _, counterparty := range counterparties {
+		owed, err := e.outstanding(ctx, counterparty.ID)
+		if err != nil {
+			return nil, err
+		}
+		if owed != 0 {
+			debts = append(debts, counterpartyDebt{Counterparty: counterparty, outstanding: owed})
+		}
+	}
+	return debts, nil
+}
+
+// overdue reports whether the day debt is due on has passed.
+func (e *Engine) overdue(debt counterpartyDebt) bool {
+	return debt.DueDate.Valid && !e.now().Before(debt.DueDate.Time.AddDate(0, 0, 1))
+}
+
+func (e *Engine) getDebts(ctx context.Context, ledger int64) Reply {
+	debts, err := e.loadDebts(ctx, ledger)
+	if err != nil {
+		log.Println("Error fetching debts:", err)
+		return Reply{Text: "❌ Error fetching debts"}
+	}
+	if len(debts) == 0 {
+		return Reply{Text: "🤝 No outstanding debts. Record one with: lend <person> = <amount> or borrow <person> = <amount>"}
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("🤝 *Debts*\nDate: %s", e.localNow().Format("2006-01-02")))
+	sections := []struct {
+		title string
+		sign  int64
+	}{
+		{"Owed to you", 1},
+		{"You owe", -1},
+	}
+	var net int64
+	for _, section := range sections {
+		var lines []string
+		var sum int64
+		for _, debt := range debts {
+			if debt.outstanding*section.sign < 0 {
+				continue
+			}
+			sum += abs(debt.outstanding)
+			line := fmt.Sprintf("• %s: %s", debt.Name, e.money(abs(debt.outstanding)))
+			if debt.DueDate.Valid {
+				due := debt.DueDate.Time.In(e.loc).Format("02 Jan 2006")
+				if e.overdue(debt) {
+					line += fmt.Sprintf(" ⚠️ overdue since %s", due)
+				} else {
+					line += fmt.Sprintf(" (due %s)", due)
+				}
+			}
+			lines = append(lines, line)
+		}
+		if len(lines) == 0 {
+			continue
+		}
+		net += sum * section.sign
+		sb.WriteString(fmt.Sprintf("\n\n*%s*: %s\n%s", section.title, e.money(sum), strings.Join(lines, "\n")))
+	}
+	sb.WriteString(fmt.Sprintf("\n\n💵 *Net*: %s", e.signedMoney(net)))
+	return Reply{Text: sb.String()}
+}
+
+// remindDebts returns one reminder per counterparty whose due date passed
+// with money still outstanding, addressed to the chat the loan was last
+// recorded in. Each due date is reminded about once.
+func (e *Engine) remindDebts(ctx context.Context) []Notice {
+	counterparties, err := models.Counterparties(
+		models.CounterpartyWhere.DueDate.IsNotNull(),
+		models.CounterpartyWhere.RemindedAt.IsNull(),
+		models.CounterpartyWhere.Chat.NEQ(""),
+		qm.OrderBy("due_date ASC, id ASC"),
+	).All(ctx, e.db)
+	if err != nil {
+		log.Println("Error fetching due debts:", err)
+		return nil
+	}
+
+	var notices []Notice
+	for _, counterparty := range counterparties {
+		owed, err := e.outstanding(ctx, counterparty.ID)
+		if err != nil {
+			log.Println("Error fetching outstanding debt:", err)
+			continue
+		}
+		debt := counterpartyDebt{Counterparty: counterparty, outstanding: owed}
+		if owed == 0 || !e.overdue(debt) {
+			continue
+		}
+
+		counterparty.RemindedAt = null.TimeFrom(e.timestamp())
+		if _, err := counterparty.Update(ctx, e.db, boil.Whitelist(models.CounterpartyColumns.RemindedAt)); err != nil {
+			log.Println("Error saving debt reminder:", err)
+			continue
+		}
+		text := fmt.Sprintf("⏰ *Debt Reminder* ⏰\n%s, due %s", e.describeDebt(counterparty.Name, owed),
+			counterparty.DueDate.Time.In(e.loc).Format("02 Jan 2006"))
+		notices = append(notices, Notice{Chat: counterparty.Chat, Reply: Reply{Text: text}})
+	}
+	return notices
+}
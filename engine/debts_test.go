@@ -0,0 +1,150 @@
+package engine
+
+import (
+	"context"
+	"financial-bot/models"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDebts(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, time.October, 17, 10, 0, 0, 0, time.UTC)
+	e := newTestEngineWithClock(t, func() time.Time { return now })
+	send := func(text string) string {
+		t.Helper()
+		return e.Handle(ctx, Command{Sender: "me", Chat: "family@g.us", Text: text})[0].Text
+	}
+
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{"nothing outstanding", "debts", []string{"🤝 No outstanding debts"}},
+		{"income", "income\nsalary = 2jt", []string{"New Balance: Rp 2.000.000"}},
+		{"lending takes money out", "lend Budi = 500rb by 2026-10-31", []string{
+			"#2 lent to budi: Rp -500.000\nbudi owes you Rp 500.000, due 31 Oct 2026",
+			"New Balance: Rp 1.500.000",
+		}},
+		{"borrowing puts money in", "borrow Om Joko = 1jt", []string{
+			"#3 borrowed from om joko: +Rp 1.000.000\nYou owe om joko Rp 1.000.000",
+			"New Balance: Rp 2.500.000",
+		}},
+		{"partial repayment received", "repay budi = 200rb", []string{
+			"#4 repaid by budi: +Rp 200.000\nbudi owes you Rp 300.000, due 31 Oct 2026",
+			"New Balance: Rp 2.700.000",
+		}},
+		{"repaying more than is owed", "repay om joko = 2jt", []string{"⚠️ Only Rp 1.000.000 is outstanding with om joko"}},
+		{"repaying a stranger", "repay sari = 1jt", []string{"⚠️ Nothing outstanding with sari"}},
+		{"repaying what we owe", "repay om joko = 400rb", []string{
+			"#5 repaid to om joko: Rp -400.000\nYou owe om joko Rp 600.000",
+			"New Balance: Rp 2.300.000",
+		}},
+		{"outstanding per person", "debts", []string{
+			"*Owed to you*: Rp 300.000\n• budi: Rp 300.000 (due 31 Oct 2026)",
+			"*You owe*: Rp 600.000\n• om joko: Rp 600.000",
+			"💵 *Net*: Rp -300.000",
+		}},
+		{"invalid due date", "lend budi = 1jt by tomorrow", []string{`⚠️ Invalid date "tomorrow"`}},
+		{"missing amount", "lend budi", []string{"⚠️ Use: lend <person> = <amount>"}},
+		{"repaying with a due date", "repay budi = 100rb by 2026-12-31", []string{"⚠️ Use: repay <person> = <amount>"}},
+	}
+	for _, tt := range tests {
+		got := send(tt.text)
+		for _, want := range tt.want {
+			if !strings.Contains(got, want) {
+				t.Errorf("%s: reply %q, want %q", tt.name, got, want)
+			}
+		}
+	}
+
+	if notices := e.Tick(ctx); len(notices) != 0 {
+		t.Fatalf("reminded before the due date passed: %+v", notices)
+	}
+	now = time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC)
+	notices := e.Tick(ctx)
+	if len(notices) != 1 || notices[0].Chat != "family@g.us" ||
+		notices[0].Reply.Text != "⏰ *Debt Reminder* ⏰\nbudi owes you Rp 300.000, due 31 Oct 2026" {
+		t.Fatalf("expected one reminder about budi, got %+v", notices)
+	}
+	if notices := e.Tick(ctx); len(notices) != 0 {
+		t.Fatalf("reminded twice: %+v", notices)
+	}
+	if got := send("debts"); !strings.Contains(got, "• budi: Rp 300.000 ⚠️ overdue since 31 Oct 2026") {
+		t.Errorf("overdue debt: %q", got)
+	}
+
+	// Settling up clears the due date, so a new loan is not reminded about
+	if got := send("repay budi = 300rb"); !strings.Contains(got, "✅ All settled with budi") {
+		t.Errorf("settling up: %q", got)
+	}
+	send("lend budi = 100rb")
+	now = now.AddDate(0, 1, 0)
+	if notices := e.Tick(ctx); len(notices) != 0 {
+		t.Fatalf("reminded about a loan without a due date: %+v", notices)
+	}
+	if got := send("category report 2026-10"); strings.Contains(got, "budi") || strings.Contains(got, "joko") {
+		t.Errorf("loans counted as income or expense: %q", got)
+	}
+}
+
+func TestLoanIsAllOrNothing(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEngine(t, time.Date(2026, time.October, 17, 10, 0, 0, 0, time.UTC))
+	send := func(text string) string {
+		t.Helper()
+		return e.Handle(ctx, Command{Sender: "me", Chat: "family@g.us", Text: text})[0].Text
+	}
+	send("lend budi = 100rb by 2026-10-31")
+
+	// Make every later loan insert fail
+	if _, err := e.db.Exec(`CREATE TRIGGER fail_loan BEFORE INSERT ON transactions
+		WHEN NEW.type = 'loan' BEGIN SELECT RAISE(ABORT, 'boom'); END`); err != nil {
+		t.Fatalf("create trigger: %v", err)
+	}
+	for _, text := range []string{"lend sari = 1jt", "lend budi = 200rb by 2026-12-31"} {
+		if got := send(text); got != "❌ Error recording loan" {
+			t.Errorf("%s: reply %q", text, got)
+		}
+	}
+
+	if exists, err := models.Counterparties(models.CounterpartyWhere.Name.EQ("sari")).Exists(ctx, e.db); err != nil || exists {
+		t.Errorf("a failed loan left its person behind: %v, %v", exists, err)
+	}
+	if got := send("debts"); !strings.Contains(got, "• budi: Rp 100.000 (due 31 Oct 2026)") {
+		t.Errorf("a failed loan moved the due date: %q", got)
+	}
+}
+
+func TestLoanCorrections(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEngine(t, time.Date(2026, time.October, 17, 10, 0, 0, 0, time.UTC))
+	send := func(text string) string {
+		t.Helper()
+		return e.Handle(ctx, Command{Sender: "me", Chat: "family@g.us", Text: text})[0].Text
+	}
+	send("lend budi = 500rb")  // #1
+	send("lend budi = 300rb")  // #2
+	send("repay budi = 400rb") // #3
+
+	tests := []struct {
+		name, text, want string
+	}{
+		{"a loan can't be edited", "edit #1 = 100rb", "⚠️ Loans and repayments can't be edited"},
+		{"nor a repayment", "edit #3 = 100rb", "⚠️ Loans and repayments can't be edited"},
+		{"deleting a loan that leaves too little lent", "delete #1", "⚠️ budi has repaid more than the loans that would be left"},
+		{"deleting a loan that still covers the repayments", "delete #2", "🗑️ *Deleted*\n#2 lent to budi: Rp -300.000"},
+		{"the repayment can go first", "delete #3", "🗑️ *Deleted*\n#3 repaid by budi: +Rp 400.000"},
+		{"then the loan", "undo", "↩️ *Undone*\n#1 lent to budi: Rp -500.000"},
+	}
+	for _, tt := range tests {
+		if got := send(tt.text); !strings.Contains(got, tt.want) {
+			t.Errorf("%s: reply %q, want %q", tt.name, got, tt.want)
+		}
+	}
+	if got := send("debts"); !strings.Contains(got, "No outstanding debts") {
+		t.Errorf("debts after the corrections: %q", got)
+	}
+}
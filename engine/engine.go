@@ -0,0 +1,315 @@
+// Package engine implements the bookkeeping commands understood by the bot.
+// It knows nothing about WhatsApp: a transport turns incoming messages into
+// Commands and delivers the returned Replies.
+package engine
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"maps"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// Command is a single message addressed to the bot.
+type Command struct {
+	// Sender identifies who sent the message, e.g. a WhatsApp JID.
+	Sender string
+	// SenderName is the sender's friendly name, shown in reports.
+	SenderName string
+	// Chat identifies the conversation the message was sent in. Notices
+	// about things the command set up, such as recurring transactions, are
+	// addressed to it.
+	Chat string
+	// Text is the raw message body, or the caption of a photo or document.
+	Text string
+	// Document is the photo or document the message carried, if any.
+	Document *Document
+	// QuotedID is the ID of the message this one replies to, if any.
+	QuotedID string
+	// MessageID is the ID of the message itself, if the transport has
+	// one. Edits and deletions of the message later refer to it.
+	MessageID string
+	// SentAt is when the message was sent. Transactions it records are
+	// dated then, which matters for messages handled long after, such as
+	// those sent while the bot was offline. Zero means now.
+	SentAt time.Time
+}
+
+// Reply is a message to send back to the chat the command came from.
+type Reply struct {
+	Text string
+	// Document, when set, is sent as a file with Text as its caption;
+	// images are sent as photos.
+	Document *Document
+	// Batch is the batch of transactions the reply reports on, if any.
+	// Transports pass it to LinkMessage along with the ID of the sent
+	// message.
+	Batch int64
+}
+
+// Document is a file attached to a command or a reply.
+type Document struct {
+	FileName string
+	MimeType string
+	Data     []byte
+	// Download, when set, fetches Data on first use. Transports use it so
+	// only attachments of commands that need them are downloaded, after
+	// access control and duplicate checks.
+	Download func() ([]byte, error)
+}
+
+// data returns the contents of d, downloading them if needed.
+func (d *Document) data() ([]byte, error) {
+	if d.Data == nil && d.Download != nil {
+		data, err := d.Download()
+		if err != nil {
+			return nil, err
+		}
+		d.Data = data
+	}
+	return d.Data, nil
+}
+
+// Engine executes commands against the finance database.
+type Engine struct {
+	db             *sql.DB
+	now            func() time.Time
+	loc            *time.Location
+	currency       string
+	defaultAccount string
+	accessControl  bool
+	mappings       map[string]ImportMapping
+	receiptDir     string
+	noRecurring    bool
+	// duplicates counts the redelivered messages skipped since start
+	duplicates *atomic.Int64
+}
+
+// Option customises an Engine created by New.
+type Option func(*Engine)
+
+// WithDefaultAccount sets the account used by income and expense lines that
+// do not name one. It is created on first use if it does not exist.
+func WithDefaultAccount(name string) Option {
+	return func(e *Engine) {
+		if name != "" {
+			e.defaultAccount = strings.ToLower(name)
+		}
+	}
+}
+
+// WithLocation sets the household's timezone, which decides where days,
+// weeks and months begin in reports and budgets. The default is time.Local.
+func WithLocation(loc *time.Location) Option {
+	return func(e *Engine) {
+		if loc != nil {
+			e.loc = loc
+		}
+	}
+}
+
+// WithCurrency sets the prefix amounts are shown with. The default is
+// "Rp".
+func WithCurrency(prefix string) Option {
+	return func(e *Engine) {
+		if prefix != "" {
+			e.currency = prefix
+		}
+	}
+}
+
+// New returns an Engine backed by db. now is used as the clock for
+// recorded transactions and relative reports; nil means time.Now.
+func New(db *sql.DB, now func() time.Time, opts ...Option) *Engine {
+	if now == nil {
+		now = time.Now
+	}
+	e := &Engine{
+		db: db, now: now, loc: time.Local, currency: defaultCurrency, defaultAccount: DefaultAccountName,
+		mappings: maps.Clone(importPresets), duplicates: new(atomic.Int64),
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
+}
+
+// localNow returns the current time in the household's timezone.
+func (e *Engine) localNow() time.Time {
+	return e.now().In(e.loc)
+}
+
+// timestamp returns the current time in UTC, the canonical form every
+// timestamp is stored in.
+func (e *Engine) timestamp() time.Time {
+	return e.now().UTC()
+}
+
+// at returns a copy of e whose clock stands still at t.
+func (e *Engine) at(t time.Time) *Engine {
+	c := *e
+	c.now = func() time.Time { return t }
+	return &c
+}
+
+// Handle runs cmd against the ledger its chat is bound to and returns the
+// replies to send. Messages that are not commands, and with access control
+// messages from non-members of the ledger, produce no replies.
+func (e *Engine) Handle(ctx context.Context, cmd Command) []Reply {
+	if !cmd.SentAt.IsZero() {
+		e = e.at(cmd.SentAt)
+	}
+	replies := e.handle(ctx, cmd)
+	// Edits and deletions of the message apply to what it recorded
+	for _, reply := range replies {
+		if err := e.LinkMessage(ctx, cmd.Chat, cmd.MessageID, reply.Batch); err != nil {
+			log.Println("Error linking message:", err)
+		}
+	}
+	return replies
+}
+
+func (e *Engine) handle(ctx context.Context, cmd Command) []Reply {
+	content := strings.ToLower(strings.TrimSpace(cmd.Text))
+	if content == "" {
+		return nil
+	}
+	// Phones like to turn ' into ’
+	content = strings.ReplaceAll(content, "’", "'")
+	args := strings.Split(content, "\n")
+
+	ledger, role, ok := e.access(ctx, &cmd)
+	if !ok {
+		return nil
+	}
+	id := ledger.ID.Int64
+	// as runs a command that needs at least the required role
+	as := func(required string, run func() Reply) []Reply {
+		if !hasRole(role, required) {
+			return []Reply{{Text: fmt.Sprintf("⛔ Only %ss can do that, you are a %s", required, role)}}
+		}
+		return []Reply{run()}
+	}
+
+	args[0] = strings.TrimSpace(args[0])
+	switch args[0] {
+	case "income":
+		return as(roleWriter, func() Reply { return e.processTransaction(ctx, cmd, id, "income", args[1:]) })
+	case "expense":
+		return as(roleWriter, func() Reply { return e.processTransaction(ctx, cmd, id, "expense", args[1:]) })
+	case "undo":
+		return as(roleWriter, func() Reply { return e.undo(ctx, id, cmd.Sender) })
+	case "delete":
+		return as(roleWriter, func() Reply { return e.deleteQuoted(ctx, cmd, id) })
+	case "ledger":
+		return []Reply{e.showLedger(ledger)}
+	case "ledgers":
+		return []Reply{e.listLedgers(ctx, cmd.Sender, ledger)}
+	case "members":
+		return []Reply{e.listMembers(ctx, id)}
+	case "recurring":
+		return []Reply{e.listRecurring(ctx, id)}
+	case "yesterday":
+		return []Reply{e.withPeriod(ctx, id, "yesterday", e.getMutations)}
+	case "categories":
+		return []Reply{e.listCategories(ctx)}
+	case "budget status":
+		return []Reply{e.getBudgetStatus(ctx, id)}
+	case "goals":
+		return []Reply{e.getGoals(ctx, id)}
+	case "debts":
+		return []Reply{e.getDebts(ctx, id)}
+	case "summaries":
+		return []Reply{e.listSummaries(ctx, cmd.Chat)}
+	case "balance", "accounts":
+		return []Reply{e.getBalance(ctx, id)}
+	default:
+		if p, ok := strings.CutSuffix(args[0], "'s mutation"); ok {
+			return []Reply{e.withPeriod(ctx, id, p, e.getMutations)}
+		}
+		if p, ok := strings.CutPrefix(args[0], "mutation "); ok {
+			return []Reply{e.withPeriod(ctx, id, p, e.getMutations)}
+		}
+		if p, ok := strings.CutPrefix(args[0], "category report"); ok {
+			return []Reply{e.withPeriod(ctx, id, p, e.getCategoryReport)}
+		}
+		if p, ok := strings.CutPrefix(args[0], "who spent"); ok {
+			return []Reply{e.withPeriod(ctx, id, p, e.getMemberReport)}
+		}
+		if args[0] == "export" || strings.HasPrefix(args[0], "export ") {
+			return []Reply{e.export(ctx, ledger, strings.TrimPrefix(args[0], "export"))}
+		}
+		if args[0] == "import" || strings.HasPrefix(args[0], "import ") {
+			return as(roleWriter, func() Reply { return e.importCommand(ctx, cmd, id, strings.TrimPrefix(args[0], "import")) })
+		}
+		if strings.HasPrefix(args[0], "use ledger ") {
+			return as(roleAdmin, func() Reply { return e.useLedger(ctx, cmd, strings.TrimPrefix(args[0], "use ledger ")) })
+		}
+		if strings.HasPrefix(args[0], "add member ") {
+			return as(roleAdmin, func() Reply { return e.addMember(ctx, id, strings.TrimPrefix(args[0], "add member ")) })
+		}
+		if strings.HasPrefix(args[0], "remove member ") {
+			return as(roleAdmin, func() Reply { return e.removeMember(ctx, id, strings.TrimPrefix(args[0], "remove member ")) })
+		}
+		if strings.HasPrefix(args[0], "add category ") {
+			return as(roleWriter, func() Reply { return e.addCategory(ctx, strings.TrimPrefix(args[0], "add category ")) })
+		}
+		if strings.HasPrefix(args[0], "remove category ") {
+			return as(roleWriter, func() Reply { return e.removeCategory(ctx, strings.TrimPrefix(args[0], "remove category ")) })
+		}
+		if strings.HasPrefix(args[0], "add account ") {
+			return as(roleWriter, func() Reply { return e.addAccount(ctx, strings.TrimPrefix(args[0], "add account ")) })
+		}
+		if strings.HasPrefix(args[0], "transfer ") {
+			return as(roleWriter, func() Reply { return e.transfer(ctx, cmd, id, strings.TrimPrefix(args[0], "transfer ")) })
+		}
+		if strings.HasPrefix(args[0], "receipt ") {
+			return []Reply{e.sendReceipt(ctx, id, strings.TrimPrefix(args[0], "receipt "))}
+		}
+		if strings.HasPrefix(args[0], "delete ") {
+			return as(roleWriter, func() Reply { return e.deleteTransaction(ctx, id, strings.TrimPrefix(args[0], "delete ")) })
+		}
+		if rest, ok := strings.CutPrefix(args[0], "edit "); ok {
+			if cmd.QuotedID != "" && !strings.HasPrefix(strings.TrimSpace(rest), "#") {
+				return as(roleWriter, func() Reply { return e.editQuoted(ctx, cmd, id, rest) })
+			}
+			return as(roleWriter, func() Reply { return e.editTransaction(ctx, id, rest) })
+		}
+		if strings.HasPrefix(args[0], "recurring ") {
+			return as(roleWriter, func() Reply {
+				return e.addRecurring(ctx, cmd.Chat, id, strings.TrimPrefix(args[0], "recurring "), args[1:])
+			})
+		}
+		if strings.HasPrefix(args[0], "cancel recurring ") {
+			return as(roleWriter, func() Reply { return e.cancelRecurring(ctx, id, strings.TrimPrefix(args[0], "cancel recurring ")) })
+		}
+		if strings.HasPrefix(args[0], "budget ") {
+			return as(roleWriter, func() Reply { return e.setBudget(ctx, id, strings.TrimPrefix(args[0], "budget ")) })
+		}
+		if strings.HasPrefix(args[0], "goal ") {
+			return as(roleWriter, func() Reply { return e.setGoal(ctx, id, strings.TrimPrefix(args[0], "goal ")) })
+		}
+		if strings.HasPrefix(args[0], "save ") {
+			return as(roleWriter, func() Reply { return e.save(ctx, cmd, id, strings.TrimPrefix(args[0], "save ")) })
+		}
+		if strings.HasPrefix(args[0], "lend ") {
+			return as(roleWriter, func() Reply { return e.recordLoan(ctx, cmd, id, lend, strings.TrimPrefix(args[0], "lend ")) })
+		}
+		if strings.HasPrefix(args[0], "borrow ") {
+			return as(roleWriter, func() Reply { return e.recordLoan(ctx, cmd, id, borrow, strings.TrimPrefix(args[0], "borrow ")) })
+		}
+		for _, kind := range []string{summaryDaily, summaryMonthly} {
+			if rest, ok := strings.CutPrefix(args[0], kind+" summary"); ok && (rest == "" || rest[0] == ' ') {
+				return as(roleWriter, func() Reply { return e.scheduleSummary(ctx, cmd.Chat, kind, rest) })
+			}
+		}
+		if strings.HasPrefix(args[0], "repay ") {
+			return as(roleWriter, func() Reply { return e.repay(ctx, cmd, id, strings.TrimPrefix(args[0], "repay ")) })
+		}
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package engine
+
+import (
+	"context"
+	"financial-bot/database"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestEngine(t *testing.T, now time.Time, opts ...Option) *Engine {
+	t.Helper()
+	return newTestEngineWithClock(t, func() time.Time { return now }, opts...)
+}
+
+// newTestEngineWithClock returns an engine on a fresh database. The
+// household timezone is UTC unless opts say otherwise.
+func newTestEngineWithClock(t *testing.T, clock func() time.Time, opts ...Option) *Engine {
+	t.Helper()
+	db, err := database.Open(filepath.Join(t.TempDir(), "app.db"))
+	if err != nil {
+		t.Fatalf("open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return New(db, clock, append([]Option{WithLocation(time.UTC)}, opts...)...)
+}
+
+func TestHandle(t *testing.T) {
+	now := time.Date(2025, time.June, 28, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		messages []string
+		want     []string
+	}{
+		{
+			name:     "not a command",
+			messages: []string{"hello"},
+			want:     nil,
+		},
+		{
+			name:     "income updates balance",
+			messages: []string{"Income\nsalary = 10.000.000"},
+			want:     []string{"New Balance: Rp 10.000.000"},
+		},
+		{
+			name:     "expense is subtracted",
+			messages: []string{"income\nsalary = 100.000", "expense\nfood = 25.000\ncoffee = 5.000"},
+			want:     []string{"New Balance: Rp 70.000"},
+		},
+		{
+			name:     "lines without amount are rejected",
+			messages: []string{"expense\nno amount here\nfood = 1.000"},
+			want:     []string{"✅ Recorded:\n#1 food: Rp -1.000", "❌ Rejected:\n• no amount here → missing \"= amount\"", "New Balance: Rp -1.000"},
+		},
+		{
+			name:     "today's mutation lists entries",
+			messages: []string{"expense\nfood = 25.000", "today's mutation"},
+			want:     []string{"Period: 2025-06-28", "food: Rp -25.000", "*Total Balance*: Rp -25.000"},
+		},
+		{
+			name:     "month's mutation",
+			messages: []string{"income\nsalary = 5.000", "month's mutation"},
+			want:     []string{"Period: Month of June 2025", "salary: +Rp 5.000"},
+		},
+		{
+			name:     "empty day",
+			messages: []string{"mutation date 2025-06-01"},
+			want:     []string{"No transactions found"},
+		},
+		{
+			name:     "unknown category is reported",
+			messages: []string{"expense\nbread = 10.000 #groceries"},
+			want:     []string{"New Balance: Rp -10.000", "Unknown category #groceries"},
+		},
+		{
+			name:     "add category",
+			messages: []string{"add category groceries", "categories"},
+			want:     []string{"• groceries"},
+		},
+		{
+			name:     "invalid category name",
+			messages: []string{"add category school fees"},
+			want:     []string{"may only contain"},
+		},
+		{
+			name: "category report",
+			messages: []string{
+				"add category groceries",
+				"add category school",
+				"expense\nbread = 25.000 #groceries\nspp = 50.000 category: school\nmilk = 25.000 #groceries\nparking = 20.000",
+				"income\nsalary = 1.000.000",
+				"category report",
+			},
+			want: []string{
+				"*Expenses*: Rp 120.000",
+				"• groceries: Rp 50.000 (41.7%)",
+				"• school: Rp 50.000 (41.7%)",
+				"• uncategorized: Rp 20.000 (16.7%)",
+				"*Income*: Rp 1.000.000",
+				"• uncategorized: Rp 1.000.000 (100.0%)",
+			},
+		},
+		{
+			name: "category report without income or expenses",
+			messages: []string{
+				"add account cash",
+				"transfer main -> cash = 100.000",
+				"category report",
+			},
+			want: []string{"📂 *Category Report*\nPeriod: Month of June 2025\n\nNo transactions found"},
+		},
+		{
+			name:     "invalid date",
+			messages: []string{"mutation date 28-06-2025"},
+			want:     []string{"Invalid period \"28-06-2025\"", periodHelp},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newTestEngine(t, now)
+
+			var replies []Reply
+			for _, msg := range tt.messages {
+				replies = e.Handle(context.Background(), Command{Sender: "me", Text: msg})
+			}
+
+			if tt.want == nil {
+				if len(replies) != 0 {
+					t.Fatalf("expected no replies, got %+v", replies)
+				}
+				return
+			}
+			if len(replies) != 1 {
+				t.Fatalf("expected 1 reply, got %d", len(replies))
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(replies[0].Text, want) {
+					t.Errorf("reply %q does not contain %q", replies[0].Text, want)
+				}
+			}
+		})
+	}
+}
+
+func TestFormatCurrency(t *testing.T) {
+	tests := []struct {
+		amount int64
+		want   string
+	}{
+		{0, "0"},
+		{999, "999"},
+		{1000, "1.000"},
+		{-25000, "-25.000"},
+		{10000000, "10.000.000"},
+	}
+	for _, tt := range tests {
+		if got := formatCurrency(tt.amount); got != tt.want {
+			t.Errorf("formatCurrency(%d) = %q, want %q", tt.amount, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package engine
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"financial-bot/models"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// Export formats.
+const (
+	formatCSV  = "csv"
+	formatXLSX = "xlsx"
+)
+
+var (
+	exportMimeTypes = map[string]string{
+		formatCSV:  "text/csv",
+		formatXLSX: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	}
+	exportHeader = []string{"id", "date", "type", "description", "category", "account", "amount", "member"}
+)
+
+// exportRow is a transaction as written to an export. Amount is signed,
+// like in the database.
+type exportRow struct {
+	id          int64
+	date        time.Time
+	txType      string
+	description string
+	category    string
+	account     string
+	amount      int64
+	member      string
+}
+
+func (r exportRow) strings(loc *time.Location) []string {
+	return []string{
+		strconv.FormatInt(r.id, 10), r.date.In(loc).Format("2006-01-02 15:04"), r.txType,
+		r.description, r.category, r.account, strconv.FormatInt(r.amount, 10), r.member,
+	}
+}
+
+// Export renders the transactions of the named ledger, or the default
+// ledger when name is empty, in the period described by periodText (such
+// as "2026-09" or "last month", the current month when empty) as a CSV or
+// XLSX document. It also returns the number of transactions exported.
+func (e *Engine) Export(ctx context.Context, ledgerName, periodText, format string) (*Document, int, error) {
+	if ledgerName == "" {
+		ledgerName = DefaultLedgerName
+	}
+	ledger, err := e.findLedger(ctx, strings.ToLower(ledgerName))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, 0, fmt.Errorf("unknown ledger %s", ledgerName)
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	if strings.TrimSpace(periodText) == "" {
+		periodText = "this month"
+	}
+	p, err := parsePeriod(strings.ToLower(periodText), e.localNow())
+	if err != nil {
+		return nil, 0, err
+	}
+	return e.exportDocument(ctx, ledger, p, format)
+}
+
+// export handles "export [period] [csv|xlsx]", replying with the document.
+func (e *Engine) export(ctx context.Context, ledger *models.Ledger, args string) Reply {
+	format := formatCSV
+	fields := strings.Fields(args)
+	if n := len(fields); n > 0 && exportMimeTypes[fields[n-1]] != "" {
+		format, fields = fields[n-1], fields[:n-1]
+	}
+	text := strings.Join(fields, " ")
+	if text == "" {
+		text = "this month"
+	}
+	p, err := parsePeriod(text, e.localNow())
+	if err != nil {
+		return invalidPeriodReply(err)
+	}
+
+	doc, count, err := e.exportDocument(ctx, ledger, p, format)
+	if err != nil {
+		log.Println("Error exporting transactions:", err)
+		return Reply{Text: "❌ Error exporting transactions"}
+	}
+	if count == 0 {
+		return Reply{Text: fmt.Sprintf("📤 No transactions to export\nPeriod: %s", p.label)}
+	}
+	return Reply{Text: fmt.Sprintf("📤 *Export*\nPeriod: %s\n%d transactions", p.label, count), Document: doc}
+}
+
+func (e *Engine) exportDocument(ctx context.Context, ledger *models.Ledger, p period, format string) (*Document, int, error) {
+	mimeType, ok := exportMimeTypes[format]
+	if !ok {
+		return nil, 0, fmt.Errorf("format %q must be csv or xlsx", format)
+	}
+	rows, err := e.exportRows(ctx, ledger.ID.Int64, p)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var data []byte
+	if format == formatXLSX {
+		data, err = e.writeXLSX(rows)
+	} else {
+		data, err = e.writeCSV(rows)
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+
+	last := p.end.In(e.loc).AddDate(0, 0, -1)
+	name := fmt.Sprintf("%s-%s_%s.%s", ledger.Name, p.start.In(e.loc).Format("2006-01-02"), last.Format("2006-01-02"), format)
+	return &Document{FileName: name, MimeType: mimeType, Data: data}, len(rows), nil
+}
+
+// exportRows returns the transactions of ledger in p, oldest first.
+func (e *Engine) exportRows(ctx context.Context, ledger int64, p period) ([]exportRow, error) {
+	rows, err := e.db.QueryContext(ctx, `
+		SELECT t.id, t.created_at, t.type, COALESCE(t.description, ''), COALESCE(c.name, ''), a.name, t.amount,
+			COALESCE(t.sender, ''), COALESCE(t.sender_name, '')
+		FROM transactions t
+		JOIN accounts a ON a.id = t.account_id
+		LEFT JOIN categories c ON c.id = t.category_id
+		WHERE t.ledger_id = ? AND t.created_at >= ? AND t.created_at < ?
+		ORDER BY t.created_at, t.id`,
+		ledger, p.start, p.end)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []exportRow
+	for rows.Next() {
+		var r exportRow
+		var sender, senderName string
+		if err := rows.Scan(&r.id, &r.date, &r.txType, &r.description, &r.category, &r.account, &r.amount, &sender, &senderName); err != nil {
+			return nil, err
+		}
+		r.member = displayName(sender, senderName)
+		result = append(result, r)
+	}
+	return result, rows.Err()
+}
+
+func (e *Engine) writeCSV(rows []exportRow) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write(exportHeader)
+	for _, r := range rows {
+		w.Write(r.strings(e.loc))
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+// writeXLSX writes rows to a single sheet, with amounts as numbers so they
+// can be summed in a spreadsheet.
+func (e *Engine) writeXLSX(rows []exportRow) ([]byte, error) {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	const sheet = "Transactions"
+	if err := f.SetSheetName("Sheet1", sheet); err != nil {
+		return nil, err
+	}
+	header := make([]any, len(exportHeader))
+	for i, h := range exportHeader {
+		header[i] = h
+	}
+	if err := f.SetSheetRow(sheet, "A1", &header); err != nil {
+		return nil, err
+	}
+	for i, r := range rows {
+		cell, err := excelize.CoordinatesToCellName(1, i+2)
+		if err != nil {
+			return nil, err
+		}
+		values := []any{r.id, r.date.In(e.loc).Format("2006-01-02 15:04"), r.txType, r.description, r.category, r.account, r.amount, r.member}
+		if err := f.SetSheetRow(sheet, cell, &values); err != nil {
+			return nil, err
+		}
+	}
+
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,79 @@
+package engine
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+)
+
+func TestExport(t *testing.T) {
+	ctx := context.Background()
+	// 20:00 UTC on 30 September is already 1 October in Jakarta.
+	e := newTestEngine(t, time.Date(2026, time.September, 30, 20, 0, 0, 0, time.UTC), WithLocation(jakarta))
+	send := func(chat, text string) Reply {
+		t.Helper()
+		return e.Handle(ctx, Command{Sender: "6281111111111@s.whatsapp.net", SenderName: "Fikri", Chat: chat, Text: text})[0]
+	}
+	send("home@g.us", "add category food")
+	send("home@g.us", "expense\nbread, \"sliced\" = 25rb #food\nfuel = 50.000")
+	send("home@g.us", "income\nsalary = 1jt")
+	send("trip@g.us", "use ledger trip")
+	send("trip@g.us", "expense\nhotel = 300.000")
+
+	reply := send("home@g.us", "export 2026-10")
+	if reply.Document == nil {
+		t.Fatalf("export replied without a document: %q", reply.Text)
+	}
+	if reply.Document.FileName != "household-2026-10-01_2026-10-31.csv" || reply.Document.MimeType != "text/csv" {
+		t.Errorf("document = %s (%s)", reply.Document.FileName, reply.Document.MimeType)
+	}
+	want := "id,date,type,description,category,account,amount,member\n" +
+		"1,2026-10-01 03:00,expense,\"bread, \"\"sliced\"\"\",food,main,-25000,Fikri\n" +
+		"2,2026-10-01 03:00,expense,fuel,,main,-50000,Fikri\n" +
+		"3,2026-10-01 03:00,income,salary,,main,1000000,Fikri\n"
+	if got := string(reply.Document.Data); got != want {
+		t.Errorf("csv =\n%s\nwant\n%s", got, want)
+	}
+	if !strings.Contains(reply.Text, "3 transactions") {
+		t.Errorf("caption %q does not count the transactions", reply.Text)
+	}
+
+	reply = send("trip@g.us", "export xlsx")
+	if reply.Document == nil || reply.Document.FileName != "trip-2026-10-01_2026-10-31.xlsx" {
+		t.Fatalf("xlsx export: %+v", reply)
+	}
+	f, err := excelize.OpenReader(bytes.NewReader(reply.Document.Data))
+	if err != nil {
+		t.Fatalf("open xlsx: %v", err)
+	}
+	defer f.Close()
+	rows, err := f.GetRows("Transactions")
+	if err != nil {
+		t.Fatalf("read xlsx: %v", err)
+	}
+	if len(rows) != 2 || rows[1][3] != "hotel" || rows[1][6] != "-300000" {
+		t.Errorf("xlsx rows = %q", rows)
+	}
+
+	if reply := send("home@g.us", "export 2026-09"); reply.Document != nil || !strings.Contains(reply.Text, "No transactions") {
+		t.Errorf("empty export: %+v", reply)
+	}
+	if reply := send("home@g.us", "export someday"); !strings.HasPrefix(reply.Text, "⚠️") {
+		t.Errorf("invalid period: %q", reply.Text)
+	}
+
+	doc, count, err := e.Export(ctx, "", "2026-10", "xlsx")
+	if err != nil || count != 3 || doc.MimeType != exportMimeTypes[formatXLSX] {
+		t.Errorf("Export = %v, %d, %v", doc, count, err)
+	}
+	if _, _, err := e.Export(ctx, "shop", "", "csv"); err == nil || err.Error() != "unknown ledger shop" {
+		t.Errorf("Export to an unknown ledger: %v", err)
+	}
+	if _, _, err := e.Export(ctx, "", "", "pdf"); err == nil {
+		t.Error("Export accepted pdf")
+	}
+}
@@ -0,0 +1,346 @@
+package engine
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"financial-bot/models"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// progressBarWidth is the number of blocks in a goal's progress bar.
+const progressBarWidth = 10
+
+var goalPattern = regexp.MustCompile(`^([^=]+?)\s*=\s*(.+?)(?:\s+by\s+(\S+)|\s+(no deadline))?$`)
+
+// goalProgress is a goal together with the money put towards it so far.
+type goalProgress struct {
+	*models.Goal
+	saved int64
+	// first is when the first contribution was made, zero if none was.
+	first time.Time
+}
+
+func (e *Engine) findGoal(ctx context.Context, ledger int64, name string) (*models.Goal, error) {
+	return models.Goals(models.GoalWhere.LedgerID.EQ(ledger), models.GoalWhere.Name.EQ(name)).One(ctx, e.db)
+}
+
+// parseDeadline reads a goal's deadline or a loan's due date: "YYYY-MM",
+// meaning the last day of that month, or "YYYY-MM-DD" in the household's
+// timezone.
+func (e *Engine) parseDeadline(s string) (time.Time, error) {
+	if day, err := time.ParseInLocation("2006-01-02", s, e.loc); err == nil {
+		return day, nil
+	}
+	month, err := time.ParseInLocation("2006-01", s, e.loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q, use YYYY-MM or YYYY-MM-DD", s)
+	}
+	return month.AddDate(0, 1, -1), nil
+}
+
+// setGoal handles "goal <name> = <target> [by YYYY-MM | no deadline]" for
+// ledger. Changing a goal keeps its deadline unless a new one is given or
+// "no deadline" drops it. A target of zero removes the goal and returns
+// what was saved for it to the spendable balance; a fraction of a rupiah is
+// rejected by parseAmount, so only an explicit zero does.
+func (e *Engine) setGoal(ctx context.Context, ledger int64, args string) Reply {
+	m := goalPattern.FindStringSubmatch(strings.TrimSpace(args))
+	if m == nil {
+		return Reply{Text: "⚠️ Use: goal <name> = <target> by YYYY-MM"}
+	}
+	name := m[1]
+	if !namePattern.MatchString(name) {
+		return Reply{Text: "⚠️ Goal names may only contain letters, digits, '-' and '_'"}
+	}
+	target, err := parseAmount(m[2])
+	if err != nil {
+		return Reply{Text: "⚠️ " + capitalize(err.Error())}
+	}
+
+	goal, err := e.findGoal(ctx, ledger, name)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		log.Println("Error fetching goal:", err)
+		return Reply{Text: "❌ Error saving goal"}
+	}
+	if target == 0 {
+		if goal == nil {
+			return Reply{Text: fmt.Sprintf("⚠️ Unknown goal %s", name)}
+		}
+		return e.removeGoal(ctx, ledger, goal)
+	}
+
+	var deadline null.Time
+	if goal != nil && m[4] == "" {
+		deadline = goal.Deadline
+	}
+	if m[3] != "" {
+		day, err := e.parseDeadline(m[3])
+		if err != nil {
+			return Reply{Text: "⚠️ " + capitalize(err.Error())}
+		}
+		now := e.localNow()
+		if day.Before(time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, e.loc)) {
+			return Reply{Text: fmt.Sprintf("⚠️ Deadline %s has already passed", m[3])}
+		}
+		deadline = null.TimeFrom(day.UTC())
+	}
+
+	if goal == nil {
+		goal = &models.Goal{LedgerID: ledger, Name: name, Target: target, Deadline: deadline, CreatedAt: e.timestamp()}
+		err = goal.Insert(ctx, e.db, boil.Infer())
+	} else {
+		goal.Target = target
+		goal.Deadline = deadline
+		_, err = goal.Update(ctx, e.db, boil.Infer())
+	}
+	if err != nil {
+		log.Println("Error saving goal:", err)
+		return Reply{Text: "❌ Error saving goal"}
+	}
+
+	progress, err := e.loadGoals(ctx, ledger, models.GoalWhere.ID.EQ(goal.ID))
+	if err != nil || len(progress) == 0 {
+		log.Println("Error fetching goal progress:", err)
+		return Reply{Text: "❌ Error fetching goals"}
+	}
+	text := fmt.Sprintf("🐷 Goal %s set to %s", name, e.money(target))
+	if deadline.Valid {
+		text += " by " + deadline.Time.In(e.loc).Format("02 Jan 2006")
+	}
+	if needed := e.monthlyNeeded(progress[0]); needed > 0 {
+		text += fmt.Sprintf("\nSave %s a month to get there", e.money(needed))
+	}
+	return Reply{Text: text}
+}
+
+// removeGoal deletes goal together with its savings, which puts the money
+// back into the spendable balance.
+func (e *Engine) removeGoal(ctx context.Context, ledger int64, goal *models.Goal) Reply {
+	dbTx, err := e.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Println("Error starting goal removal:", err)
+		return Reply{Text: "❌ Error removing goal"}
+	}
+	defer dbTx.Rollback()
+
+	var released int64
+	err = dbTx.QueryRowContext(ctx, `SELECT COALESCE(-SUM(amount), 0) FROM transactions WHERE goal_id = ?`, goal.ID).Scan(&released)
+	if err == nil {
+		_, err = models.Transactions(models.TransactionWhere.GoalID.EQ(goal.ID)).DeleteAll(ctx, dbTx)
+	}
+	if err == nil {
+		_, err = goal.Delete(ctx, dbTx)
+	}
+	if err == nil {
+		err = dbTx.Commit()
+	}
+	if err != nil {
+		log.Println("Error removing goal:", err)
+		return Reply{Text: "❌ Error removing goal"}
+	}
+
+	if released == 0 {
+		return Reply{Text: fmt.Sprintf("🗑️ Goal %s removed", goal.Name)}
+	}
+	return e.correctionReply(ctx, ledger, fmt.Sprintf("🗑️ Goal %s removed", goal.Name),
+		[]string{fmt.Sprintf("%s saved for it is back in the balance", e.money(released))})
+}
+
+// save handles "save <goal> = <amount> [@account]", which sets money
+// aside for a goal by taking it out of the account's balance.
+func (e *Engine) save(ctx context.Context, cmd Command, ledger int64, args string) Reply {
+	ent, err := parseEntry(args)
+	if errors.Is(err, errNoAmount) {
+		return Reply{Text: "⚠️ Use: save <goal> = <amount>"}
+	}
+	if err != nil {
+		return Reply{Text: "⚠️ " + capitalize(err.Error())}
+	}
+
+	goal, err := e.findGoal(ctx, ledger, ent.desc)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Reply{Text: fmt.Sprintf("⚠️ Unknown goal %s. Set one with: goal %s = <target> by YYYY-MM", ent.desc, ent.desc)}
+	}
+	if err != nil {
+		log.Println("Error fetching goal:", err)
+		return Reply{Text: "❌ Error recording saving"}
+	}
+	account, err := e.resolveAccount(ctx, e.db, ent.account)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Reply{Text: fmt.Sprintf("⚠️ Unknown account %s", ent.account)}
+	}
+	if err != nil {
+		log.Println("Error fetching account:", err)
+		return Reply{Text: "❌ Error recording saving"}
+	}
+
+	tx := &models.Transaction{
+		Type:        "saving",
+		Description: null.StringFrom("save " + goal.Name),
+		Amount:      -ent.amount,
+		CreatedAt:   e.timestamp(),
+		AccountID:   account.ID.Int64,
+		LedgerID:    ledger,
+		GoalID:      goal.ID,
+		Sender:      null.StringFrom(cmd.Sender),
+		SenderName:  null.StringFrom(cmd.SenderName),
+	}
+	if err := e.insertBatch(ctx, cmd.Sender, ledger, []*models.Transaction{tx}); err != nil {
+		log.Println("Error saving transactions:", err)
+		return Reply{Text: "❌ Error recording saving"}
+	}
+
+	progress, err := e.loadGoals(ctx, ledger, models.GoalWhere.ID.EQ(goal.ID))
+	if err != nil || len(progress) == 0 {
+		log.Println("Error fetching goal progress:", err)
+		return Reply{Text: "❌ Error fetching goals"}
+	}
+	p := progress[0]
+	reply := e.correctionReply(ctx, ledger, "🐷 *Saved* 🐷", []string{
+		e.formatEntry(tx),
+		"",
+		fmt.Sprintf("%s: %s of %s", goal.Name, e.money(p.saved), e.money(goal.Target)),
+		progressBar(p.saved, goal.Target),
+	})
+	if before := p.saved - ent.amount; before < goal.Target && p.saved >= goal.Target {
+		reply.Text += fmt.Sprintf("\n\n🎉 Goal %s reached!", goal.Name)
+	}
+	reply.Batch = tx.BatchID.Int64
+	return reply
+}
+
+// loadGoals returns the goals of ledger matching mods with what was
+// saved for each, sorted by name.
+func (e *Engine) loadGoals(ctx context.Context, ledger int64, mods ...qm.QueryMod) ([]goalProgress, error) {
+	goals, err := models.Goals(append(mods, models.GoalWhere.LedgerID.EQ(ledger), qm.OrderBy("name ASC"))...).All(ctx, e.db)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int64, 0, len(goals))
+	for _, goal := range goals {
+		ids = append(ids, goal.ID.Int64)
+	}
+	savings, err := models.Transactions(models.TransactionWhere.GoalID.IN(ids), qm.OrderBy("created_at ASC")).All(ctx, e.db)
+	if err != nil {
+		return nil, err
+	}
+
+	byGoal := make(map[int64]*goalProgress, len(goals))
+	result := make([]goalProgress, len(goals))
+	for i, goal := range goals {
+		result[i].Goal = goal
+		byGoal[goal.ID.Int64] = &result[i]
+	}
+	for _, tx := range savings {
+		p := byGoal[tx.GoalID.Int64]
+		p.saved -= tx.Amount
+		if p.first.IsZero() {
+			p.first = tx.CreatedAt
+		}
+	}
+	return result, nil
+}
+
+// monthsUntil counts the calendar months from the one containing from to
+// the one containing to, both included.
+func monthsUntil(from, to time.Time) int {
+	return (to.Year()-from.Year())*12 + int(to.Month()) - int(from.Month()) + 1
+}
+
+func ceilDiv(a, b int64) int64 {
+	return (a + b - 1) / b
+}
+
+// monthlyNeeded is how much has to be saved every month, this one
+// included, to reach p's target by its deadline. It is zero without a
+// deadline, once the target is reached and after the deadline passed.
+func (e *Engine) monthlyNeeded(p goalProgress) int64 {
+	remaining := p.Target - p.saved
+	if !p.Deadline.Valid || remaining <= 0 {
+		return 0
+	}
+	months := monthsUntil(e.localNow(), p.Deadline.Time.In(e.loc))
+	if months <= 0 {
+		return 0
+	}
+	return ceilDiv(remaining, int64(months))
+}
+
+// projection extrapolates the average monthly contribution since the first
+// one to the month p's target will be reached. ok is false when nothing
+// was saved yet or the target is already reached.
+func (e *Engine) projection(p goalProgress) (average int64, month time.Time, ok bool) {
+	remaining := p.Target - p.saved
+	if p.first.IsZero() || p.saved <= 0 || remaining <= 0 {
+		return 0, time.Time{}, false
+	}
+	now := e.localNow()
+	average = p.saved / int64(monthsUntil(p.first.In(e.loc), now))
+	if average <= 0 {
+		return 0, time.Time{}, false
+	}
+	thisMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, e.loc)
+	return average, thisMonth.AddDate(0, int(ceilDiv(remaining, average)), 0), true
+}
+
+// progressBar draws how much of target was saved, such as
+// "[███░░░░░░░] 30.0%".
+func progressBar(saved, target int64) string {
+	filled := 0
+	if target > 0 && saved > 0 {
+		filled = int(min(saved*progressBarWidth/target, progressBarWidth))
+	}
+	return fmt.Sprintf("[%s%s] %s", strings.Repeat("█", filled), strings.Repeat("░", progressBarWidth-filled), formatPercent(saved, target))
+}
+
+func (e *Engine) getGoals(ctx context.Context, ledger int64) Reply {
+	goals, err := e.loadGoals(ctx, ledger)
+	if err != nil {
+		log.Println("Error fetching goals:", err)
+		return Reply{Text: "❌ Error fetching goals"}
+	}
+	if len(goals) == 0 {
+		return Reply{Text: "🐷 No savings goals yet. Set one with: goal <name> = <target> by YYYY-MM"}
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("🐷 *Savings Goals*\nDate: %s\n", e.localNow().Format("2006-01-02")))
+	var total int64
+	for _, p := range goals {
+		total += p.saved
+		sb.WriteString(fmt.Sprintf("\n*%s* %s\nSaved: %s of %s\n",
+			p.Name, progressBar(p.saved, p.Target), e.money(p.saved), e.money(p.Target)))
+		if p.saved >= p.Target {
+			sb.WriteString("🎉 Reached\n")
+			continue
+		}
+
+		var deadline time.Time
+		if p.Deadline.Valid {
+			deadline = p.Deadline.Time.In(e.loc)
+			if needed := e.monthlyNeeded(p); needed > 0 {
+				sb.WriteString(fmt.Sprintf("Deadline: %s, needs %s a month\n", deadline.Format("02 Jan 2006"), e.money(needed)))
+			} else {
+				sb.WriteString(fmt.Sprintf("⚠️ Deadline %s passed, %s short\n", deadline.Format("02 Jan 2006"), e.money(p.Target-p.saved)))
+			}
+		}
+		if average, month, ok := e.projection(p); ok {
+			sb.WriteString(fmt.Sprintf("Projected: %s at %s a month", month.Format("January 2006"), e.money(average)))
+			if !deadline.IsZero() && month.After(deadline) {
+				sb.WriteString(" ⚠️ after the deadline")
+			}
+			sb.WriteString("\n")
+		}
+	}
+	sb.WriteString(fmt.Sprintf("\n💰 Total saved: %s", e.money(total)))
+	return Reply{Text: sb.String()}
+}
@@ -0,0 +1,81 @@
+package engine
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGoals(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, time.October, 17, 10, 0, 0, 0, time.UTC)
+	e := newTestEngineWithClock(t, func() time.Time { return now })
+	send := func(text string) string {
+		t.Helper()
+		return e.Handle(ctx, Command{Sender: "me", Text: text})[0].Text
+	}
+
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{"monthly amount needed", "goal umroh = 50.000.000 by 2027-12", []string{"🐷 Goal umroh set to Rp 50.000.000 by 31 Dec 2027\nSave Rp 3.333.334 a month to get there"}},
+		{"income", "income\nsalary = 10jt", []string{"New Balance: Rp 10.000.000"}},
+		{"saving leaves the balance", "save umroh = 5jt", []string{
+			"#2 save umroh: Rp -5.000.000\n\numroh: Rp 5.000.000 of Rp 50.000.000\n[█░░░░░░░░░] 10.0%",
+			"New Balance: Rp 5.000.000",
+		}},
+		{"savings are shown with the balance", "balance", []string{"Total Balance: Rp 5.000.000\n🐷 Saved for goals: Rp 5.000.000"}},
+		{"unknown goal", "save hajj = 1jt", []string{"⚠️ Unknown goal hajj"}},
+		{"unknown account", "save umroh = 1jt @ovo", []string{"⚠️ Unknown account ovo"}},
+		{"past deadline", "goal car = 100jt by 2026-09", []string{"⚠️ Deadline 2026-09 has already passed"}},
+		{"invalid deadline", "goal car = 100jt by someday", []string{"⚠️ Invalid date"}},
+		{"goal without deadline", "goal laptop = 2jt", []string{"🐷 Goal laptop set to Rp 2.000.000"}},
+		{"a new target keeps the deadline", "goal umroh = 50jt", []string{"🐷 Goal umroh set to Rp 50.000.000 by 31 Dec 2027"}},
+		{"a fraction does not remove a goal", "goal umroh = 0,4", []string{`⚠️ Amount "0,4" is not a whole number of rupiah`}},
+		{"a new deadline replaces the old one", "goal car = 100jt by 2027-06", []string{"🐷 Goal car set to Rp 100.000.000 by 30 Jun 2027"}},
+		{"reaching a goal", "save laptop = 2jt", []string{"[██████████] 100.0%", "🎉 Goal laptop reached!"}},
+		{"savings can be undone", "undo", []string{"↩️ *Undone*\n#3 save laptop: Rp -2.000.000"}},
+	}
+	for _, tt := range tests {
+		got := send(tt.text)
+		for _, want := range tt.want {
+			if !strings.Contains(got, want) {
+				t.Errorf("%s: reply %q, want %q", tt.name, got, want)
+			}
+		}
+	}
+
+	if got := send("goal car = 120jt no deadline"); got != "🐷 Goal car set to Rp 120.000.000" {
+		t.Errorf("dropping a deadline: %q", got)
+	}
+
+	now = now.AddDate(0, 1, 0)
+	send("save umroh = 5jt")
+	send("goal tv = 10jt by 2026-12")
+	send("save tv = 1jt")
+	got := send("goals")
+	for _, want := range []string{
+		"🐷 *Savings Goals*\nDate: 2026-11-17\n",
+		"*laptop* [░░░░░░░░░░] 0.0%\nSaved: Rp 0 of Rp 2.000.000\n\n",
+		"*tv* [█░░░░░░░░░] 10.0%\nSaved: Rp 1.000.000 of Rp 10.000.000\nDeadline: 31 Dec 2026, needs Rp 4.500.000 a month\nProjected: August 2027 at Rp 1.000.000 a month ⚠️ after the deadline\n",
+		"*umroh* [██░░░░░░░░] 20.0%\nSaved: Rp 10.000.000 of Rp 50.000.000\nDeadline: 31 Dec 2027, needs Rp 2.857.143 a month\nProjected: July 2027 at Rp 5.000.000 a month\n",
+		"💰 Total saved: Rp 11.000.000",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("goals = %q, want %q", got, want)
+		}
+	}
+
+	if got := send("goal umroh = 0"); !strings.Contains(got, "🗑️ Goal umroh removed\nRp 10.000.000 saved for it is back in the balance\n\nNew Balance: Rp 9.000.000") {
+		t.Errorf("removing a goal: %q", got)
+	}
+	if got := e.Handle(ctx, Command{Sender: "me", Chat: "trip@g.us", Text: "use ledger trip"}); len(got) == 0 {
+		t.Fatal("no reply to use ledger")
+	}
+	if got := e.Handle(ctx, Command{Sender: "me", Chat: "trip@g.us", Text: "goals"})[0].Text; !strings.Contains(got, "No savings goals yet") {
+		t.Errorf("goals leak into other ledgers: %q", got)
+	}
+}
@@ -0,0 +1,236 @@
+package engine
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"financial-bot/models"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// Member roles. Each role may do everything the roles below it may.
+const (
+	roleAdmin  = "admin"
+	roleWriter = "writer"
+	roleViewer = "viewer"
+)
+
+var (
+	roleRank = map[string]int{roleViewer: 1, roleWriter: 2, roleAdmin: 3}
+
+	// addMemberPattern matches "<phone> [name] as <role>".
+	addMemberPattern = regexp.MustCompile(`^\+?([0-9][0-9 -]*[0-9])\s*(.*?)\s+as\s+(admin|writer|viewer)$`)
+)
+
+// WithAccessControl restricts the engine to household members: messages
+// from anyone else are ignored, viewers may only ask for reports and only
+// admins may manage members. Without it every sender acts as an admin.
+func WithAccessControl() Option {
+	return func(e *Engine) {
+		e.accessControl = true
+	}
+}
+
+// hasRole reports whether role includes the permissions of required.
+func hasRole(role, required string) bool {
+	return roleRank[role] >= roleRank[required]
+}
+
+// phoneOf returns the phone number of a WhatsApp JID such as
+// "6281234567890:12@s.whatsapp.net".
+func phoneOf(sender string) string {
+	user, _, _ := strings.Cut(sender, "@")
+	user, _, _ = strings.Cut(user, ":")
+	user, _, _ = strings.Cut(user, ".")
+	return user
+}
+
+// sentBy matches the rows whose sender column holds the same phone number
+// as sender, whichever device or JID form it was recorded with.
+func sentBy(sender string) qm.QueryMod {
+	phone := phoneOf(sender)
+	return qm.Where("(sender = ? OR sender LIKE ? OR sender LIKE ? OR sender LIKE ?)",
+		phone, phone+"@%", phone+":%", phone+".%")
+}
+
+// normalisePhone strips the "+", spaces and dashes from a phone number.
+func normalisePhone(phone string) string {
+	return strings.NewReplacer("+", "", " ", "", "-", "").Replace(phone)
+}
+
+// memberOf returns the member of ledger who sent a message.
+func (e *Engine) memberOf(ctx context.Context, ledger int64, sender string) (*models.Member, error) {
+	return models.Members(
+		models.MemberWhere.LedgerID.EQ(ledger),
+		models.MemberWhere.Phone.EQ(phoneOf(sender)),
+	).One(ctx, e.db)
+}
+
+// access returns the ledger cmd's chat is bound to and the role of its
+// sender there, and fills in the sender's name. ok is false when the
+// sender is not a member of the ledger or the lookup failed.
+func (e *Engine) access(ctx context.Context, cmd *Command) (ledger *models.Ledger, role string, ok bool) {
+	ledger, err := e.ledgerOf(ctx, cmd.Chat)
+	if err != nil {
+		log.Println("Error fetching ledger:", err)
+		return nil, "", false
+	}
+	if !e.accessControl {
+		return ledger, roleAdmin, true
+	}
+	member, err := e.memberOf(ctx, ledger.ID.Int64, cmd.Sender)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, "", false
+	}
+	if err != nil {
+		log.Println("Error fetching member:", err)
+		return nil, "", false
+	}
+	if member.Name != "" {
+		cmd.SenderName = member.Name
+	}
+	return ledger, member.Role, true
+}
+
+// Member is a household member to seed the members table with.
+type Member struct {
+	Phone, Name, Role string
+}
+
+// SeedMembers adds members to the default ledger when it has none, so the
+// first admin can be configured outside the chat. Once members exist they
+// are managed through the chat only, and members removed there stay
+// removed.
+func (e *Engine) SeedMembers(ctx context.Context, members []Member) error {
+	ledger, err := e.defaultLedger(ctx)
+	if err != nil {
+		return err
+	}
+	exists, err := models.Members(models.MemberWhere.LedgerID.EQ(ledger.ID.Int64)).Exists(ctx, e.db)
+	if err != nil || exists {
+		return err
+	}
+
+	dbTx, err := e.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer dbTx.Rollback()
+	for _, m := range members {
+		member := &models.Member{LedgerID: ledger.ID.Int64, Phone: normalisePhone(m.Phone), Name: m.Name, Role: m.Role, CreatedAt: e.timestamp()}
+		if err := member.Insert(ctx, dbTx, boil.Infer()); err != nil {
+			return err
+		}
+	}
+	return dbTx.Commit()
+}
+
+func (e *Engine) listMembers(ctx context.Context, ledger int64) Reply {
+	members, err := models.Members(
+		models.MemberWhere.LedgerID.EQ(ledger),
+		qm.OrderBy("name ASC, phone ASC"),
+	).All(ctx, e.db)
+	if err != nil {
+		log.Println("Error fetching members:", err)
+		return Reply{Text: "❌ Error fetching members"}
+	}
+	if len(members) == 0 {
+		return Reply{Text: "👪 No members yet. Add one with: add member <phone> <name> as <admin|writer|viewer>"}
+	}
+
+	var sb strings.Builder
+	sb.WriteString("👪 *Members*")
+	for _, m := range members {
+		sb.WriteString(fmt.Sprintf("\n• %s (%s): %s", displayName(m.Phone, m.Name), m.Phone, m.Role))
+	}
+	return Reply{Text: sb.String()}
+}
+
+// addMember handles "add member <phone> [name] as <role>" for ledger.
+// Adding a known number changes its role, and its name when one is given.
+func (e *Engine) addMember(ctx context.Context, ledger int64, args string) Reply {
+	m := addMemberPattern.FindStringSubmatch(strings.TrimSpace(args))
+	if m == nil {
+		return Reply{Text: "⚠️ Use: add member <phone> <name> as <admin|writer|viewer>"}
+	}
+	phone, name, role := normalisePhone(m[1]), capitalize(strings.TrimSpace(m[2])), m[3]
+	if strings.HasPrefix(phone, "0") {
+		return Reply{Text: "⚠️ Use the international format without a leading 0, e.g. 62812..."}
+	}
+
+	member, err := models.Members(models.MemberWhere.LedgerID.EQ(ledger), models.MemberWhere.Phone.EQ(phone)).One(ctx, e.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		member = &models.Member{LedgerID: ledger, Phone: phone, Name: name, Role: role, CreatedAt: e.timestamp()}
+		if err := member.Insert(ctx, e.db, boil.Infer()); err != nil {
+			log.Println("Error saving member:", err)
+			return Reply{Text: "❌ Error saving member"}
+		}
+		return Reply{Text: fmt.Sprintf("✅ %s added as %s", displayName(phone, name), role)}
+	}
+	if err != nil {
+		log.Println("Error fetching member:", err)
+		return Reply{Text: "❌ Error saving member"}
+	}
+
+	if member.Role == roleAdmin && role != roleAdmin {
+		if reply, ok := e.keepAnAdmin(ctx, member); !ok {
+			return reply
+		}
+	}
+	member.Role = role
+	if name != "" {
+		member.Name = name
+	}
+	if _, err := member.Update(ctx, e.db, boil.Whitelist(models.MemberColumns.Role, models.MemberColumns.Name)); err != nil {
+		log.Println("Error updating member:", err)
+		return Reply{Text: "❌ Error saving member"}
+	}
+	return Reply{Text: fmt.Sprintf("✅ %s is now %s", displayName(phone, member.Name), role)}
+}
+
+// removeMember handles "remove member <phone>" for ledger.
+func (e *Engine) removeMember(ctx context.Context, ledger int64, phone string) Reply {
+	phone = normalisePhone(strings.TrimSpace(phone))
+	member, err := models.Members(models.MemberWhere.LedgerID.EQ(ledger), models.MemberWhere.Phone.EQ(phone)).One(ctx, e.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Reply{Text: fmt.Sprintf("⚠️ %s is not a member", phone)}
+	}
+	if err != nil {
+		log.Println("Error fetching member:", err)
+		return Reply{Text: "❌ Error removing member"}
+	}
+	if member.Role == roleAdmin {
+		if reply, ok := e.keepAnAdmin(ctx, member); !ok {
+			return reply
+		}
+	}
+	if _, err := member.Delete(ctx, e.db); err != nil {
+		log.Println("Error deleting member:", err)
+		return Reply{Text: "❌ Error removing member"}
+	}
+	return Reply{Text: fmt.Sprintf("🗑️ %s removed", displayName(phone, member.Name))}
+}
+
+// keepAnAdmin checks that an admin other than member remains in its
+// ledger, so the members cannot lock themselves out of managing it.
+func (e *Engine) keepAnAdmin(ctx context.Context, member *models.Member) (Reply, bool) {
+	others, err := models.Members(
+		models.MemberWhere.LedgerID.EQ(member.LedgerID),
+		models.MemberWhere.Role.EQ(roleAdmin),
+		models.MemberWhere.ID.NEQ(member.ID),
+	).Count(ctx, e.db)
+	if err != nil {
+		log.Println("Error counting admins:", err)
+		return Reply{Text: "❌ Error saving member"}, false
+	}
+	if others == 0 {
+		return Reply{Text: fmt.Sprintf("⚠️ %s is the only admin. Make someone else admin first.", displayName(member.Phone, member.Name))}, false
+	}
+	return Reply{}, true
+}
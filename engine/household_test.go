@@ -0,0 +1,75 @@
+package engine
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAccessControl(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, time.June, 28, 10, 0, 0, 0, time.UTC)
+	e := newTestEngine(t, now, WithAccessControl())
+	if err := e.SeedMembers(ctx, []Member{
+		{Phone: "6281111111111", Name: "Fikri", Role: roleAdmin},
+		{Phone: "+62 822-2222-2222", Name: "Sari", Role: roleWriter},
+	}); err != nil {
+		t.Fatalf("SeedMembers: %v", err)
+	}
+
+	const (
+		admin    = "6281111111111:3@s.whatsapp.net"
+		writer   = "6282222222222@s.whatsapp.net"
+		grandma  = "6283333333333@s.whatsapp.net"
+		stranger = "6289999999999@s.whatsapp.net"
+	)
+	send := func(sender, text string) string {
+		t.Helper()
+		replies := e.Handle(ctx, Command{Sender: sender, SenderName: "push name", Chat: "family@g.us", Text: text})
+		if len(replies) == 0 {
+			return ""
+		}
+		return replies[0].Text
+	}
+
+	tests := []struct {
+		name   string
+		sender string
+		text   string
+		want   string
+	}{
+		{"strangers are ignored", stranger, "balance", ""},
+		{"writers record transactions", writer, "expense\nlunch = 25.000", "✅ Recorded:"},
+		{"writers cannot manage members", writer, "add member 6283333333333 nenek as viewer", "⛔ Only admins can do that, you are a writer"},
+		{"admins add members", admin, "add member +62 833-3333-3333 nenek as viewer", "✅ Nenek added as viewer"},
+		{"viewers read reports", grandma, "balance", "Rp -25.000"},
+		{"viewers cannot record", grandma, "expense\nsnack = 5.000", "⛔ Only writers can do that, you are a viewer"},
+		{"anyone lists members", grandma, "members", "• Nenek (6283333333333): viewer"},
+		{"the last admin stays", admin, "add member 6281111111111 as writer", "⚠️ Fikri is the only admin"},
+		{"admins change roles", admin, "add member 6282222222222 as admin", "✅ Sari is now admin"},
+		{"an admin can step down once another exists", admin, "add member 6281111111111 as writer", "✅ Fikri is now writer"},
+		{"admins remove members", writer, "remove member 6283333333333", "🗑️ Nenek removed"},
+		{"removed members are ignored", grandma, "balance", ""},
+		{"the last admin cannot be removed", writer, "remove member 6282222222222", "⚠️ Sari is the only admin"},
+	}
+	for _, tt := range tests {
+		got := send(tt.sender, tt.text)
+		if tt.want == "" && got != "" || !strings.Contains(got, tt.want) {
+			t.Errorf("%s: reply %q, want %q", tt.name, got, tt.want)
+		}
+	}
+
+	// Members' own names are used in reports rather than their push names.
+	if got := send(admin, "who spent today"); !strings.Contains(got, "Sari") {
+		t.Errorf("who spent: reply %q does not name Sari", got)
+	}
+
+	// Seeding only fills an empty table, so removed members stay removed.
+	if err := e.SeedMembers(ctx, []Member{{Phone: "6283333333333", Name: "Nenek", Role: roleViewer}}); err != nil {
+		t.Fatalf("SeedMembers: %v", err)
+	}
+	if got := send(grandma, "balance"); got != "" {
+		t.Errorf("seeding re-added a removed member: reply %q", got)
+	}
+}
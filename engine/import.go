@@ -0,0 +1,499 @@
+package engine
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"financial-bot/models"
+	"fmt"
+	"io"
+	"log"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// ImportMapping describes the columns of a bank statement CSV. Columns are
+// named by their header, matched case-insensitively; rows above the header,
+// such as an account summary, are ignored.
+type ImportMapping struct {
+	Date string
+	// DateLayouts are the Go layouts Date is tried with, in order.
+	// Layouts without a year use the latest year that does not put the
+	// date in the future.
+	DateLayouts []string
+	Description string
+	// Amount holds signed amounts, or amounts followed by DB (money out)
+	// or CR (money in) as BCA writes them.
+	Amount string
+	// Debit and Credit hold money out and money in, for statements that
+	// split amounts into two columns instead of using Amount.
+	Debit  string
+	Credit string
+	// Type holds the transaction type, for files that record more than
+	// income and expenses. Rows of any other type, such as transfers, are
+	// rejected; rows without a type are read by the sign of the amount.
+	Type string
+}
+
+// importPresets are the bank statement mappings known without
+// configuration. generic reads the files written by export.
+var importPresets = map[string]ImportMapping{
+	"bca": {
+		Date: "Tanggal Transaksi", DateLayouts: []string{"02/01/2006", "02/01"},
+		Description: "Keterangan", Amount: "Jumlah",
+	},
+	"mandiri": {
+		Date: "Tanggal", DateLayouts: []string{"02/01/2006", "02 Jan 2006"},
+		Description: "Keterangan", Debit: "Debit", Credit: "Kredit",
+	},
+	"generic": {
+		Date: "date", DateLayouts: []string{"2006-01-02"},
+		Description: "description", Amount: "amount", Type: "type",
+	},
+}
+
+// WithImportMappings adds bank statement mappings to the built-in bca,
+// mandiri and generic ones, replacing built-ins of the same name.
+func WithImportMappings(mappings map[string]ImportMapping) Option {
+	return func(e *Engine) {
+		for name, m := range mappings {
+			e.mappings[strings.ToLower(name)] = m
+		}
+	}
+}
+
+// ImportOptions configures Import.
+type ImportOptions struct {
+	// Ledger and Account default to the default ledger and account.
+	Ledger, Account string
+	// Bank names the column mapping: bca, mandiri, generic or one added
+	// with WithImportMappings.
+	Bank string
+	// DryRun reports what would be imported without recording anything.
+	DryRun bool
+}
+
+// ImportResult summarises an import.
+type ImportResult struct {
+	// New lists the transactions recorded, or that would be recorded in a
+	// dry run.
+	New []string
+	// Duplicates counts the statement lines already in the ledger.
+	Duplicates int
+	// Skipped lists the statement lines that are not transactions, with
+	// the reason.
+	Skipped []string
+}
+
+// statementLine is a transaction read from a bank statement.
+type statementLine struct {
+	// date is midnight in the household's timezone.
+	date   time.Time
+	desc   string
+	amount int64
+}
+
+// Import records the transactions of a bank statement CSV that are not in
+// the ledger yet. They are recorded as one batch, which undo removes again.
+func (e *Engine) Import(ctx context.Context, data []byte, opts ImportOptions) (ImportResult, error) {
+	ledgerName := opts.Ledger
+	if ledgerName == "" {
+		ledgerName = DefaultLedgerName
+	}
+	ledger, err := e.findLedger(ctx, strings.ToLower(ledgerName))
+	if errors.Is(err, sql.ErrNoRows) {
+		return ImportResult{}, fmt.Errorf("unknown ledger %s", ledgerName)
+	}
+	if err != nil {
+		return ImportResult{}, err
+	}
+	return e.importStatement(ctx, Command{Sender: "import", SenderName: "Import"}, ledger.ID.Int64, data, opts)
+}
+
+// importCommand handles a bank statement sent as a document with the
+// caption "import <bank> [@account] [dry run]".
+func (e *Engine) importCommand(ctx context.Context, cmd Command, ledger int64, args string) Reply {
+	var opts ImportOptions
+	args, opts.DryRun = strings.CutSuffix(strings.TrimSpace(args), "dry run")
+	args, opts.Account = extractAccount(args)
+	opts.Bank = strings.TrimSpace(args)
+	usage := fmt.Sprintf("Send the bank's CSV statement as a document with the caption:\nimport <%s> [@account] [dry run]", strings.Join(e.banks(), "|"))
+	if opts.Bank == "" {
+		return Reply{Text: "⚠️ " + usage}
+	}
+	if _, ok := e.mappings[opts.Bank]; !ok {
+		return Reply{Text: fmt.Sprintf("⚠️ Unknown bank %s. %s", opts.Bank, usage)}
+	}
+	if cmd.Document == nil {
+		return Reply{Text: "⚠️ " + usage}
+	}
+
+	data, err := cmd.Document.data()
+	if err != nil {
+		log.Println("Error downloading statement:", err)
+		return Reply{Text: "❌ Error downloading the statement, nothing was recorded"}
+	}
+	result, err := e.importStatement(ctx, cmd, ledger, data, opts)
+	var invalid *invalidStatementError
+	if errors.As(err, &invalid) {
+		return Reply{Text: "⚠️ " + capitalize(err.Error())}
+	}
+	if err != nil {
+		log.Println("Error importing statement:", err)
+		return Reply{Text: "❌ Error importing statement, nothing was recorded"}
+	}
+
+	var sb strings.Builder
+	if opts.DryRun {
+		sb.WriteString(fmt.Sprintf("📥 *Import Preview*\nWould record %d new transactions", len(result.New)))
+	} else {
+		sb.WriteString(fmt.Sprintf("📥 *Imported*\nRecorded %d new transactions", len(result.New)))
+	}
+	const shown = 20
+	for i, line := range result.New {
+		if i == shown {
+			sb.WriteString(fmt.Sprintf("\n…and %d more", len(result.New)-shown))
+			break
+		}
+		sb.WriteString("\n• " + line)
+	}
+	if result.Duplicates > 0 {
+		sb.WriteString(fmt.Sprintf("\n\n♻️ %d already recorded, skipped", result.Duplicates))
+	}
+	if len(result.Skipped) > 0 {
+		sb.WriteString("\n\n❌ Not transactions:")
+		for _, s := range result.Skipped {
+			sb.WriteString("\n• " + s)
+		}
+	}
+
+	switch {
+	case opts.DryRun && len(result.New) > 0:
+		sb.WriteString(fmt.Sprintf("\n\nSend it again with the caption \"import %s\" to record them.", opts.Bank))
+	case len(result.New) > 0:
+		balances, err := e.accountBalances(ctx, ledger)
+		if err != nil {
+			log.Println("Error fetching balances:", err)
+		}
+		sb.WriteString("\n\n" + e.formatBalances("New Balance", balances) + "\n\nSend undo to remove them again.")
+	}
+	return Reply{Text: sb.String()}
+}
+
+// banks returns the names of the known bank statement mappings.
+func (e *Engine) banks() []string {
+	return slices.Sorted(maps.Keys(e.mappings))
+}
+
+// invalidStatementError is returned for a statement that cannot be read
+// with the chosen mapping, as opposed to a database error.
+type invalidStatementError struct {
+	msg string
+}
+
+func (e *invalidStatementError) Error() string { return e.msg }
+
+func invalidStatement(format string, args ...any) error {
+	return &invalidStatementError{fmt.Sprintf(format, args...)}
+}
+
+// importStatement parses data with the mapping named by opts.Bank and
+// records the lines that are not duplicates in ledger, unless it is a dry
+// run.
+func (e *Engine) importStatement(ctx context.Context, cmd Command, ledger int64, data []byte, opts ImportOptions) (ImportResult, error) {
+	var result ImportResult
+	mapping, ok := e.mappings[strings.ToLower(opts.Bank)]
+	if !ok {
+		return result, invalidStatement("unknown bank %s, use one of %s", opts.Bank, strings.Join(e.banks(), ", "))
+	}
+	account, err := e.resolveAccount(ctx, e.db, strings.ToLower(opts.Account))
+	if errors.Is(err, sql.ErrNoRows) {
+		return result, invalidStatement("unknown account %s", opts.Account)
+	}
+	if err != nil {
+		return result, err
+	}
+
+	lines, rejected, err := e.parseStatement(data, mapping)
+	if err != nil {
+		return result, err
+	}
+	for _, r := range rejected {
+		result.Skipped = append(result.Skipped, fmt.Sprintf("%s → %s", r.line, r.reason))
+	}
+	fresh, duplicates, err := e.withoutDuplicates(ctx, ledger, lines)
+	if err != nil {
+		return result, err
+	}
+	result.Duplicates = duplicates
+
+	var pending []*models.Transaction
+	for _, line := range fresh {
+		tx := &models.Transaction{
+			Type:        "income",
+			Description: null.StringFrom(line.desc),
+			Amount:      line.amount,
+			CreatedAt:   line.date.UTC(),
+			AccountID:   account.ID.Int64,
+			LedgerID:    ledger,
+			Sender:      null.StringFrom(cmd.Sender),
+			SenderName:  null.StringFrom(cmd.SenderName),
+		}
+		if line.amount < 0 {
+			tx.Type = "expense"
+		}
+		pending = append(pending, tx)
+		result.New = append(result.New, fmt.Sprintf("%s %s: %s", line.date.Format("2006-01-02"), line.desc, e.signedMoney(line.amount)))
+	}
+	if opts.DryRun {
+		return result, nil
+	}
+	return result, e.insertBatch(ctx, cmd.Sender, ledger, pending)
+}
+
+// parseStatement reads the transactions of a bank statement CSV, returning
+// the lines below the header that hold no transaction as rejections.
+func (e *Engine) parseStatement(data []byte, m ImportMapping) ([]statementLine, []rejection, error) {
+	data = bytes.TrimPrefix(data, []byte("\ufeff"))
+	r := csv.NewReader(bytes.NewReader(data))
+	r.Comma = statementDelimiter(data)
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+
+	var columns map[string]int
+	var lines []statementLine
+	var rejected []rejection
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, invalidStatement("the statement is not a valid CSV file: %v", err)
+		}
+		lineNo, _ := r.FieldPos(0)
+		if columns == nil {
+			columns = headerColumns(record, m)
+			continue
+		}
+		if strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+
+		line, err := e.parseStatementLine(record, columns, m)
+		if err != nil {
+			rejected = append(rejected, rejection{fmt.Sprintf("line %d", lineNo), err.Error()})
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if columns == nil {
+		return nil, nil, invalidStatement("no header row with the columns %q and %q found", m.Date, m.Description)
+	}
+	return lines, rejected, nil
+}
+
+// statementDelimiter guesses whether a CSV file uses "," or ";" from its
+// first line.
+func statementDelimiter(data []byte) rune {
+	first, _, _ := bytes.Cut(data, []byte("\n"))
+	if bytes.Count(first, []byte(";")) > bytes.Count(first, []byte(",")) {
+		return ';'
+	}
+	return ','
+}
+
+// headerColumns returns the index of every column in record, or nil when
+// record is not the header row of m.
+func headerColumns(record []string, m ImportMapping) map[string]int {
+	columns := map[string]int{}
+	for i, name := range record {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if _, seen := columns[name]; !seen {
+			columns[name] = i
+		}
+	}
+	for _, required := range []string{m.Date, m.Description} {
+		if _, ok := columns[strings.ToLower(required)]; !ok {
+			return nil
+		}
+	}
+	return columns
+}
+
+func (e *Engine) parseStatementLine(record []string, columns map[string]int, m ImportMapping) (statementLine, error) {
+	field := func(name string) string {
+		i, ok := columns[strings.ToLower(name)]
+		if name == "" || !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var line statementLine
+	date, err := e.parseStatementDate(field(m.Date), m.DateLayouts)
+	if err != nil {
+		return line, err
+	}
+	line.date = date
+	line.desc = normaliseDescription(field(m.Description))
+	if line.desc == "" {
+		return line, errors.New("missing description")
+	}
+
+	if m.Amount != "" {
+		line.amount, err = parseSignedAmount(field(m.Amount))
+	} else {
+		line.amount, err = parseDebitCredit(field(m.Debit), field(m.Credit))
+	}
+	if err != nil {
+		return line, err
+	}
+	if line.amount == 0 {
+		return line, errors.New("amount is zero")
+	}
+	// Transfers, savings and loans move money within the household and
+	// are not read as income or expenses
+	switch txType := strings.ToLower(field(m.Type)); txType {
+	case "", "income", "expense":
+	default:
+		return line, fmt.Errorf("%s is not an income or expense", txType)
+	}
+	return line, nil
+}
+
+// parseStatementDate reads value with the first layout that fits, as
+// midnight in the household's timezone.
+func (e *Engine) parseStatementDate(value string, layouts []string) (time.Time, error) {
+	value = strings.TrimPrefix(value, "'")
+	if strings.EqualFold(value, "pend") {
+		return time.Time{}, errors.New("pending transaction")
+	}
+	if len(layouts) == 0 {
+		layouts = []string{"02/01/2006"}
+	}
+
+	today := e.localNow()
+	for _, layout := range layouts {
+		text := value
+		if !strings.Contains(layout, " ") {
+			// Drop a time of day after the date
+			text, _, _ = strings.Cut(value, " ")
+		}
+		date, err := time.ParseInLocation(layout, text, e.loc)
+		if err != nil {
+			continue
+		}
+		if !strings.Contains(layout, "2006") {
+			date = time.Date(today.Year(), date.Month(), date.Day(), 0, 0, 0, 0, e.loc)
+			if date.After(today) {
+				date = date.AddDate(-1, 0, 0)
+			}
+		}
+		return date, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q", value)
+}
+
+// parseSignedAmount reads an amount that is negative for money out, either
+// through a minus sign or a DB suffix.
+func parseSignedAmount(s string) (int64, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	sign := int64(1)
+	if rest, ok := strings.CutSuffix(s, "db"); ok {
+		s, sign = rest, -1
+	} else {
+		s = strings.TrimSuffix(s, "cr")
+	}
+	if rest, ok := strings.CutPrefix(strings.TrimSpace(s), "-"); ok {
+		s, sign = rest, -sign
+	}
+	amount, err := parseStatementAmount(s)
+	return sign * amount, err
+}
+
+// parseDebitCredit reads the amount of a statement line with separate
+// money out and money in columns, one of which is empty or zero.
+func parseDebitCredit(debit, credit string) (int64, error) {
+	var out, in int64
+	var err error
+	if debit != "" {
+		if out, err = parseStatementAmount(debit); err != nil {
+			return 0, err
+		}
+	}
+	if credit != "" {
+		if in, err = parseStatementAmount(credit); err != nil {
+			return 0, err
+		}
+	}
+	if out != 0 && in != 0 {
+		return 0, errors.New("both debit and credit are set")
+	}
+	return in - out, nil
+}
+
+// normaliseDescription lower-cases desc and collapses its spaces, like the
+// descriptions typed in the chat.
+func normaliseDescription(desc string) string {
+	return strings.Join(strings.Fields(strings.ToLower(desc)), " ")
+}
+
+// fingerprint identifies a transaction for duplicate detection by its day
+// in the household's timezone, amount and description.
+func (e *Engine) fingerprint(t time.Time, amount int64, desc string) string {
+	return fmt.Sprintf("%s|%d|%s", t.In(e.loc).Format("2006-01-02"), amount, normaliseDescription(desc))
+}
+
+// withoutDuplicates drops the statement lines that are already in ledger.
+// Fingerprints are counted, so a statement with two identical coffees on
+// one day still records the second one when only the first was recorded.
+func (e *Engine) withoutDuplicates(ctx context.Context, ledger int64, lines []statementLine) ([]statementLine, int, error) {
+	if len(lines) == 0 {
+		return nil, 0, nil
+	}
+	first, last := lines[0].date, lines[0].date
+	for _, line := range lines {
+		if line.date.Before(first) {
+			first = line.date
+		}
+		if line.date.After(last) {
+			last = line.date
+		}
+	}
+
+	existing, err := models.Transactions(
+		models.TransactionWhere.LedgerID.EQ(ledger),
+		models.TransactionWhere.Type.IN([]string{"income", "expense"}),
+		models.TransactionWhere.CreatedAt.GTE(first.UTC()),
+		models.TransactionWhere.CreatedAt.LT(last.AddDate(0, 0, 1).UTC()),
+		qm.Select(models.TransactionColumns.CreatedAt, models.TransactionColumns.Amount, models.TransactionColumns.Description),
+	).All(ctx, e.db)
+	if err != nil {
+		return nil, 0, err
+	}
+	seen := map[string]int{}
+	for _, tx := range existing {
+		seen[e.fingerprint(tx.CreatedAt, tx.Amount, tx.Description.String)]++
+	}
+
+	var fresh []statementLine
+	duplicates := 0
+	for _, line := range lines {
+		fp := e.fingerprint(line.date, line.amount, line.desc)
+		if seen[fp] > 0 {
+			seen[fp]--
+			duplicates++
+			continue
+		}
+		fresh = append(fresh, line)
+	}
+	return fresh, duplicates, nil
+}
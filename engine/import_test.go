@@ -0,0 +1,137 @@
+package engine
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+// bcaStatement is shaped like a KlikBCA account statement export.
+const bcaStatement = `No. rekening : 1234567890
+Nama : FIKRI
+Periode : 01/09/2026 - 30/09/2026
+Kode Mata Uang : IDR
+
+Tanggal Transaksi,Keterangan,Cabang,Jumlah,Saldo
+'01/09,TRSF E-BANKING CR  GAJI SEPTEMBER,0000,"10,000,000.00 CR","10,500,000.00"
+'02/09,KARTU DEBIT  INDOMARET,0000,"125,500.00 DB","10,374,500.00"
+'02/09,KARTU DEBIT  INDOMARET,0000,"125,500.00 DB","10,249,000.00"
+PEND,KARTU DEBIT  SPBU,0000,"300,000.00 DB","9,949,000.00"
+
+Saldo Awal,:,"500,000.00"
+`
+
+const mandiriStatement = `Tanggal;Keterangan;Debit;Kredit;Saldo
+03/09/2026;Transfer ke Budi;1.500.000,00;0,00;8.500.000,00
+04/09/2026;Bunga;0,00;2.345,67;8.502.345,67
+`
+
+func TestImport(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEngine(t, time.Date(2026, time.October, 5, 10, 0, 0, 0, time.UTC), WithLocation(jakarta))
+
+	result, err := e.Import(ctx, []byte(bcaStatement), ImportOptions{Bank: "bca", DryRun: true})
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	wantNew := []string{
+		"2026-09-01 trsf e-banking cr gaji september: +Rp 10.000.000",
+		"2026-09-02 kartu debit indomaret: Rp -125.500",
+		"2026-09-02 kartu debit indomaret: Rp -125.500",
+	}
+	if strings.Join(result.New, "\n") != strings.Join(wantNew, "\n") {
+		t.Errorf("new = %q, want %q", result.New, wantNew)
+	}
+	if len(result.Skipped) != 2 || !strings.Contains(result.Skipped[0], "line 10 → pending transaction") ||
+		!strings.Contains(result.Skipped[1], `line 12 → invalid date "Saldo Awal"`) {
+		t.Errorf("skipped = %q", result.Skipped)
+	}
+	if reply := e.Handle(ctx, Command{Text: "balance"}); !strings.Contains(reply[0].Text, "Total Balance: Rp 0") {
+		t.Errorf("dry run recorded transactions: %q", reply[0].Text)
+	}
+
+	// One of the two identical card payments was already typed in the chat.
+	e.Handle(ctx, Command{Text: "expense\nkartu debit indomaret = 125.500"})
+	now := time.Date(2026, time.September, 2, 12, 0, 0, 0, jakarta)
+	if _, err := e.db.Exec("UPDATE transactions SET created_at = ?", now.UTC()); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err = e.Import(ctx, []byte(bcaStatement), ImportOptions{Bank: "bca"})
+	if err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	if len(result.New) != 2 || result.Duplicates != 1 {
+		t.Errorf("import: %d new, %d duplicates, want 2 and 1", len(result.New), result.Duplicates)
+	}
+	result, err = e.Import(ctx, []byte(bcaStatement), ImportOptions{Bank: "bca"})
+	if err != nil || len(result.New) != 0 || result.Duplicates != 3 {
+		t.Errorf("second import: %+v, %v", result, err)
+	}
+
+	result, err = e.Import(ctx, []byte(mandiriStatement), ImportOptions{Bank: "mandiri"})
+	if err != nil {
+		t.Fatalf("mandiri: %v", err)
+	}
+	if strings.Join(result.New, "\n") != "2026-09-03 transfer ke budi: Rp -1.500.000\n2026-09-04 bunga: +Rp 2.346" {
+		t.Errorf("mandiri new = %q", result.New)
+	}
+
+	// An export reads back in as all duplicates, and its transfers are
+	// not taken for income or expenses
+	doc, _, err := e.Export(ctx, "", "2026-09", "csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := append(doc.Data, "99,2026-09-05 10:00,transfer,transfer,,main,-50000,Me\n"...)
+	result, err = e.Import(ctx, data, ImportOptions{Bank: "generic"})
+	if err != nil || len(result.New) != 0 || result.Duplicates != 5 {
+		t.Errorf("reimported export: %+v, %v", result, err)
+	}
+	if len(result.Skipped) != 1 || !strings.HasSuffix(result.Skipped[0], "→ transfer is not an income or expense") {
+		t.Errorf("reimported export skipped %q, want the transfer", result.Skipped)
+	}
+
+	if _, err := e.Import(ctx, []byte("a,b\n1,2\n"), ImportOptions{Bank: "bca"}); err == nil || !strings.Contains(err.Error(), "no header row") {
+		t.Errorf("statement without header: %v", err)
+	}
+	if _, err := e.Import(ctx, []byte(bcaStatement), ImportOptions{Bank: "jago"}); err == nil {
+		t.Error("unknown bank accepted")
+	}
+}
+
+func TestImportCommand(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEngine(t, time.Date(2026, time.October, 5, 10, 0, 0, 0, time.UTC),
+		WithImportMappings(map[string]ImportMapping{
+			"Jago": {Date: "Tanggal", DateLayouts: []string{"2006-01-02"}, Description: "Catatan", Amount: "Jumlah"},
+		}))
+	e.Handle(ctx, Command{Text: "add account jago"})
+	statement := &Document{FileName: "jago.csv", MimeType: "text/csv", Data: []byte("Tanggal,Catatan,Jumlah\n2026-09-10,Kopi,-25000\n2026-09-11,Refund,10000\n")}
+	send := func(text string, doc *Document) string {
+		t.Helper()
+		return e.Handle(ctx, Command{Sender: "me", Chat: "family@g.us", Text: text, Document: doc})[0].Text
+	}
+
+	tests := []struct {
+		text string
+		doc  *Document
+		want string
+	}{
+		{"import", nil, "import <bca|generic|jago|mandiri> [@account] [dry run]"},
+		{"import jago", nil, "Send the bank's CSV statement as a document"},
+		{"import bri", statement, "Unknown bank bri"},
+		{"import jago @ovo", statement, "Unknown account ovo"},
+		{"import jago @jago dry run", statement, "Would record 2 new transactions\n• 2026-09-10 kopi: Rp -25.000\n• 2026-09-11 refund: +Rp 10.000\n\nSend it again"},
+		{"import jago @jago", statement, "Recorded 2 new transactions"},
+		{"balance", nil, "🏦 jago: Rp -15.000"},
+		{"import jago @jago", statement, "Recorded 0 new transactions\n\n♻️ 2 already recorded, skipped"},
+		{"undo", nil, "↩️ *Undone*\n#1 kopi: Rp -25.000\n#2 refund: +Rp 10.000"},
+	}
+	for _, tt := range tests {
+		if got := send(tt.text, tt.doc); !strings.Contains(got, tt.want) {
+			t.Errorf("%q: reply %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package engine
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"financial-bot/models"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// DefaultLedgerName is the ledger existing data was migrated into and the
+// one chats use until they are bound to another.
+const DefaultLedgerName = "household"
+
+func (e *Engine) findLedger(ctx context.Context, name string) (*models.Ledger, error) {
+	return models.Ledgers(models.LedgerWhere.Name.EQ(name)).One(ctx, e.db)
+}
+
+func (e *Engine) defaultLedger(ctx context.Context) (*models.Ledger, error) {
+	return e.findLedger(ctx, DefaultLedgerName)
+}
+
+// ledgerOf returns the ledger chat is bound to, or the default ledger when
+// it is not bound to any.
+func (e *Engine) ledgerOf(ctx context.Context, chat string) (*models.Ledger, error) {
+	binding, err := models.Chats(models.ChatWhere.Jid.EQ(chat)).One(ctx, e.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return e.defaultLedger(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return models.FindLedger(ctx, e.db, null.Int64From(binding.LedgerID))
+}
+
+func (e *Engine) showLedger(ledger *models.Ledger) Reply {
+	return Reply{Text: fmt.Sprintf("📒 This chat uses the *%s* ledger. Switch with: use ledger <name>", ledger.Name)}
+}
+
+// listLedgers lists every ledger, or with access control the ledgers the
+// sender is a member of.
+func (e *Engine) listLedgers(ctx context.Context, sender string, current *models.Ledger) Reply {
+	mods := []qm.QueryMod{qm.OrderBy("name ASC")}
+	if e.accessControl {
+		mods = append(mods, qm.Where("id IN (SELECT ledger_id FROM members WHERE phone = ?)", phoneOf(sender)))
+	}
+	ledgers, err := models.Ledgers(mods...).All(ctx, e.db)
+	if err != nil {
+		log.Println("Error fetching ledgers:", err)
+		return Reply{Text: "❌ Error fetching ledgers"}
+	}
+
+	var sb strings.Builder
+	sb.WriteString("📒 *Ledgers*")
+	for _, l := range ledgers {
+		sb.WriteString("\n• " + l.Name)
+		if l.ID == current.ID {
+			sb.WriteString(" (this chat)")
+		}
+	}
+	return Reply{Text: sb.String()}
+}
+
+// useLedger handles "use ledger <name>", binding the chat to the named
+// ledger and creating it if it does not exist yet. With access control the
+// sender must be an admin of an existing ledger, and becomes the admin of a
+// new one.
+func (e *Engine) useLedger(ctx context.Context, cmd Command, name string) Reply {
+	name = strings.TrimSpace(name)
+	if !namePattern.MatchString(name) {
+		return Reply{Text: "⚠️ Ledger names may only contain letters, digits, '-' and '_'"}
+	}
+	if cmd.Chat == "" {
+		return Reply{Text: "⚠️ Only chats can be bound to a ledger"}
+	}
+
+	ledger, err := e.findLedger(ctx, name)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		log.Println("Error fetching ledger:", err)
+		return Reply{Text: "❌ Error switching ledger"}
+	}
+	if ledger != nil && e.accessControl {
+		member, err := e.memberOf(ctx, ledger.ID.Int64, cmd.Sender)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			log.Println("Error fetching member:", err)
+			return Reply{Text: "❌ Error switching ledger"}
+		}
+		if member == nil || member.Role != roleAdmin {
+			return Reply{Text: fmt.Sprintf("⛔ Only admins of the %s ledger can move a chat to it", name)}
+		}
+	}
+
+	dbTx, err := e.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Println("Error starting ledger switch:", err)
+		return Reply{Text: "❌ Error switching ledger"}
+	}
+	defer dbTx.Rollback()
+
+	created := ledger == nil
+	if created {
+		ledger = &models.Ledger{Name: name, CreatedAt: e.timestamp()}
+		if err := ledger.Insert(ctx, dbTx, boil.Infer()); err != nil {
+			log.Println("Error saving ledger:", err)
+			return Reply{Text: "❌ Error switching ledger"}
+		}
+		if e.accessControl {
+			admin := &models.Member{LedgerID: ledger.ID.Int64, Phone: phoneOf(cmd.Sender), Name: cmd.SenderName, Role: roleAdmin, CreatedAt: e.timestamp()}
+			if err := admin.Insert(ctx, dbTx, boil.Infer()); err != nil {
+				log.Println("Error saving member:", err)
+				return Reply{Text: "❌ Error switching ledger"}
+			}
+		}
+	}
+
+	binding, err := models.Chats(models.ChatWhere.Jid.EQ(cmd.Chat)).One(ctx, dbTx)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		binding = &models.Chat{Jid: cmd.Chat, LedgerID: ledger.ID.Int64, CreatedAt: e.timestamp()}
+		err = binding.Insert(ctx, dbTx, boil.Infer())
+	case err == nil:
+		binding.LedgerID = ledger.ID.Int64
+		_, err = binding.Update(ctx, dbTx, boil.Whitelist(models.ChatColumns.LedgerID))
+	}
+	if err != nil {
+		log.Println("Error binding chat:", err)
+		return Reply{Text: "❌ Error switching ledger"}
+	}
+	if err := dbTx.Commit(); err != nil {
+		log.Println("Error committing ledger switch:", err)
+		return Reply{Text: "❌ Error switching ledger"}
+	}
+
+	if created && e.accessControl {
+		return Reply{Text: fmt.Sprintf("📒 Ledger *%s* created for this chat and you are its admin. Add others with: add member <phone> <name> as <admin|writer|viewer>", name)}
+	}
+	if created {
+		return Reply{Text: fmt.Sprintf("📒 Ledger *%s* created for this chat", name)}
+	}
+	return Reply{Text: fmt.Sprintf("📒 This chat now uses the *%s* ledger", name)}
+}
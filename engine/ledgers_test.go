@@ -0,0 +1,129 @@
+package engine
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLedgers(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEngine(t, time.Date(2025, time.June, 28, 10, 0, 0, 0, time.UTC))
+
+	const (
+		home = "6281111111111@s.whatsapp.net"
+		trip = "bali@g.us"
+	)
+	send := func(chat, text string) string {
+		t.Helper()
+		replies := e.Handle(ctx, Command{Sender: "6281111111111@s.whatsapp.net", Chat: chat, Text: text})
+		if len(replies) == 0 {
+			return ""
+		}
+		return replies[0].Text
+	}
+
+	tests := []struct {
+		name string
+		chat string
+		text string
+		want string
+	}{
+		{"chats start in the household ledger", trip, "ledger", "This chat uses the *household* ledger"},
+		{"household income", home, "income\nsalary = 1.000.000", "New Balance: Rp 1.000.000"},
+		{"binding creates the ledger", trip, "use ledger Trip", "Ledger *trip* created for this chat"},
+		{"a new ledger starts empty", trip, "expense\nhotel = 300.000", "New Balance: Rp -300.000"},
+		{"the household balance is untouched", home, "balance", "Total Balance: Rp 1.000.000"},
+		{"reports only cover the chat's ledger", trip, "mutation today", "hotel"},
+		{"transactions of other ledgers are not found", trip, "delete #1", "Transaction #1 not found"},
+		{"undo stays in the ledger", home, "undo", "salary"},
+		{"ledgers are listed", trip, "ledgers", "• household\n• trip (this chat)"},
+		{"binding to an existing ledger", home, "use ledger trip", "This chat now uses the *trip* ledger"},
+		{"both chats share the ledger", home, "balance", "Total Balance: Rp -300.000"},
+		{"invalid names are rejected", trip, "use ledger a b", "may only contain"},
+	}
+	for _, tt := range tests {
+		if got := send(tt.chat, tt.text); !strings.Contains(got, tt.want) {
+			t.Errorf("%s: reply %q, want %q", tt.name, got, tt.want)
+		}
+	}
+	if got := send(trip, "mutation today"); strings.Contains(got, "salary") {
+		t.Errorf("trip report includes household transactions: %q", got)
+	}
+}
+
+func TestLedgerBudgets(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEngine(t, time.Date(2025, time.June, 28, 10, 0, 0, 0, time.UTC))
+	send := func(chat, text string) string {
+		t.Helper()
+		return e.Handle(ctx, Command{Sender: "me", Chat: chat, Text: text})[0].Text
+	}
+
+	send("shop@g.us", "use ledger shop")
+	send("home@g.us", "add category food")
+	send("home@g.us", "budget food = 100.000")
+	if got := send("shop@g.us", "budget status"); !strings.Contains(got, "No budgets yet") {
+		t.Errorf("shop sees the household budget: %q", got)
+	}
+	if got := send("shop@g.us", "expense\nstock #food = 500.000"); strings.Contains(got, "Budget for food") {
+		t.Errorf("shop expense counted against the household budget: %q", got)
+	}
+	if got := send("home@g.us", "budget status"); !strings.Contains(got, "Used: Rp 0 of Rp 100.000") {
+		t.Errorf("household budget includes shop spending: %q", got)
+	}
+}
+
+func TestLedgerMembers(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEngine(t, time.Date(2025, time.June, 28, 10, 0, 0, 0, time.UTC), WithAccessControl())
+	if err := e.SeedMembers(ctx, []Member{
+		{Phone: "6281111111111", Name: "Fikri", Role: roleAdmin},
+		{Phone: "6282222222222", Name: "Sari", Role: roleWriter},
+	}); err != nil {
+		t.Fatalf("SeedMembers: %v", err)
+	}
+
+	const (
+		admin   = "6281111111111@s.whatsapp.net"
+		writer  = "6282222222222@s.whatsapp.net"
+		partner = "6283333333333@s.whatsapp.net"
+		shop    = "shop@g.us"
+	)
+	send := func(sender, chat, text string) string {
+		t.Helper()
+		replies := e.Handle(ctx, Command{Sender: sender, Chat: chat, Text: text})
+		if len(replies) == 0 {
+			return ""
+		}
+		return replies[0].Text
+	}
+
+	tests := []struct {
+		name   string
+		sender string
+		chat   string
+		text   string
+		want   string
+	}{
+		{"writers cannot create ledgers", writer, shop, "use ledger shop", "⛔ Only admins can do that"},
+		{"admins create ledgers", admin, shop, "use ledger shop", "you are its admin"},
+		{"household members are not shop members", writer, shop, "balance", ""},
+		{"shop admins add members", admin, shop, "add member 6283333333333 Budi as writer", "✅ Budi added as writer"},
+		{"shop members record", partner, shop, "expense\nstock = 200.000", "New Balance: Rp -200.000"},
+		{"shop members cannot use the household", partner, writer, "balance", ""},
+		{"members are listed per ledger", admin, shop, "members", "• Budi (6283333333333): writer"},
+		{"only ledgers you belong to are listed", partner, shop, "ledgers", "📒 *Ledgers*\n• shop (this chat)"},
+		{"moving a chat needs admin of the target", partner, shop, "use ledger household", "⛔ Only admins can do that"},
+	}
+	for _, tt := range tests {
+		got := send(tt.sender, tt.chat, tt.text)
+		if tt.want == "" && got != "" || !strings.Contains(got, tt.want) {
+			t.Errorf("%s: reply %q, want %q", tt.name, got, tt.want)
+		}
+	}
+	if got := send(admin, shop, "members"); strings.Contains(got, "Sari") {
+		t.Errorf("shop members include the household: %q", got)
+	}
+}
@@ -0,0 +1,127 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+)
+
+// otherMembers labels transactions without a sender, such as those recorded
+// by the scheduler or before senders were tracked.
+const otherMembers = "others"
+
+// displayName returns the friendly name of a sender, falling back to the
+// phone number of its identity.
+func displayName(sender, name string) string {
+	if name != "" {
+		return name
+	}
+	return phoneOf(sender)
+}
+
+// MemberTotal is what one member recorded over a period. Expense is
+// positive, the sum of the member's expenses.
+type MemberTotal struct {
+	Name    string
+	Expense int64
+	Income  int64
+}
+
+// MemberTotals totals the income and expenses of ledger in [start, end)
+// per member, in no particular order. A member who wrote from several
+// devices is counted once, under the name of their most recent
+// transaction.
+func MemberTotals(ctx context.Context, exec boil.ContextExecutor, ledger int64, start, end time.Time) ([]MemberTotal, error) {
+	rows, err := exec.QueryContext(ctx, `
+		SELECT COALESCE(sender, ''), COALESCE(sender_name, ''), type, SUM(amount), MAX(id)
+		FROM transactions
+		WHERE ledger_id = ? AND type IN ('income', 'expense') AND created_at >= ? AND created_at < ?
+		GROUP BY 1, 2, 3`,
+		ledger, start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	members := map[string]*MemberTotal{}
+	named := map[string]int64{} // the latest transaction that gave a name
+	for rows.Next() {
+		var sender, name, txType string
+		var total, latest int64
+		if err := rows.Scan(&sender, &name, &txType, &total, &latest); err != nil {
+			return nil, err
+		}
+
+		phone := phoneOf(sender)
+		m, ok := members[phone]
+		if !ok {
+			m = &MemberTotal{Name: displayName(sender, "")}
+			if m.Name == "" {
+				m.Name = otherMembers
+			}
+			members[phone] = m
+		}
+		if name != "" && latest > named[phone] {
+			m.Name, named[phone] = name, latest
+		}
+		if txType == "expense" {
+			m.Expense += -total
+		} else {
+			m.Income += total
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	totals := make([]MemberTotal, 0, len(members))
+	for _, m := range members {
+		totals = append(totals, *m)
+	}
+	return totals, nil
+}
+
+func (e *Engine) getMemberReport(ctx context.Context, ledger int64, p period) Reply {
+	totals, err := MemberTotals(ctx, e.db, ledger, p.start, p.end)
+	if err != nil {
+		log.Println("Error fetching member report:", err)
+		return Reply{Text: "❌ Error fetching member report"}
+	}
+	return Reply{Text: e.buildMemberResponse(totals, p.label)}
+}
+
+func (e *Engine) buildMemberResponse(totals []MemberTotal, period string) string {
+	if len(totals) == 0 {
+		return fmt.Sprintf("👥 *Spending by Member*\nPeriod: %s\n\nNo transactions found", period)
+	}
+
+	sort.Slice(totals, func(i, j int) bool {
+		if totals[i].Expense != totals[j].Expense {
+			return totals[i].Expense > totals[j].Expense
+		}
+		return totals[i].Name < totals[j].Name
+	})
+
+	var expense, income int64
+	for _, m := range totals {
+		expense += m.Expense
+		income += m.Income
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("👥 *Spending by Member*\nPeriod: %s\n", period))
+	for _, m := range totals {
+		sb.WriteString(fmt.Sprintf("\n👤 *%s*\nExpenses: %s (%s)\nIncome: %s (%s)\n",
+			m.Name,
+			e.money(m.Expense), formatPercent(m.Expense, expense),
+			e.money(m.Income), formatPercent(m.Income, income)))
+	}
+	sb.WriteString(fmt.Sprintf("\n💸 *Total Expenses*: %s\n💵 *Total Income*: %s",
+		e.money(expense), e.money(income)))
+	return sb.String()
+}
@@ -0,0 +1,46 @@
+package engine
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMemberReport(t *testing.T) {
+	e := newTestEngine(t, time.Date(2025, time.June, 28, 10, 0, 0, 0, time.UTC))
+	ctx := context.Background()
+
+	for _, cmd := range []Command{
+		{Sender: "6281111@s.whatsapp.net", SenderName: "Budi", Text: "income\nsalary = 1.000.000"},
+		// The same member from another device, with a new profile name
+		{Sender: "6281111:7@s.whatsapp.net", SenderName: "Pak Budi", Text: "expense\nfuel = 100.000"},
+		{Sender: "6282222@s.whatsapp.net", SenderName: "Ani", Text: "expense\ngroceries = 300.000"},
+		{Sender: "6283333@s.whatsapp.net", Text: "expense\nsnack = 100.000"},
+	} {
+		e.Handle(ctx, cmd)
+	}
+
+	reply := e.Handle(ctx, Command{Text: "who spent"})[0].Text
+	for _, want := range []string{
+		"👤 *Ani*\nExpenses: Rp 300.000 (60.0%)\nIncome: Rp 0 (0.0%)",
+		"👤 *Pak Budi*\nExpenses: Rp 100.000 (20.0%)\nIncome: Rp 1.000.000 (100.0%)",
+		"👤 *6283333*\nExpenses: Rp 100.000 (20.0%)",
+		"*Total Expenses*: Rp 500.000",
+	} {
+		if !strings.Contains(reply, want) {
+			t.Errorf("reply %q does not contain %q", reply, want)
+		}
+	}
+	if strings.Count(reply, "Budi") != 1 {
+		t.Errorf("one member is listed twice: %q", reply)
+	}
+	if strings.Index(reply, "Ani") > strings.Index(reply, "Budi") {
+		t.Errorf("members should be ordered by spending: %q", reply)
+	}
+
+	mutations := e.Handle(ctx, Command{Text: "today's mutation"})[0].Text
+	if !strings.Contains(mutations, "⏰ Sat, 28 Jun 2025 10:00 · 👤 Ani\ngroceries: Rp -300.000") {
+		t.Errorf("mutation report does not show the sender: %q", mutations)
+	}
+}
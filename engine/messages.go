@@ -0,0 +1,242 @@
+package engine
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"financial-bot/models"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+)
+
+// LinkMessage records that the message with messageID in chat recorded or
+// reports on batch, such as the bot's reply carrying Reply.Batch. A reply
+// quoting the message then acts on that batch's transactions.
+func (e *Engine) LinkMessage(ctx context.Context, chat, messageID string, batch int64) error {
+	if messageID == "" || batch == 0 {
+		return nil
+	}
+	message := &models.Message{Chat: chat, MessageID: messageID, BatchID: batch, CreatedAt: e.timestamp()}
+	return message.Upsert(ctx, e.db, true,
+		[]string{models.MessageColumns.Chat, models.MessageColumns.MessageID},
+		boil.Whitelist(models.MessageColumns.BatchID), boil.Infer())
+}
+
+// linkedTransactions returns the link of the message messageID in chat and
+// the transactions of ledger its batch recorded. The message is nil when
+// it is not linked to a batch.
+func (e *Engine) linkedTransactions(ctx context.Context, chat, messageID string, ledger int64) (*models.Message, models.TransactionSlice, error) {
+	message, err := models.Messages(
+		models.MessageWhere.Chat.EQ(chat),
+		models.MessageWhere.MessageID.EQ(messageID),
+	).One(ctx, e.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	transactions, err := e.batchTransactions(ctx, null.Int64From(message.BatchID))
+	if err != nil {
+		return nil, nil, err
+	}
+	var result models.TransactionSlice
+	for _, tx := range transactions {
+		if tx.LedgerID == ledger {
+			result = append(result, tx)
+		}
+	}
+	return message, result, nil
+}
+
+// quotedTransactions returns the transactions of ledger recorded by the
+// batch linked to the message cmd quotes. When there are none it returns
+// nil and the reply explaining why.
+func (e *Engine) quotedTransactions(ctx context.Context, cmd Command, ledger int64) (models.TransactionSlice, Reply) {
+	message, transactions, err := e.linkedTransactions(ctx, cmd.Chat, cmd.QuotedID, ledger)
+	if err != nil {
+		log.Println("Error fetching transactions:", err)
+		return nil, Reply{Text: "❌ Error fetching transactions"}
+	}
+	if message == nil {
+		return nil, Reply{Text: "⚠️ That message did not record any transactions"}
+	}
+	if len(transactions) == 0 {
+		return nil, Reply{Text: "⚠️ The transactions of that message were already deleted"}
+	}
+	return transactions, Reply{}
+}
+
+// deleteQuoted handles "delete" sent as a reply, deleting every
+// transaction the quoted message recorded.
+func (e *Engine) deleteQuoted(ctx context.Context, cmd Command, ledger int64) Reply {
+	if cmd.QuotedID == "" {
+		return Reply{Text: "⚠️ Use: delete #<id>, or reply to an update with: delete"}
+	}
+	transactions, reply := e.quotedTransactions(ctx, cmd, ledger)
+	if transactions == nil {
+		return reply
+	}
+	if reply, ok := e.removable(ctx, transactions); !ok {
+		return reply
+	}
+	if err := e.deleteTransactions(ctx, transactions); err != nil {
+		log.Println("Error deleting transactions:", err)
+		return Reply{Text: "❌ Error deleting transaction"}
+	}
+	return e.correctionReply(ctx, ledger, "🗑️ *Deleted*", e.formatEntries(transactions))
+}
+
+// editQuoted handles "edit [description] = <amount>" sent as a reply. It
+// only applies when the quoted message recorded a single transaction, or
+// a single transfer; otherwise the sender has to say which one.
+func (e *Engine) editQuoted(ctx context.Context, cmd Command, ledger int64, args string) Reply {
+	transactions, reply := e.quotedTransactions(ctx, cmd, ledger)
+	if transactions == nil {
+		return reply
+	}
+	target := transactions[0]
+	for _, tx := range transactions[1:] {
+		if tx.Type != "transfer" || target.Type != "transfer" {
+			return Reply{Text: fmt.Sprintf("⚠️ That message recorded %d transactions, pick one with: edit #<id> = <amount>\n%s",
+				len(transactions), strings.Join(e.formatEntries(transactions), "\n"))}
+		}
+	}
+	return e.editTransaction(ctx, ledger, fmt.Sprintf("#%d %s", target.ID.Int64, strings.TrimSpace(args)))
+}
+
+// recordCommands are the first lines of the commands that record
+// transactions; those ending in a space take arguments.
+var recordCommands = []string{"income", "expense", "transfer ", "save ", "lend ", "borrow ", "repay "}
+
+// recordsTransactions reports whether text is a command that records
+// transactions, such as an expense.
+func recordsTransactions(text string) bool {
+	first, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(text)), "\n")
+	first = strings.TrimSpace(first)
+	return slices.ContainsFunc(recordCommands, func(command string) bool {
+		return first == command || (strings.HasSuffix(command, " ") && strings.HasPrefix(first, command))
+	})
+}
+
+// replacedKey is the context key of the transactions an edited message
+// recorded before, which the batch of its new text replaces.
+type replacedKey struct{}
+
+// unlinkMessage deletes the transactions a message recorded along with its
+// link, for when the message itself was edited or deleted.
+func (e *Engine) unlinkMessage(ctx context.Context, message *models.Message, transactions models.TransactionSlice) error {
+	if err := e.deleteTransactions(ctx, transactions); err != nil {
+		return err
+	}
+	_, err := message.Delete(ctx, e.db)
+	return err
+}
+
+// Edit applies an edit of the message cmd.MessageID, whose new text is
+// cmd.Text: the transactions the message recorded are replaced by those of
+// the new text. Edits of messages known to have recorded nothing are
+// handled like new messages, so a mistyped command can be fixed by editing
+// it. Other messages, such as ones recorded before edits were tracked, are
+// left alone, with a warning when the new text would record transactions.
+// Transactions are dated when the original message was sent.
+func (e *Engine) Edit(ctx context.Context, cmd Command) []Reply {
+	ledger, role, ok := e.access(ctx, &cmd)
+	if !ok {
+		return nil
+	}
+	id := ledger.ID.Int64
+	message, transactions, err := e.linkedTransactions(ctx, cmd.Chat, cmd.MessageID, id)
+	if err != nil {
+		log.Println("Error fetching transactions:", err)
+		return []Reply{{Text: "❌ Error applying the edit"}}
+	}
+	if message == nil {
+		processed, err := e.processedMessage(ctx, cmd.Chat, cmd.MessageID)
+		if errors.Is(err, sql.ErrNoRows) || (err == nil && processed.Outcome == outcomeRecorded) {
+			// Edits of ordinary chat need no answer
+			if !recordsTransactions(cmd.Text) {
+				return nil
+			}
+			return []Reply{{Text: "⚠️ That message can't be edited, correct its transactions with: edit #<id> = <amount>"}}
+		}
+		if err != nil {
+			log.Println("Error fetching processed message:", err)
+			return []Reply{{Text: "❌ Error applying the edit"}}
+		}
+		// Dated like the original message, not the edit
+		if processed.SentAt.Valid {
+			cmd.SentAt = processed.SentAt.Time
+		}
+		replies := e.Handle(ctx, cmd)
+		e.updateOutcome(ctx, cmd.Chat, cmd.MessageID, replies)
+		return replies
+	}
+	if len(transactions) == 0 {
+		return []Reply{{Text: "⚠️ The transactions of that message were already deleted"}}
+	}
+	if !hasRole(role, roleWriter) {
+		return []Reply{{Text: fmt.Sprintf("⛔ Only %ss can do that, you are a %s", roleWriter, role)}}
+	}
+	if reply, ok := e.removable(ctx, transactions); !ok {
+		return []Reply{reply}
+	}
+
+	// The old transactions go together with recording the new ones, so
+	// they stay when the new text records nothing. The new ones keep the
+	// date of the old.
+	cmd.SentAt = transactions[0].CreatedAt
+	title := "✏️ *Message Edited*\nRemoved:"
+	replies := e.Handle(context.WithValue(ctx, replacedKey{}, transactions), cmd)
+	switch {
+	case slices.ContainsFunc(replies, func(reply Reply) bool { return reply.Batch != 0 }):
+		replies[0].Text = fmt.Sprintf("%s\n%s\n\n%s", title, strings.Join(e.formatEntries(transactions), "\n"), replies[0].Text)
+		return replies
+	case len(replies) == 0:
+		// Edited into plain text, the message no longer records anything
+		if err := e.unlinkMessage(ctx, message, transactions); err != nil {
+			log.Println("Error deleting transactions:", err)
+			return []Reply{{Text: "❌ Error applying the edit"}}
+		}
+		e.updateOutcome(ctx, cmd.Chat, cmd.MessageID, replies)
+		return []Reply{e.correctionReply(ctx, id, title, e.formatEntries(transactions))}
+	default:
+		replies[0].Text = "⚠️ The edit recorded nothing, the original transactions were kept\n\n" + replies[0].Text
+		return replies
+	}
+}
+
+// Revoke reverses the transactions the message cmd.MessageID recorded,
+// after its sender deleted it for everyone.
+func (e *Engine) Revoke(ctx context.Context, cmd Command) []Reply {
+	ledger, role, ok := e.access(ctx, &cmd)
+	if !ok {
+		return nil
+	}
+	id := ledger.ID.Int64
+	message, transactions, err := e.linkedTransactions(ctx, cmd.Chat, cmd.MessageID, id)
+	if err != nil {
+		log.Println("Error fetching transactions:", err)
+		return []Reply{{Text: "❌ Error deleting transactions"}}
+	}
+	if message == nil || len(transactions) == 0 {
+		return nil
+	}
+	if !hasRole(role, roleWriter) {
+		return []Reply{{Text: fmt.Sprintf("⛔ Only %ss can do that, you are a %s", roleWriter, role)}}
+	}
+	if reply, ok := e.removable(ctx, transactions); !ok {
+		return []Reply{reply}
+	}
+	if err := e.unlinkMessage(ctx, message, transactions); err != nil {
+		log.Println("Error deleting transactions:", err)
+		return []Reply{{Text: "❌ Error deleting transactions"}}
+	}
+	return []Reply{e.correctionReply(ctx, id, "🗑️ *Message Deleted*", e.formatEntries(transactions))}
+}
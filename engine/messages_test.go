@@ -0,0 +1,223 @@
+package engine
+
+import (
+	"context"
+	"financial-bot/models"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestQuotedReplies(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEngine(t, time.Date(2026, time.October, 17, 10, 0, 0, 0, time.UTC))
+	// send delivers text as if it quoted the message with ID quoted, and
+	// links the reply to the ID "bot-<n>" like the transport does
+	sent := 0
+	send := func(text, quoted string) string {
+		t.Helper()
+		reply := e.Handle(ctx, Command{Sender: "me", Chat: "family@g.us", Text: text, QuotedID: quoted})[0]
+		sent++
+		if err := e.LinkMessage(ctx, "family@g.us", "bot-"+strconv.Itoa(sent), reply.Batch); err != nil {
+			t.Fatalf("LinkMessage: %v", err)
+		}
+		return reply.Text
+	}
+	send("expense\nbread = 20rb", "")             // bot-1
+	send("expense\nrice = 60rb\nmilk = 15rb", "") // bot-2
+	send("add account cash", "")                  // bot-3, no batch
+	send("transfer main -> cash = 100rb", "")     // bot-4
+
+	tests := []struct {
+		name   string
+		text   string
+		quoted string
+		want   string
+	}{
+		{"editing a single transaction", "edit = 25rb", "bot-1", "✏️ *Edited*\n#1 bread: Rp -25.000 (was Rp -20.000)"},
+		{"editing with a new description", "edit roti = 30rb", "bot-1", "#1 roti: Rp -30.000 (was Rp -25.000)"},
+		{"an update with several transactions", "edit = 50rb", "bot-2", "⚠️ That message recorded 2 transactions, pick one with: edit #<id> = <amount>\n#2 rice: Rp -60.000\n#3 milk: Rp -15.000"},
+		{"an explicit reference wins", "edit #3 = 10rb", "bot-2", "#3 milk: Rp -10.000 (was Rp -15.000)"},
+		{"editing a transfer moves both legs", "edit = 50rb", "bot-4", "#4 transfer main -> cash: Rp -50.000 (was Rp -100.000)\n#5 transfer main -> cash: +Rp 50.000 (was +Rp 100.000)"},
+		{"a message without transactions", "delete", "bot-3", "⚠️ That message did not record any transactions"},
+		{"an unknown message", "delete", "someone-else", "⚠️ That message did not record any transactions"},
+		{"delete without a quote", "delete", "", "⚠️ Use: delete #<id>, or reply to an update with: delete"},
+		{"deleting exactly the quoted update", "delete", "bot-2", "🗑️ *Deleted*\n#2 rice: Rp -60.000\n#3 milk: Rp -10.000\n\nNew Balance: Rp -30.000"},
+		{"deleting it again", "delete", "bot-2", "⚠️ The transactions of that message were already deleted"},
+	}
+	for _, tt := range tests {
+		if got := send(tt.text, tt.quoted); !strings.Contains(got, tt.want) {
+			t.Errorf("%s: reply %q, want %q", tt.name, got, tt.want)
+		}
+	}
+
+	// Quotes only reach transactions of the chat's own ledger
+	e.Handle(ctx, Command{Sender: "me", Chat: "trip@g.us", Text: "use ledger trip"})
+	if err := e.LinkMessage(ctx, "trip@g.us", "bot-1", 1); err != nil {
+		t.Fatal(err)
+	}
+	if got := e.Handle(ctx, Command{Sender: "me", Chat: "trip@g.us", Text: "delete", QuotedID: "bot-1"})[0].Text; !strings.Contains(got, "already deleted") {
+		t.Errorf("deleted another ledger's transactions: %q", got)
+	}
+}
+
+func TestMessageEdits(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEngine(t, time.Date(2026, time.October, 17, 10, 0, 0, 0, time.UTC))
+	message := func(id, text string) Command {
+		return Command{Sender: "me", Chat: "family@g.us", Text: text, MessageID: id}
+	}
+	text := func(replies []Reply) string {
+		if len(replies) == 0 {
+			return ""
+		}
+		return replies[0].Text
+	}
+	// send delivers a message the way the transport does
+	send := func(id, text string) {
+		e.ProcessOnce(ctx, message(id, text), func() []Reply { return e.Handle(ctx, message(id, text)) })
+	}
+	send("m1", "expense\nbread = 20rb\nmilk = 15rb")
+	send("m2", "expense\nrice = 60rb")
+	send("m3", "good night")
+
+	tests := []struct {
+		name   string
+		edit   func() []Reply
+		want   []string
+		reject []string
+	}{
+		{
+			"an edit replaces what the message recorded",
+			func() []Reply { return e.Edit(ctx, message("m1", "expense\nbread = 25rb")) },
+			[]string{"✏️ *Message Edited*\nRemoved:\n#1 bread: Rp -20.000\n#2 milk: Rp -15.000\n\n💰 *Financial Update* 💰", "#4 bread: Rp -25.000", "Rp -85.000"},
+			nil,
+		},
+		{
+			"a second edit replaces the first",
+			func() []Reply { return e.Edit(ctx, message("m1", "income\nrefund = 5rb")) },
+			[]string{"Removed:\n#4 bread: Rp -25.000", "#5 refund: +Rp 5.000", "Rp -55.000"},
+			nil,
+		},
+		{
+			"an edit that records nothing keeps the transactions",
+			func() []Reply { return e.Edit(ctx, message("m1", "income\nrefund")) },
+			[]string{"⚠️ The edit recorded nothing, the original transactions were kept\n\n⚠️ *Nothing Recorded* ⚠️", "Rp -55.000"},
+			[]string{"Removed"},
+		},
+		{
+			"an edit into plain text only removes",
+			func() []Reply { return e.Edit(ctx, message("m1", "thanks")) },
+			[]string{"✏️ *Message Edited*\nRemoved:\n#5 refund: +Rp 5.000\n\nNew Balance: Rp -60.000"},
+			nil,
+		},
+		{
+			"editing it again records it anew",
+			func() []Reply { return e.Edit(ctx, message("m1", "expense\nkopi = 10rb")) },
+			[]string{"💰 *Financial Update* 💰", "#6 kopi: Rp -10.000"},
+			[]string{"Removed"},
+		},
+		{
+			"an edit of a message that was not a command",
+			func() []Reply { return e.Edit(ctx, message("m3", "see you")) },
+			nil,
+			nil,
+		},
+		{
+			"an edit of a message that was never processed",
+			func() []Reply { return e.Edit(ctx, message("m9", "expense\nkopi = 10rb")) },
+			[]string{"⚠️ That message can't be edited, correct its transactions with: edit #<id> = <amount>"},
+			nil,
+		},
+		{
+			"an edit of chat that was never processed",
+			func() []Reply { return e.Edit(ctx, message("m10", "see you at 7")) },
+			nil,
+			nil,
+		},
+		{
+			"an edit of a query that was never processed",
+			func() []Reply { return e.Edit(ctx, message("m11", "balance")) },
+			nil,
+			nil,
+		},
+		{
+			"a deletion reverses what the message recorded",
+			func() []Reply { return e.Revoke(ctx, message("m2", "")) },
+			[]string{"🗑️ *Message Deleted*\n#3 rice: Rp -60.000\n\nNew Balance: Rp -10.000"},
+			nil,
+		},
+		{
+			"a second deletion does nothing",
+			func() []Reply { return e.Revoke(ctx, message("m2", "")) },
+			nil,
+			nil,
+		},
+		{
+			"deleting a message that recorded nothing",
+			func() []Reply { return e.Revoke(ctx, message("m3", "")) },
+			nil,
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		got := text(tt.edit())
+		if tt.want == nil && got != "" {
+			t.Errorf("%s: reply %q, want none", tt.name, got)
+		}
+		for _, want := range tt.want {
+			if !strings.Contains(got, want) {
+				t.Errorf("%s: reply %q, want %q", tt.name, got, want)
+			}
+		}
+		for _, reject := range tt.reject {
+			if strings.Contains(got, reject) {
+				t.Errorf("%s: reply %q contains %q", tt.name, got, reject)
+			}
+		}
+	}
+
+	// Transactions deleted by hand are not recorded again by an edit
+	e.Handle(ctx, message("", "delete #6"))
+	if got := text(e.Edit(ctx, message("m1", "expense\nkopi = 12rb"))); got != "⚠️ The transactions of that message were already deleted" {
+		t.Errorf("edit after delete: %q", got)
+	}
+
+	// Messages that recorded transactions before they were linked are not
+	// recorded again
+	e.ProcessOnce(ctx, message("old", "expense\nsugar = 12rb"), func() []Reply {
+		return e.Handle(ctx, message("", "expense\nsugar = 12rb"))
+	})
+	if got := text(e.Edit(ctx, message("old", "expense\nsugar = 15rb"))); !strings.Contains(got, "can't be edited") {
+		t.Errorf("edit of an unlinked message: %q", got)
+	}
+	if got := e.Handle(ctx, Command{Text: "balance"})[0].Text; !strings.Contains(got, "Rp -12.000") {
+		t.Errorf("balance after editing an unlinked message: %q", got)
+	}
+}
+
+func TestMessageEditsKeepDate(t *testing.T) {
+	ctx := context.Background()
+	sent := time.Date(2026, time.October, 31, 22, 0, 0, 0, time.UTC)
+	now := sent
+	e := newTestEngineWithClock(t, func() time.Time { return now })
+	for id, text := range map[string]string{"m1": "expense\nbread = 20rb", "m2": "expnse\nmilk = 15rb"} {
+		cmd := Command{Sender: "me", Chat: "family@g.us", Text: text, MessageID: id, SentAt: sent}
+		e.ProcessOnce(ctx, cmd, func() []Reply { return e.Handle(ctx, cmd) })
+	}
+
+	// Edited in the next month, the entries stay in October
+	now = time.Date(2026, time.November, 2, 9, 0, 0, 0, time.UTC)
+	e.Edit(ctx, Command{Sender: "me", Chat: "family@g.us", Text: "expense\nbread = 25rb", MessageID: "m1"})
+	e.Edit(ctx, Command{Sender: "me", Chat: "family@g.us", Text: "expense\nmilk = 15rb", MessageID: "m2"})
+	transactions, err := models.Transactions().All(ctx, e.db)
+	if err != nil || len(transactions) != 2 {
+		t.Fatalf("transactions = %v, %v", transactions, err)
+	}
+	for _, tx := range transactions {
+		if !tx.CreatedAt.Equal(sent) {
+			t.Errorf("%s dated %v, want %v", tx.Description.String, tx.CreatedAt, sent)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package engine
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// period is a half-open time range [start, end) with a human readable label
+// for report headers. The bounds are kept in UTC, the form timestamps are
+// stored in, so they can be compared with created_at directly.
+type period struct {
+	start, end time.Time
+	label      string
+}
+
+// periodHelp lists the accepted period formats, shared by every error
+// message about an invalid period.
+const periodHelp = "Use today, yesterday, this week, last week, this month, last month, this year, YYYY-MM-DD, YYYY-MM, YYYY or from YYYY-MM-DD to YYYY-MM-DD"
+
+// parsePeriod parses a period relative to now, such as "yesterday",
+// "last month", "2026-08" or "from 2026-09-01 to 2026-09-15". Both dates of
+// a "from ... to ..." range are included. Days begin at midnight in now's
+// location.
+func parsePeriod(s string, now time.Time) (period, error) {
+	s = strings.Join(strings.Fields(s), " ")
+	s = strings.TrimPrefix(s, "date ")
+	loc := now.Location()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+
+	switch s {
+	case "today":
+		return dayPeriod(today), nil
+	case "yesterday":
+		return dayPeriod(today.AddDate(0, 0, -1)), nil
+	case "week", "this week":
+		return weekPeriod(today), nil
+	case "last week":
+		return weekPeriod(today.AddDate(0, 0, -7)), nil
+	case "month", "this month":
+		return monthPeriod(today.Year(), today.Month(), loc), nil
+	case "last month":
+		return monthPeriod(today.Year(), today.Month()-1, loc), nil
+	case "year", "this year":
+		return yearPeriod(today.Year(), loc), nil
+	case "last year":
+		return yearPeriod(today.Year()-1, loc), nil
+	}
+
+	if rest, ok := strings.CutPrefix(s, "from "); ok {
+		fromText, toText, ok := strings.Cut(rest, " to ")
+		if !ok {
+			return period{}, invalidPeriod(s)
+		}
+		from, err := time.ParseInLocation("2006-01-02", strings.TrimSpace(fromText), loc)
+		if err != nil {
+			return period{}, invalidPeriod(s)
+		}
+		to, err := time.ParseInLocation("2006-01-02", strings.TrimSpace(toText), loc)
+		if err != nil {
+			return period{}, invalidPeriod(s)
+		}
+		if to.Before(from) {
+			return period{}, fmt.Errorf("invalid period %q: %s is after %s", s, fromText, toText)
+		}
+		return newPeriod(from, to.AddDate(0, 0, 1),
+			fmt.Sprintf("%s to %s", from.Format("2006-01-02"), to.Format("2006-01-02"))), nil
+	}
+
+	if day, err := time.ParseInLocation("2006-01-02", s, loc); err == nil {
+		return dayPeriod(day), nil
+	}
+	if month, err := time.Parse("2006-01", s); err == nil {
+		return monthPeriod(month.Year(), month.Month(), loc), nil
+	}
+	if year, err := time.Parse("2006", s); err == nil {
+		return yearPeriod(year.Year(), loc), nil
+	}
+	return period{}, invalidPeriod(s)
+}
+
+func invalidPeriod(s string) error {
+	return fmt.Errorf("invalid period %q", s)
+}
+
+// invalidPeriodReply is the reply for a period parsePeriod rejected.
+func invalidPeriodReply(err error) Reply {
+	return Reply{Text: fmt.Sprintf("⚠️ %s. %s", capitalize(err.Error()), periodHelp)}
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// newPeriod returns the period [start, end) with its bounds converted to UTC.
+func newPeriod(start, end time.Time, label string) period {
+	return period{start: start.UTC(), end: end.UTC(), label: label}
+}
+
+// dayPeriod returns the day starting at midnight day, in day's location.
+func dayPeriod(day time.Time) period {
+	return newPeriod(day, day.AddDate(0, 0, 1), day.Format("2006-01-02"))
+}
+
+// weekPeriod returns the Monday-to-Sunday week containing day.
+func weekPeriod(day time.Time) period {
+	start := day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	end := start.AddDate(0, 0, 7)
+	return newPeriod(start, end,
+		fmt.Sprintf("Week of %s - %s", start.Format("02 Jan"), end.AddDate(0, 0, -1).Format("02 Jan 2006")))
+}
+
+func monthPeriod(year int, month time.Month, loc *time.Location) period {
+	start := time.Date(year, month, 1, 0, 0, 0, 0, loc)
+	return newPeriod(start, start.AddDate(0, 1, 0), "Month of "+start.Format("January 2006"))
+}
+
+func yearPeriod(year int, loc *time.Location) period {
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+	return newPeriod(start, start.AddDate(1, 0, 0), fmt.Sprintf("Year %d", year))
+}
@@ -0,0 +1,95 @@
+package engine
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParsePeriod(t *testing.T) {
+	// A Saturday
+	now := time.Date(2026, time.October, 17, 15, 30, 0, 0, time.UTC)
+	date := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		input      string
+		start, end time.Time
+		label      string
+	}{
+		{"today", date(2026, 10, 17), date(2026, 10, 18), "2026-10-17"},
+		{"yesterday", date(2026, 10, 16), date(2026, 10, 17), "2026-10-16"},
+		{"this week", date(2026, 10, 12), date(2026, 10, 19), "Week of 12 Oct - 18 Oct 2026"},
+		{"last week", date(2026, 10, 5), date(2026, 10, 12), "Week of 05 Oct - 11 Oct 2026"},
+		{"month", date(2026, 10, 1), date(2026, 11, 1), "Month of October 2026"},
+		{"last month", date(2026, 9, 1), date(2026, 10, 1), "Month of September 2026"},
+		{"last year", date(2025, 1, 1), date(2026, 1, 1), "Year 2025"},
+		{"2026-08", date(2026, 8, 1), date(2026, 9, 1), "Month of August 2026"},
+		{"date 2026-02-28", date(2026, 2, 28), date(2026, 3, 1), "2026-02-28"},
+		{"2024", date(2024, 1, 1), date(2025, 1, 1), "Year 2024"},
+		{"from 2026-09-01 to 2026-09-15", date(2026, 9, 1), date(2026, 9, 16), "2026-09-01 to 2026-09-15"},
+		{" from  2026-09-01  to 2026-09-01 ", date(2026, 9, 1), date(2026, 9, 2), "2026-09-01 to 2026-09-01"},
+	}
+	for _, tt := range tests {
+		p, err := parsePeriod(tt.input, now)
+		if err != nil {
+			t.Errorf("parsePeriod(%q): %v", tt.input, err)
+			continue
+		}
+		if !p.start.Equal(tt.start) || !p.end.Equal(tt.end) || p.label != tt.label {
+			t.Errorf("parsePeriod(%q) = [%s, %s) %q, want [%s, %s) %q",
+				tt.input, p.start, p.end, p.label, tt.start, tt.end, tt.label)
+		}
+	}
+
+	for _, invalid := range []string{"", "tomorrow-ish", "2026-13", "28-06-2025", "from 2026-09-15 to 2026-09-01", "from 2026-09-01", "from 2026-09-01 to soon"} {
+		if _, err := parsePeriod(invalid, now); err == nil {
+			t.Errorf("parsePeriod(%q) succeeded, want error", invalid)
+		}
+	}
+}
+
+func TestPeriodCommands(t *testing.T) {
+	now := time.Date(2026, time.October, 17, 15, 30, 0, 0, time.UTC)
+	e := newTestEngineWithClock(t, func() time.Time { return now })
+	ctx := context.Background()
+
+	record := func(at time.Time, text string) {
+		now = at
+		e.Handle(ctx, Command{Text: text})
+	}
+	record(time.Date(2026, time.September, 5, 9, 0, 0, 0, time.UTC), "expense\nseptember = 1.000")
+	record(time.Date(2026, time.October, 16, 9, 0, 0, 0, time.UTC), "expense\nfriday = 2.000")
+	record(time.Date(2026, time.October, 17, 9, 0, 0, 0, time.UTC), "expense\nsaturday = 3.000")
+	now = time.Date(2026, time.October, 17, 15, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		command string
+		want    []string
+		notWant []string
+	}{
+		{"yesterday", []string{"Period: 2026-10-16", "friday"}, []string{"saturday"}},
+		{"yesterday's mutation", []string{"friday"}, []string{"saturday"}},
+		{"this week's mutation", []string{"friday", "saturday"}, []string{"september"}},
+		{"last month’s mutation", []string{"Month of September 2026", "september"}, []string{"friday"}},
+		{"mutation 2026-09", []string{"september"}, []string{"friday"}},
+		{"mutation from 2026-09-01 to 2026-10-16", []string{"september", "friday"}, []string{"saturday"}},
+		{"mutation from 2026-10-16 to 2026-09-01", []string{"⚠️ Invalid period", "is after"}, nil},
+		{"mutation next week", []string{"⚠️ Invalid period \"next week\"", periodHelp}, nil},
+		{"category report last month", []string{"Month of September 2026", "Rp 1.000"}, nil},
+		{"who spent this week", []string{"Expenses: Rp 5.000"}, nil},
+	}
+	for _, tt := range tests {
+		reply := e.Handle(ctx, Command{Text: tt.command})[0].Text
+		for _, want := range tt.want {
+			if !strings.Contains(reply, want) {
+				t.Errorf("%q: reply %q does not contain %q", tt.command, reply, want)
+			}
+		}
+		for _, notWant := range tt.notWant {
+			if strings.Contains(reply, notWant) {
+				t.Errorf("%q: reply %q unexpectedly contains %q", tt.command, reply, notWant)
+			}
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package engine
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"financial-bot/models"
+	"log"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// Outcomes of processing a message, as recorded in processed_messages.
+const (
+	outcomeProcessing = "processing"
+	outcomeIgnored    = "ignored"
+	outcomeReplied    = "replied"
+	outcomeRecorded   = "recorded"
+)
+
+// outcomeOf describes the replies a message produced.
+func outcomeOf(replies []Reply) string {
+	if len(replies) == 0 {
+		return outcomeIgnored
+	}
+	for _, reply := range replies {
+		if reply.Batch != 0 {
+			return outcomeRecorded
+		}
+	}
+	return outcomeReplied
+}
+
+// processedMessage returns the record of the message messageID in chat.
+func (e *Engine) processedMessage(ctx context.Context, chat, messageID string) (*models.ProcessedMessage, error) {
+	return models.ProcessedMessages(
+		models.ProcessedMessageWhere.Chat.EQ(chat),
+		models.ProcessedMessageWhere.MessageID.EQ(messageID),
+	).One(ctx, e.db)
+}
+
+// updateOutcome records the outcome of processing the message messageID in
+// chat again, after an edit changed what it does.
+func (e *Engine) updateOutcome(ctx context.Context, chat, messageID string, replies []Reply) {
+	processed, err := e.processedMessage(ctx, chat, messageID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return
+	}
+	if err == nil {
+		processed.Outcome = outcomeOf(replies)
+		_, err = processed.Update(ctx, e.db, boil.Whitelist(models.ProcessedMessageColumns.Outcome))
+	}
+	if err != nil {
+		log.Println("Error saving processed message:", err)
+	}
+}
+
+// processedRetention is how long processed messages are remembered.
+// WhatsApp keeps undelivered messages for 30 days, so older ones are not
+// delivered again.
+const processedRetention = 30 * 24 * time.Hour
+
+// ProcessOnce runs process for the message cmd.MessageID in cmd.Chat, sent
+// at cmd.SentAt, and records its outcome, unless the message was processed
+// before. WhatsApp delivers messages again after reconnects and retries,
+// and processing one twice would record its transactions twice; duplicates
+// produce no replies. Messages from senders without access to the chat's
+// ledger are not recorded, as the bot ignores them anyway.
+//
+// A message is marked before it is processed, so one that crashed the bot
+// is not processed again either.
+func (e *Engine) ProcessOnce(ctx context.Context, cmd Command, process func() []Reply) []Reply {
+	chat, messageID, sentAt := cmd.Chat, cmd.MessageID, cmd.SentAt
+	if messageID == "" {
+		return process()
+	}
+	if _, _, ok := e.access(ctx, &cmd); !ok {
+		return process()
+	}
+	processed, err := e.processedMessage(ctx, chat, messageID)
+	if err == nil {
+		processed.Duplicates++
+		if _, err := processed.Update(ctx, e.db, boil.Whitelist(models.ProcessedMessageColumns.Duplicates)); err != nil {
+			log.Println("Error counting duplicate message:", err)
+		}
+		log.Printf("Skipped duplicate of message %s in %s (%s, delivered %d times), %d duplicates skipped since start",
+			messageID, chat, processed.Outcome, processed.Duplicates+1, e.duplicates.Add(1))
+		return nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		log.Println("Error fetching processed message:", err)
+		return nil
+	}
+
+	processed = &models.ProcessedMessage{Chat: chat, MessageID: messageID, Outcome: outcomeProcessing, ProcessedAt: e.timestamp(), SentAt: null.TimeFrom(e.timestamp())}
+	if !sentAt.IsZero() {
+		processed.SentAt = null.TimeFrom(sentAt.UTC())
+	}
+	if err := processed.Insert(ctx, e.db, boil.Infer()); err != nil {
+		log.Println("Error saving processed message:", err)
+		return nil
+	}
+	replies := process()
+	processed.Outcome = outcomeOf(replies)
+	if _, err := processed.Update(ctx, e.db, boil.Whitelist(models.ProcessedMessageColumns.Outcome)); err != nil {
+		log.Println("Error saving processed message:", err)
+	}
+	return replies
+}
+
+// pruneProcessed forgets the messages processed longer ago than
+// processedRetention, except the most recently sent one, which
+// LastProcessed needs to catch up after a long time offline.
+func (e *Engine) pruneProcessed(ctx context.Context) {
+	_, err := models.ProcessedMessages(
+		models.ProcessedMessageWhere.ProcessedAt.LT(e.timestamp().Add(-processedRetention)),
+		qm.Where("sent_at IS NULL OR sent_at < (SELECT MAX(sent_at) FROM processed_messages)"),
+	).DeleteAll(ctx, e.db)
+	if err != nil {
+		log.Println("Error pruning processed messages:", err)
+	}
+}
+
+// LastProcessed returns when the most recently sent message processed so
+// far was sent, or the zero time when none was.
+func (e *Engine) LastProcessed(ctx context.Context) (time.Time, error) {
+	processed, err := models.ProcessedMessages(
+		models.ProcessedMessageWhere.SentAt.IsNotNull(),
+		qm.OrderBy(models.ProcessedMessageColumns.SentAt+" DESC"),
+	).One(ctx, e.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	return processed.SentAt.Time, nil
+}
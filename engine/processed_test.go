@@ -0,0 +1,104 @@
+package engine
+
+import (
+	"context"
+	"financial-bot/models"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+func TestProcessOnce(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEngine(t, time.Date(2026, time.October, 17, 10, 0, 0, 0, time.UTC))
+	deliver := func(chat, id, text string) []Reply {
+		cmd := Command{Sender: "me", Chat: chat, Text: text, MessageID: id}
+		return e.ProcessOnce(ctx, cmd, func() []Reply { return e.Handle(ctx, cmd) })
+	}
+
+	tests := []struct {
+		chat, id, text string
+		replies        int
+		outcome        string
+	}{
+		{"family@g.us", "m1", "expense\nbread = 20rb", 1, outcomeRecorded},
+		{"family@g.us", "m1", "expense\nbread = 20rb", 0, outcomeRecorded},
+		{"family@g.us", "m2", "balance", 1, outcomeReplied},
+		{"family@g.us", "m3", "good morning", 0, outcomeIgnored},
+		{"family@g.us", "m3", "good morning", 0, outcomeIgnored},
+		// IDs are only unique within a chat
+		{"trip@g.us", "m1", "balance", 1, outcomeReplied},
+		// Without an ID there is nothing to recognise a redelivery by
+		{"family@g.us", "", "balance", 1, ""},
+		{"family@g.us", "", "balance", 1, ""},
+	}
+	for _, tt := range tests {
+		if got := deliver(tt.chat, tt.id, tt.text); len(got) != tt.replies {
+			t.Errorf("%s %q: %d replies, want %d", tt.id, tt.text, len(got), tt.replies)
+		}
+		if tt.id == "" {
+			continue
+		}
+		processed, err := models.ProcessedMessages(
+			models.ProcessedMessageWhere.Chat.EQ(tt.chat),
+			models.ProcessedMessageWhere.MessageID.EQ(tt.id),
+		).One(ctx, e.db)
+		if err != nil || processed.Outcome != tt.outcome {
+			t.Errorf("%s %q: processed %+v, %v, want outcome %s", tt.id, tt.text, processed, err, tt.outcome)
+		}
+	}
+
+	// The expense was recorded once, and the redeliveries counted
+	if n, _ := models.Transactions().Count(ctx, e.db); n != 1 {
+		t.Errorf("%d transactions recorded, want 1", n)
+	}
+	if got := e.duplicates.Load(); got != 2 {
+		t.Errorf("%d duplicates skipped, want 2", got)
+	}
+}
+
+func TestProcessOnceRemembersMembersOnly(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, time.October, 17, 10, 0, 0, 0, time.UTC)
+	e := newTestEngineWithClock(t, func() time.Time { return now }, WithAccessControl())
+	if err := e.SeedMembers(ctx, []Member{{Phone: "6281111111111", Name: "Budi", Role: roleAdmin}}); err != nil {
+		t.Fatalf("SeedMembers: %v", err)
+	}
+	deliver := func(sender, id, text string) {
+		cmd := Command{Sender: sender, Chat: "family@g.us", Text: text, MessageID: id}
+		e.ProcessOnce(ctx, cmd, func() []Reply { return e.Handle(ctx, cmd) })
+	}
+	deliver("6281111111111@s.whatsapp.net", "m1", "balance")
+	deliver("6289999999999@s.whatsapp.net", "m2", "good morning")
+	ids := func() []string {
+		t.Helper()
+		processed, err := models.ProcessedMessages(qm.OrderBy("message_id")).All(ctx, e.db)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ids []string
+		for _, p := range processed {
+			ids = append(ids, p.MessageID)
+		}
+		return ids
+	}
+	if got := ids(); !slices.Equal(got, []string{"m1"}) {
+		t.Errorf("processed %v, want only the member's message", got)
+	}
+
+	// Old messages are forgotten, except the last one sent
+	now = now.Add(20 * 24 * time.Hour)
+	deliver("6281111111111@s.whatsapp.net", "m3", "balance")
+	now = now.Add(15 * 24 * time.Hour)
+	e.Tick(ctx)
+	if got := ids(); !slices.Equal(got, []string{"m3"}) {
+		t.Errorf("processed %v after pruning, want m3", got)
+	}
+	now = now.Add(60 * 24 * time.Hour)
+	e.Tick(ctx)
+	if got := ids(); !slices.Equal(got, []string{"m3"}) {
+		t.Errorf("processed %v after a long time offline, want m3 kept", got)
+	}
+}
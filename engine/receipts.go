@@ -0,0 +1,130 @@
+package engine
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"financial-bot/models"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+)
+
+// receiptExtensions name stored receipts after their type, so they open
+// with the right program when browsed on disk.
+var receiptExtensions = map[string]string{
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+	"image/webp":      ".webp",
+	"image/heic":      ".heic",
+	"application/pdf": ".pdf",
+}
+
+// WithReceiptDir sets the directory photos and documents sent along with
+// income and expense messages are kept in. Without it they are not kept.
+func WithReceiptDir(dir string) Option {
+	return func(e *Engine) {
+		e.receiptDir = dir
+	}
+}
+
+// storeReceipt writes doc to the receipt directory under the hash of its
+// contents, records it and links it to transactions, all or nothing. A
+// file that was stored before is reused.
+func (e *Engine) storeReceipt(ctx context.Context, doc *Document, transactions []*models.Transaction) (err error) {
+	data, err := doc.data()
+	if err != nil {
+		return err
+	}
+	sum := sha256.Sum256(data)
+	hash := hex.EncodeToString(sum[:])
+
+	dbTx, err := e.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer dbTx.Rollback()
+
+	receipt, err := models.Receipts(models.ReceiptWhere.Hash.EQ(hash)).One(ctx, dbTx)
+	if errors.Is(err, sql.ErrNoRows) {
+		mimeType, _, _ := strings.Cut(doc.MimeType, ";")
+		ext, ok := receiptExtensions[mimeType]
+		if !ok {
+			ext = strings.ToLower(filepath.Ext(doc.FileName))
+		}
+		receipt = &models.Receipt{Hash: hash, FileName: hash + ext, MimeType: doc.MimeType, CreatedAt: e.timestamp()}
+		path := filepath.Join(e.receiptDir, receipt.FileName)
+		if err := os.MkdirAll(e.receiptDir, 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			return err
+		}
+		// No file is left behind without its row
+		defer func() {
+			if err != nil {
+				os.Remove(path)
+			}
+		}()
+		if err = receipt.Insert(ctx, dbTx, boil.Infer()); err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	}
+
+	for _, tx := range transactions {
+		tx.ReceiptID = receipt.ID
+		if _, err = tx.Update(ctx, dbTx, boil.Whitelist(models.TransactionColumns.ReceiptID)); err != nil {
+			return err
+		}
+	}
+	return dbTx.Commit()
+}
+
+// attachReceipt stores the file cmd carries, if any, and links it to the
+// recorded transactions. It returns a line for the reply, or "" when there
+// was nothing to attach.
+func (e *Engine) attachReceipt(ctx context.Context, cmd Command, transactions []*models.Transaction) string {
+	if cmd.Document == nil || e.receiptDir == "" || len(transactions) == 0 {
+		return ""
+	}
+	if err := e.storeReceipt(ctx, cmd.Document, transactions); err != nil {
+		log.Println("Error saving receipt:", err)
+		return "⚠️ The receipt could not be saved"
+	}
+	return "🧾 Receipt attached"
+}
+
+// sendReceipt handles "receipt #123", replying with the file stored for
+// the transaction.
+func (e *Engine) sendReceipt(ctx context.Context, ledger int64, ref string) Reply {
+	tx, reply := e.findTransaction(ctx, ledger, ref, "receipt")
+	if tx == nil {
+		return reply
+	}
+	if !tx.ReceiptID.Valid {
+		return Reply{Text: fmt.Sprintf("⚠️ Transaction #%d has no receipt", tx.ID.Int64)}
+	}
+
+	receipt, err := models.FindReceipt(ctx, e.db, tx.ReceiptID)
+	if err != nil {
+		log.Println("Error fetching receipt:", err)
+		return Reply{Text: "❌ Error fetching receipt"}
+	}
+	data, err := os.ReadFile(filepath.Join(e.receiptDir, receipt.FileName))
+	if err != nil {
+		log.Println("Error reading receipt:", err)
+		return Reply{Text: "❌ Error fetching receipt"}
+	}
+	return Reply{
+		Text:     "🧾 " + e.formatEntry(tx),
+		Document: &Document{FileName: fmt.Sprintf("receipt-%d%s", tx.ID.Int64, filepath.Ext(receipt.FileName)), MimeType: receipt.MimeType, Data: data},
+	}
+}
@@ -0,0 +1,104 @@
+package engine
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReceipts(t *testing.T) {
+	ctx := context.Background()
+	dir := filepath.Join(t.TempDir(), "receipts")
+	e := newTestEngine(t, time.Date(2026, time.October, 17, 10, 0, 0, 0, time.UTC), WithReceiptDir(dir))
+	photo := &Document{MimeType: "image/jpeg", Data: []byte("\xff\xd8\xff fake jpeg")}
+	send := func(text string, doc *Document) Reply {
+		t.Helper()
+		return e.Handle(ctx, Command{Sender: "me", Text: text, Document: doc})[0]
+	}
+
+	// Nothing is stored when no line is recorded
+	if got := send("expense\nbensin", photo).Text; strings.Contains(got, "Receipt") {
+		t.Errorf("receipt attached to nothing: %q", got)
+	}
+	if files, _ := os.ReadDir(dir); len(files) != 0 {
+		t.Errorf("receipt stored without transactions: %v", files)
+	}
+
+	if got := send("expense\nbensin = 50rb\nparkir = 5rb", photo).Text; !strings.Contains(got, "#2 parkir: Rp -5.000\n\n🧾 Receipt attached") {
+		t.Fatalf("expense with a photo: %q", got)
+	}
+	files, err := os.ReadDir(dir)
+	if err != nil || len(files) != 1 || !strings.HasSuffix(files[0].Name(), ".jpg") || len(files[0].Name()) != 64+len(".jpg") {
+		t.Fatalf("stored receipts = %v, %v", files, err)
+	}
+
+	// The same photo again is stored once
+	send("expense\ntol = 20rb", photo)
+	if files, _ := os.ReadDir(dir); len(files) != 1 {
+		t.Errorf("photo stored twice: %v", files)
+	}
+	send("expense\nkopi = 25rb", nil)
+
+	tests := []struct {
+		text string
+		want string
+		doc  bool
+	}{
+		{"receipt #1", "🧾 #1 bensin: Rp -50.000", true},
+		{"receipt #3", "🧾 #3 tol: Rp -20.000", true},
+		{"receipt #4", "⚠️ Transaction #4 has no receipt", false},
+		{"receipt #9", "⚠️ Transaction #9 not found", false},
+		{"receipt one", "⚠️ Use: receipt #<id>", false},
+	}
+	for _, tt := range tests {
+		reply := send(tt.text, nil)
+		if reply.Text != tt.want || (reply.Document != nil) != tt.doc {
+			t.Errorf("%q: reply %q (document %v), want %q", tt.text, reply.Text, reply.Document != nil, tt.want)
+		}
+		if tt.doc && (!bytes.Equal(reply.Document.Data, photo.Data) || reply.Document.MimeType != "image/jpeg" || !strings.HasSuffix(reply.Document.FileName, ".jpg")) {
+			t.Errorf("%q: document %s (%s) does not match the photo", tt.text, reply.Document.FileName, reply.Document.MimeType)
+		}
+	}
+
+	// Without a receipt directory photos are ignored
+	e = newTestEngine(t, time.Date(2026, time.October, 17, 10, 0, 0, 0, time.UTC))
+	if got := e.Handle(ctx, Command{Text: "expense\nbensin = 50rb", Document: photo})[0].Text; strings.Contains(got, "Receipt") {
+		t.Errorf("receipt attached without a receipt directory: %q", got)
+	}
+}
+
+func TestReceiptsDownloadOnlyWhenUsed(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEngine(t, time.Date(2026, time.October, 17, 10, 0, 0, 0, time.UTC), WithReceiptDir(t.TempDir()), WithAccessControl())
+	if err := e.SeedMembers(ctx, []Member{{Phone: "6281111111111", Name: "Fikri", Role: roleAdmin}}); err != nil {
+		t.Fatalf("SeedMembers: %v", err)
+	}
+	downloads := 0
+	photo := func() *Document {
+		return &Document{MimeType: "image/jpeg", Download: func() ([]byte, error) {
+			downloads++
+			return []byte("\xff\xd8\xff fake jpeg"), nil
+		}}
+	}
+
+	tests := []struct {
+		sender, text string
+		downloads    int
+	}{
+		{"6281111111111@s.whatsapp.net", "look at this sunset", 0},
+		{"6281111111111@s.whatsapp.net", "balance", 0},
+		{"6289999999999@s.whatsapp.net", "expense\nbensin = 50rb", 0},
+		{"6281111111111@s.whatsapp.net", "expense\nbensin = 50rb", 1},
+	}
+	for _, tt := range tests {
+		downloads = 0
+		e.Handle(ctx, Command{Sender: tt.sender, Text: tt.text, Document: photo()})
+		if downloads != tt.downloads {
+			t.Errorf("%s %q: %d downloads, want %d", tt.sender, tt.text, downloads, tt.downloads)
+		}
+	}
+}
@@ -0,0 +1,369 @@
+package engine
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"financial-bot/models"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+const (
+	frequencyWeekly  = "weekly"
+	frequencyMonthly = "monthly"
+	frequencyYearly  = "yearly"
+)
+
+var weekdays = map[string]time.Weekday{
+	"sunday": time.Sunday, "sun": time.Sunday,
+	"monday": time.Monday, "mon": time.Monday,
+	"tuesday": time.Tuesday, "tue": time.Tuesday,
+	"wednesday": time.Wednesday, "wed": time.Wednesday,
+	"thursday": time.Thursday, "thu": time.Thursday,
+	"friday": time.Friday, "fri": time.Friday,
+	"saturday": time.Saturday, "sat": time.Saturday,
+}
+
+// schedule describes when a recurring transaction is due. day is the day of
+// the month for monthly and yearly rules and the weekday for weekly ones;
+// month is only used by yearly rules.
+type schedule struct {
+	frequency string
+	day       int
+	month     time.Month
+}
+
+// parseSchedule parses "monthly 25", "weekly monday" or "yearly 03-15". The
+// word "on" after the frequency is optional.
+func parseSchedule(s string) (schedule, error) {
+	fields := strings.Fields(s)
+	if len(fields) == 3 && fields[1] == "on" {
+		fields = []string{fields[0], fields[2]}
+	}
+	if len(fields) != 2 {
+		return schedule{}, errors.New("use monthly <day>, weekly <weekday> or yearly <MM-DD>")
+	}
+
+	switch fields[0] {
+	case frequencyMonthly:
+		day, err := strconv.Atoi(fields[1])
+		if err != nil || day < 1 || day > 31 {
+			return schedule{}, errors.New("day of month must be between 1 and 31")
+		}
+		return schedule{frequency: frequencyMonthly, day: day}, nil
+	case frequencyWeekly:
+		weekday, ok := weekdays[fields[1]]
+		if !ok {
+			return schedule{}, errors.New("unknown weekday " + fields[1])
+		}
+		return schedule{frequency: frequencyWeekly, day: int(weekday)}, nil
+	case frequencyYearly:
+		date, err := time.Parse("01-02", fields[1])
+		if err != nil {
+			return schedule{}, errors.New("yearly date must look like MM-DD")
+		}
+		return schedule{frequency: frequencyYearly, day: date.Day(), month: date.Month()}, nil
+	}
+	return schedule{}, errors.New("frequency must be monthly, weekly or yearly")
+}
+
+func scheduleOf(rule *models.RecurringTransaction) schedule {
+	return schedule{frequency: rule.Frequency, day: int(rule.Day), month: time.Month(rule.Month)}
+}
+
+// next returns the first occurrence strictly after the day of t, at
+// midnight in t's location.
+func (s schedule) next(t time.Time) time.Time {
+	y, m, d := t.Date()
+	today := time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+
+	switch s.frequency {
+	case frequencyWeekly:
+		days := (s.day - int(today.Weekday()) + 7) % 7
+		if days == 0 {
+			days = 7
+		}
+		return today.AddDate(0, 0, days)
+	case frequencyYearly:
+		for year := y; ; year++ {
+			candidate := dateClamped(year, s.month, s.day, t.Location())
+			if candidate.After(today) {
+				return candidate
+			}
+		}
+	default:
+		for month := m; ; month++ {
+			candidate := dateClamped(y, month, s.day, t.Location())
+			if candidate.After(today) {
+				return candidate
+			}
+		}
+	}
+}
+
+// dateClamped returns the given date, moving days past the end of the
+// month back to its last day (e.g. the 31st of February becomes the 28th).
+func dateClamped(year int, month time.Month, day int, loc *time.Location) time.Time {
+	last := time.Date(year, month+1, 0, 0, 0, 0, 0, loc).Day()
+	if day > last {
+		day = last
+	}
+	return time.Date(year, month, day, 0, 0, 0, 0, loc)
+}
+
+func (s schedule) String() string {
+	switch s.frequency {
+	case frequencyWeekly:
+		return "every " + time.Weekday(s.day).String()
+	case frequencyYearly:
+		return fmt.Sprintf("every year on %s %d", s.month, s.day)
+	default:
+		return fmt.Sprintf("every month on day %d", s.day)
+	}
+}
+
+// addRecurring handles
+//
+//	recurring <income|expense> <schedule>
+//	rent = 3.000.000 @bca #housing
+//
+// creating one rule per line in ledger. chat is where materialised entries
+// are announced.
+func (e *Engine) addRecurring(ctx context.Context, chat string, ledger int64, header string, lines []string) Reply {
+	txType, scheduleText, _ := strings.Cut(strings.TrimSpace(header), " ")
+	if txType != "income" && txType != "expense" {
+		return Reply{Text: "⚠️ Use: recurring <income|expense> <monthly 25 | weekly monday | yearly 03-15>\n<description> = <amount>"}
+	}
+	sched, err := parseSchedule(scheduleText)
+	if err != nil {
+		return Reply{Text: "⚠️ Invalid schedule: " + err.Error()}
+	}
+
+	var results []string
+	for _, line := range lines {
+		ent, err := parseEntry(line)
+		if errors.Is(err, errNoAmount) {
+			continue
+		}
+		if err != nil {
+			results = append(results, fmt.Sprintf("⚠️ %s was not scheduled: %v", ent.desc, err))
+			continue
+		}
+
+		account, err := e.resolveAccount(ctx, e.db, ent.account)
+		if errors.Is(err, sql.ErrNoRows) {
+			results = append(results, fmt.Sprintf("⚠️ Unknown account @%s, %s was not scheduled", ent.account, ent.desc))
+			continue
+		}
+		if err != nil {
+			log.Println("Error fetching account:", err)
+			return Reply{Text: "❌ Error saving recurring transaction"}
+		}
+
+		rule := &models.RecurringTransaction{
+			Type:        txType,
+			Description: ent.desc,
+			Amount:      ent.amount,
+			AccountID:   account.ID.Int64,
+			Frequency:   sched.frequency,
+			Day:         int64(sched.day),
+			Month:       int64(sched.month),
+			NextRun:     sched.next(e.localNow()).UTC(),
+			Chat:        chat,
+			LedgerID:    ledger,
+			CreatedAt:   e.timestamp(),
+		}
+		if txType == "expense" {
+			rule.Amount = -rule.Amount
+		}
+		if ent.category != "" {
+			category, err := e.findCategory(ctx, ent.category)
+			if errors.Is(err, sql.ErrNoRows) {
+				results = append(results, fmt.Sprintf("⚠️ Unknown category #%s, %s was not scheduled", ent.category, ent.desc))
+				continue
+			}
+			if err != nil {
+				log.Println("Error fetching category:", err)
+				return Reply{Text: "❌ Error saving recurring transaction"}
+			}
+			rule.CategoryID = category.ID
+		}
+
+		if err := rule.Insert(ctx, e.db, boil.Infer()); err != nil {
+			log.Println("Error saving recurring transaction:", err)
+			return Reply{Text: "❌ Error saving recurring transaction"}
+		}
+		results = append(results, fmt.Sprintf("✅ #%d %s: %s %s\nNext: %s",
+			rule.ID.Int64, rule.Description, e.money(abs(rule.Amount)), sched, rule.NextRun.In(e.loc).Format("2006-01-02")))
+	}
+
+	if len(results) == 0 {
+		return Reply{Text: "⚠️ No recurring transactions found. Add lines like: rent = 3.000.000"}
+	}
+	return Reply{Text: "🔄 *Recurring Transactions* 🔄\n\n" + strings.Join(results, "\n\n")}
+}
+
+func (e *Engine) listRecurring(ctx context.Context, ledger int64) Reply {
+	rules, err := models.RecurringTransactions(
+		models.RecurringTransactionWhere.LedgerID.EQ(ledger),
+		qm.OrderBy("next_run ASC, id ASC"),
+	).All(ctx, e.db)
+	if err != nil {
+		log.Println("Error fetching recurring transactions:", err)
+		return Reply{Text: "❌ Error fetching recurring transactions"}
+	}
+	if len(rules) == 0 {
+		return Reply{Text: "🔄 No recurring transactions yet. Add one with:\nrecurring expense monthly 5\nrent = 3.000.000"}
+	}
+
+	var sb strings.Builder
+	sb.WriteString("🔄 *Recurring Transactions* 🔄\n")
+	for _, rule := range rules {
+		sb.WriteString(fmt.Sprintf("\n#%d %s %s: %s\n%s, next %s\n",
+			rule.ID.Int64, rule.Type, rule.Description, e.money(abs(rule.Amount)),
+			scheduleOf(rule), rule.NextRun.In(e.loc).Format("2006-01-02")))
+	}
+	return Reply{Text: strings.TrimSuffix(sb.String(), "\n")}
+}
+
+func (e *Engine) cancelRecurring(ctx context.Context, ledger int64, ref string) Reply {
+	id, err := strconv.ParseInt(strings.TrimPrefix(strings.TrimSpace(ref), "#"), 10, 64)
+	if err != nil {
+		return Reply{Text: "⚠️ Use: cancel recurring <id>"}
+	}
+
+	rule, err := models.RecurringTransactions(
+		models.RecurringTransactionWhere.ID.EQ(null.Int64From(id)),
+		models.RecurringTransactionWhere.LedgerID.EQ(ledger),
+	).One(ctx, e.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Reply{Text: fmt.Sprintf("⚠️ Recurring transaction #%d not found", id)}
+	}
+	if err != nil {
+		log.Println("Error fetching recurring transaction:", err)
+		return Reply{Text: "❌ Error cancelling recurring transaction"}
+	}
+	if _, err := rule.Delete(ctx, e.db); err != nil {
+		log.Println("Error deleting recurring transaction:", err)
+		return Reply{Text: "❌ Error cancelling recurring transaction"}
+	}
+	return Reply{Text: fmt.Sprintf("🗑️ Recurring transaction #%d (%s) cancelled", id, rule.Description)}
+}
+
+// recurringSender is the sender of the transactions recurring rules record,
+// under which they show in the member report.
+const recurringSender = "recurring"
+
+// WithoutRecurring stops the scheduler from recording recurring
+// transactions. Summaries and reminders are still sent.
+func WithoutRecurring() Option {
+	return func(e *Engine) {
+		e.noRecurring = true
+	}
+}
+
+// runRecurring records every occurrence that is due, including ones missed
+// while the bot was down, and returns one summary per chat and ledger.
+// Each rule's entries and its advanced next_run are committed together, so
+// an occurrence is never recorded twice.
+func (e *Engine) runRecurring(ctx context.Context) []Notice {
+	now := e.now()
+	rules, err := models.RecurringTransactions(qm.OrderBy("next_run ASC, id ASC")).All(ctx, e.db)
+	if err != nil {
+		log.Println("Error fetching recurring transactions:", err)
+		return nil
+	}
+
+	// Rules announce to the chat they were set up in, which may since have
+	// moved to another ledger
+	type target struct {
+		chat   string
+		ledger int64
+	}
+	var targets []target
+	recorded := map[target][]string{}
+	spent := map[target]map[int64]int64{}
+	for _, rule := range rules {
+		if rule.NextRun.After(now) {
+			continue
+		}
+
+		entries, err := e.materialise(ctx, rule, now)
+		if err != nil {
+			log.Printf("Error recording recurring transaction #%d: %v", rule.ID.Int64, err)
+			continue
+		}
+
+		to := target{rule.Chat, rule.LedgerID}
+		if _, ok := recorded[to]; !ok {
+			targets = append(targets, to)
+			spent[to] = map[int64]int64{}
+		}
+		for _, tx := range entries {
+			recorded[to] = append(recorded[to], fmt.Sprintf("%s (%s)", e.formatEntry(tx), tx.CreatedAt.In(e.loc).Format("2006-01-02")))
+			if tx.Type == "expense" && tx.CategoryID.Valid {
+				spent[to][tx.CategoryID.Int64] -= tx.Amount
+			}
+		}
+	}
+
+	var notices []Notice
+	for _, to := range targets {
+		balances, err := e.accountBalances(ctx, to.ledger)
+		if err != nil {
+			log.Println("Error fetching balances:", err)
+		}
+		text := fmt.Sprintf("🔄 *Recurring Transactions* 🔄\nRecorded automatically:\n%s\n\n%s",
+			strings.Join(recorded[to], "\n"), e.formatBalances("New Balance", balances))
+		if alerts := e.budgetAlerts(ctx, to.ledger, spent[to]); len(alerts) > 0 {
+			text += "\n\n" + strings.Join(alerts, "\n")
+		}
+		notices = append(notices, Notice{Chat: to.chat, Reply: Reply{Text: text}})
+	}
+	return notices
+}
+
+// materialise records every occurrence of rule up to now as one batch of
+// recurringSender and advances its next_run in a single database
+// transaction.
+func (e *Engine) materialise(ctx context.Context, rule *models.RecurringTransaction, now time.Time) ([]*models.Transaction, error) {
+	dbTx, err := e.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer dbTx.Rollback()
+
+	var entries []*models.Transaction
+	sched := scheduleOf(rule)
+	for !rule.NextRun.After(now) {
+		tx := &models.Transaction{
+			Type:        rule.Type,
+			Description: null.StringFrom(rule.Description),
+			Amount:      rule.Amount,
+			CreatedAt:   rule.NextRun.UTC(),
+			CategoryID:  rule.CategoryID,
+			AccountID:   rule.AccountID,
+			LedgerID:    rule.LedgerID,
+			Sender:      null.StringFrom(recurringSender),
+			SenderName:  null.StringFrom(recurringSender),
+		}
+		entries = append(entries, tx)
+		rule.NextRun = sched.next(rule.NextRun.In(e.loc)).UTC()
+	}
+	if err := e.addBatch(ctx, dbTx, recurringSender, rule.LedgerID, entries); err != nil {
+		return nil, err
+	}
+
+	if _, err := rule.Update(ctx, dbTx, boil.Whitelist(models.RecurringTransactionColumns.NextRun)); err != nil {
+		return nil, err
+	}
+	return entries, dbTx.Commit()
+}
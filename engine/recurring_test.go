@@ -0,0 +1,115 @@
+package engine
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestScheduleNext(t *testing.T) {
+	date := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		schedule string
+		from     time.Time
+		want     time.Time
+	}{
+		{"monthly 25", date(2025, time.June, 10), date(2025, time.June, 25)},
+		{"monthly 25", date(2025, time.June, 25), date(2025, time.July, 25)},
+		{"monthly on 31", date(2025, time.January, 31), date(2025, time.February, 28)},
+		{"monthly 31", date(2025, time.February, 28), date(2025, time.March, 31)},
+		{"monthly 5", date(2025, time.December, 20), date(2026, time.January, 5)},
+		{"weekly monday", date(2025, time.June, 28), date(2025, time.June, 30)},
+		{"weekly sat", date(2025, time.June, 28), date(2025, time.July, 5)},
+		{"yearly 03-15", date(2025, time.June, 28), date(2026, time.March, 15)},
+		{"yearly 02-29", date(2025, time.January, 1), date(2025, time.February, 28)},
+	}
+	for _, tt := range tests {
+		sched, err := parseSchedule(tt.schedule)
+		if err != nil {
+			t.Fatalf("parseSchedule(%q): %v", tt.schedule, err)
+		}
+		if got := sched.next(tt.from.Add(15 * time.Hour)); !got.Equal(tt.want) {
+			t.Errorf("%q after %s = %s, want %s", tt.schedule, tt.from.Format("2006-01-02"), got.Format("2006-01-02"), tt.want.Format("2006-01-02"))
+		}
+	}
+
+	for _, invalid := range []string{"monthly 32", "weekly someday", "yearly 13-01", "daily 1", "monthly"} {
+		if _, err := parseSchedule(invalid); err == nil {
+			t.Errorf("parseSchedule(%q) succeeded, want error", invalid)
+		}
+	}
+}
+
+func TestRecurringMaterialisesOnce(t *testing.T) {
+	now := time.Date(2025, time.June, 20, 9, 0, 0, 0, time.UTC)
+	e := newTestEngineWithClock(t, func() time.Time { return now })
+	ctx := context.Background()
+
+	reply := e.Handle(ctx, Command{Chat: "family@g.us", Text: "recurring expense monthly 25\nrent = 3.000.000"})[0].Text
+	if !strings.Contains(reply, "Next: 2025-06-25") {
+		t.Fatalf("unexpected reply: %q", reply)
+	}
+
+	if notices := e.Tick(ctx); len(notices) != 0 {
+		t.Fatalf("nothing should be due yet, got %+v", notices)
+	}
+
+	// The bot was down for two months: both missed occurrences are recorded.
+	now = time.Date(2025, time.August, 1, 9, 0, 0, 0, time.UTC)
+	notices := e.Tick(ctx)
+	if len(notices) != 1 || notices[0].Chat != "family@g.us" {
+		t.Fatalf("expected one notice for the family chat, got %+v", notices)
+	}
+	for _, want := range []string{"#1 rent: Rp -3.000.000 (2025-06-25)\n#2 rent: Rp -3.000.000 (2025-07-25)", "New Balance: Rp -6.000.000"} {
+		if !strings.Contains(notices[0].Reply.Text, want) {
+			t.Errorf("notice %q does not contain %q", notices[0].Reply.Text, want)
+		}
+	}
+
+	// Running again at the same time must not record anything twice.
+	if notices := e.Tick(ctx); len(notices) != 0 {
+		t.Fatalf("occurrences recorded twice: %+v", notices)
+	}
+	if reply := e.Handle(ctx, Command{Text: "balance"})[0].Text; !strings.Contains(reply, "Rp -6.000.000") {
+		t.Errorf("unexpected balance: %q", reply)
+	}
+
+	if reply := e.Handle(ctx, Command{Text: "who spent last month"})[0].Text; !strings.Contains(reply, "👤 *recurring*\nExpenses: Rp 3.000.000") {
+		t.Errorf("recurring transactions missing from the member report: %q", reply)
+	}
+	if reply := e.Handle(ctx, Command{Text: "delete #2"})[0].Text; !strings.Contains(reply, "🗑️ *Deleted*\n#2 rent: Rp -3.000.000") {
+		t.Errorf("unexpected delete reply: %q", reply)
+	}
+	if reply := e.Handle(ctx, Command{Text: "recurring"})[0].Text; !strings.Contains(reply, "next 2025-08-25") {
+		t.Errorf("unexpected list: %q", reply)
+	}
+	if reply := e.Handle(ctx, Command{Text: "cancel recurring #1"})[0].Text; !strings.Contains(reply, "cancelled") {
+		t.Errorf("unexpected cancel reply: %q", reply)
+	}
+	now = time.Date(2025, time.September, 1, 9, 0, 0, 0, time.UTC)
+	if notices := e.Tick(ctx); len(notices) != 0 {
+		t.Fatalf("cancelled rule still runs: %+v", notices)
+	}
+}
+
+func TestWithoutRecurring(t *testing.T) {
+	now := time.Date(2025, time.June, 20, 9, 0, 0, 0, time.UTC)
+	e := newTestEngineWithClock(t, func() time.Time { return now }, WithoutRecurring())
+	ctx := context.Background()
+	e.Handle(ctx, Command{Chat: "family@g.us", Text: "recurring expense monthly 25\nrent = 3.000.000"})
+	e.Handle(ctx, Command{Chat: "family@g.us", Text: "daily summary on at 21:00"})
+
+	// The rent is due, but only the summary is sent
+	now = time.Date(2025, time.June, 25, 20, 0, 0, 0, time.UTC)
+	e.Handle(ctx, Command{Chat: "family@g.us", Text: "expense\nbread = 20rb"})
+	now = time.Date(2025, time.June, 25, 21, 0, 0, 0, time.UTC)
+	notices := e.Tick(ctx)
+	if len(notices) != 1 || !strings.Contains(notices[0].Reply.Text, "Daily Summary") {
+		t.Fatalf("notices = %+v, want only the daily summary", notices)
+	}
+	if reply := e.Handle(ctx, Command{Text: "balance"})[0].Text; !strings.Contains(reply, "Rp -20.000") {
+		t.Errorf("recurring transaction recorded: %q", reply)
+	}
+}
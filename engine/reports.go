@@ -0,0 +1,117 @@
+package engine
+
+import (
+	"context"
+	"financial-bot/models"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+func (e *Engine) getMutations(ctx context.Context, ledger int64, p period) Reply {
+	transactions, err := models.Transactions(
+		models.TransactionWhere.LedgerID.EQ(ledger),
+		qm.Where("created_at >= ? AND created_at < ?", p.start, p.end),
+		qm.OrderBy("created_at ASC"),
+	).All(ctx, e.db)
+
+	if err != nil {
+		log.Println("Error fetching transactions:", err)
+		return Reply{Text: "❌ Error fetching transactions"}
+	}
+
+	return Reply{Text: e.buildMutationResponse(transactions, p.label)}
+}
+
+// withPeriod parses text as a period, defaulting to the current month when
+// it is empty, and runs report on ledger for it.
+func (e *Engine) withPeriod(ctx context.Context, ledger int64, text string, report func(context.Context, int64, period) Reply) Reply {
+	if strings.TrimSpace(text) == "" {
+		text = "this month"
+	}
+	p, err := parsePeriod(text, e.localNow())
+	if err != nil {
+		return invalidPeriodReply(err)
+	}
+	return report(ctx, ledger, p)
+}
+
+func formatCurrency(amount int64) string {
+	str := fmt.Sprintf("%d", amount)
+	var parts []string
+
+	// Handle negative numbers
+	negative := false
+	if amount < 0 {
+		negative = true
+		str = str[1:]
+	}
+
+	// Format with thousand separators
+	for len(str) > 3 {
+		parts = append([]string{str[len(str)-3:]}, parts...)
+		str = str[:len(str)-3]
+	}
+	if str != "" {
+		parts = append([]string{str}, parts...)
+	}
+
+	result := strings.Join(parts, ".")
+	if negative {
+		result = "-" + result
+	}
+	return result
+}
+
+// defaultCurrency is the prefix amounts are shown with unless the engine
+// is configured otherwise.
+const defaultCurrency = "Rp"
+
+// money renders amount with the currency prefix, such as "Rp -25.000".
+func (e *Engine) money(amount int64) string {
+	return e.currency + " " + formatCurrency(amount)
+}
+
+// signedMoney is money with a "+" in front of positive amounts, so income
+// stands out from expenses: "+Rp 25.000".
+func (e *Engine) signedMoney(amount int64) string {
+	return signOf(amount) + e.money(amount)
+}
+
+// signOf returns "+" for positive amounts; formatCurrency already prints
+// the minus sign of negative ones.
+func signOf(amount int64) string {
+	if amount > 0 {
+		return "+"
+	}
+	return ""
+}
+
+// buildMutationResponse lists transactions with their times shown in the
+// household's timezone.
+func (e *Engine) buildMutationResponse(transactions []*models.Transaction, period string) string {
+	if len(transactions) == 0 {
+		return fmt.Sprintf("📊 *Transaction Report*\nPeriod: %s\n\nNo transactions found", period)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("📊 *Transaction Report*\nPeriod: %s\n\n", period))
+
+	var total int64
+	for _, tx := range transactions {
+		total += tx.Amount
+		when := tx.CreatedAt.In(e.loc).Format("Mon, 02 Jan 2006 15:04")
+		if who := displayName(tx.Sender.String, tx.SenderName.String); who != "" {
+			when += " · 👤 " + who
+		}
+		sb.WriteString(fmt.Sprintf("⏰ %s\n%s: %s\n\n",
+			when,
+			tx.Description.String,
+			e.signedMoney(tx.Amount)))
+	}
+
+	sb.WriteString(fmt.Sprintf("💵 *Total Balance*: %s", e.money(total)))
+	return sb.String()
+}
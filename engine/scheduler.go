@@ -0,0 +1,45 @@
+package engine
+
+import (
+	"context"
+	"time"
+)
+
+// Notice is a message the engine sends on its own initiative, addressed to
+// a chat rather than in reply to a command.
+type Notice struct {
+	Chat  string
+	Reply Reply
+}
+
+// Tick runs every background job that is due according to the engine's
+// clock and returns the notices to deliver.
+func (e *Engine) Tick(ctx context.Context) []Notice {
+	var notices []Notice
+	if !e.noRecurring {
+		notices = e.runRecurring(ctx)
+	}
+	notices = append(notices, e.remindDebts(ctx)...)
+	notices = append(notices, e.runSummaries(ctx)...)
+	e.pruneProcessed(ctx)
+	return notices
+}
+
+// RunScheduler calls Tick immediately and then every interval until ctx is
+// cancelled, passing the resulting notices to deliver.
+func (e *Engine) RunScheduler(ctx context.Context, interval time.Duration, deliver func(Notice)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		for _, notice := range e.Tick(ctx) {
+			deliver(notice)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,317 @@
+package engine
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"financial-bot/models"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// Kinds of scheduled summary.
+const (
+	summaryDaily   = "daily"
+	summaryMonthly = "monthly"
+)
+
+const (
+	// defaultSummaryMinute is when summaries are sent unless a time is
+	// given: 21:00, after the day's spending is usually recorded.
+	defaultSummaryMinute = 21 * 60
+	// topCategories is how many expense categories a monthly summary
+	// lists.
+	topCategories = 3
+)
+
+// parseTimeOfDay reads "HH:MM" or "HH.MM" as minutes after midnight.
+func parseTimeOfDay(s string) (int, error) {
+	t, err := time.Parse("15:04", strings.ReplaceAll(s, ".", ":"))
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q, use HH:MM", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+func formatTimeOfDay(minute int64) string {
+	return fmt.Sprintf("%02d:%02d", minute/60, minute%60)
+}
+
+// nextSummary returns when a summary of kind sent at minute is due next,
+// strictly after t. Daily summaries go out every day, monthly ones on the
+// last day of the month.
+func nextSummary(kind string, minute int64, t time.Time) time.Time {
+	y, m, d := t.Date()
+	at := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, int(minute), 0, 0, t.Location())
+	}
+
+	if kind == summaryDaily {
+		next := at(y, m, d)
+		if !next.After(t) {
+			next = at(y, m, d+1)
+		}
+		return next
+	}
+	next := at(y, m+1, 0)
+	if !next.After(t) {
+		next = at(y, m+2, 0)
+	}
+	return next
+}
+
+// describeSummary says when a summary of kind is sent.
+func describeSummary(kind string, minute int64) string {
+	if kind == summaryDaily {
+		return "every day at " + formatTimeOfDay(minute)
+	}
+	return "on the last day of every month at " + formatTimeOfDay(minute)
+}
+
+// scheduleSummary handles "daily summary on|off" and "monthly summary
+// on|off" for chat, optionally with "at HH:MM". Giving only "at HH:MM"
+// reschedules the summary, switching it on if it was off.
+func (e *Engine) scheduleSummary(ctx context.Context, chat, kind, args string) Reply {
+	usage := Reply{Text: fmt.Sprintf("⚠️ Use: %s summary on|off [at HH:MM]", kind)}
+	fields := strings.Fields(args)
+	if len(fields) == 0 {
+		return usage
+	}
+	off := fields[0] == "off"
+	if off || fields[0] == "on" {
+		fields = fields[1:]
+	}
+	minute := -1
+	if len(fields) == 2 && fields[0] == "at" && !off {
+		m, err := parseTimeOfDay(fields[1])
+		if err != nil {
+			return Reply{Text: "⚠️ " + capitalize(err.Error())}
+		}
+		minute = m
+	} else if len(fields) != 0 {
+		return usage
+	}
+
+	summary, err := models.Summaries(models.SummaryWhere.Chat.EQ(chat), models.SummaryWhere.Kind.EQ(kind)).One(ctx, e.db)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		log.Println("Error fetching summary:", err)
+		return Reply{Text: "❌ Error scheduling summary"}
+	}
+	title := capitalize(kind) + " summary"
+
+	if off {
+		if summary != nil {
+			if _, err := summary.Delete(ctx, e.db); err != nil {
+				log.Println("Error deleting summary:", err)
+				return Reply{Text: "❌ Error scheduling summary"}
+			}
+		}
+		return Reply{Text: fmt.Sprintf("🔕 %s off", title)}
+	}
+
+	if summary == nil {
+		summary = &models.Summary{Chat: chat, Kind: kind, Minute: defaultSummaryMinute, CreatedAt: e.timestamp()}
+	}
+	if minute >= 0 {
+		summary.Minute = int64(minute)
+	}
+	summary.NextRun = nextSummary(kind, summary.Minute, e.localNow()).UTC()
+	if summary.ID.Valid {
+		_, err = summary.Update(ctx, e.db, boil.Infer())
+	} else {
+		err = summary.Insert(ctx, e.db, boil.Infer())
+	}
+	if err != nil {
+		log.Println("Error saving summary:", err)
+		return Reply{Text: "❌ Error scheduling summary"}
+	}
+	return Reply{Text: fmt.Sprintf("🔔 %s on, sent %s", title, describeSummary(kind, summary.Minute))}
+}
+
+// listSummaries shows which summaries chat receives.
+func (e *Engine) listSummaries(ctx context.Context, chat string) Reply {
+	summaries, err := models.Summaries(models.SummaryWhere.Chat.EQ(chat), qm.OrderBy("kind ASC")).All(ctx, e.db)
+	if err != nil {
+		log.Println("Error fetching summaries:", err)
+		return Reply{Text: "❌ Error fetching summaries"}
+	}
+	if len(summaries) == 0 {
+		return Reply{Text: "🔕 No scheduled summaries. Switch one on with: daily summary on or monthly summary on"}
+	}
+
+	var sb strings.Builder
+	sb.WriteString("🔔 *Scheduled Summaries*")
+	for _, summary := range summaries {
+		sb.WriteString(fmt.Sprintf("\n• %s: %s, next %s", summary.Kind, describeSummary(summary.Kind, summary.Minute),
+			summary.NextRun.In(e.loc).Format("2006-01-02 15:04")))
+	}
+	return Reply{Text: sb.String()}
+}
+
+// runSummaries sends every summary that is due. A summary missed while the
+// bot was down is sent once, for the latest period it was due for.
+func (e *Engine) runSummaries(ctx context.Context) []Notice {
+	now := e.now()
+	summaries, err := models.Summaries(
+		models.SummaryWhere.NextRun.LTE(now.UTC()),
+		qm.OrderBy("next_run ASC, id ASC"),
+	).All(ctx, e.db)
+	if err != nil {
+		log.Println("Error fetching summaries:", err)
+		return nil
+	}
+
+	var notices []Notice
+	for _, summary := range summaries {
+		// Runs missed while the bot was down collapse into the latest
+		due := summary.NextRun.In(e.loc)
+		for next := nextSummary(summary.Kind, summary.Minute, due); !next.After(now); next = nextSummary(summary.Kind, summary.Minute, next) {
+			due = next
+		}
+		summary.NextRun = nextSummary(summary.Kind, summary.Minute, due).UTC()
+		if _, err := summary.Update(ctx, e.db, boil.Whitelist(models.SummaryColumns.NextRun)); err != nil {
+			log.Println("Error saving summary:", err)
+			continue
+		}
+
+		ledger, err := e.ledgerOf(ctx, summary.Chat)
+		if err != nil {
+			log.Println("Error fetching ledger:", err)
+			continue
+		}
+		var reply Reply
+		var ok bool
+		if summary.Kind == summaryDaily {
+			reply, ok = e.dailySummary(ctx, ledger.ID.Int64, dayPeriod(time.Date(due.Year(), due.Month(), due.Day(), 0, 0, 0, 0, e.loc)))
+		} else {
+			reply, ok = e.monthlySummary(ctx, ledger.ID.Int64, due)
+		}
+		if ok {
+			notices = append(notices, Notice{Chat: summary.Chat, Reply: reply})
+		}
+	}
+	return notices
+}
+
+// periodTotals returns the income and expenses of ledger in p, expenses as
+// a positive amount.
+func (e *Engine) periodTotals(ctx context.Context, ledger int64, p period) (income, expense int64, err error) {
+	err = e.db.QueryRowContext(ctx, `
+		SELECT COALESCE(SUM(CASE WHEN type = 'income' THEN amount END), 0),
+			COALESCE(-SUM(CASE WHEN type = 'expense' THEN amount END), 0)
+		FROM transactions
+		WHERE ledger_id = ? AND created_at >= ? AND created_at < ?`,
+		ledger, p.start, p.end).Scan(&income, &expense)
+	return income, expense, err
+}
+
+// dailySummary is the end-of-day digest of ledger for day: the totals
+// followed by the day's transactions. It is not sent for a day without
+// transactions.
+func (e *Engine) dailySummary(ctx context.Context, ledger int64, day period) (Reply, bool) {
+	transactions, err := models.Transactions(
+		models.TransactionWhere.LedgerID.EQ(ledger),
+		qm.Where("created_at >= ? AND created_at < ?", day.start, day.end),
+		qm.OrderBy("created_at ASC"),
+	).All(ctx, e.db)
+	if err != nil {
+		log.Println("Error fetching transactions:", err)
+		return Reply{}, false
+	}
+	if len(transactions) == 0 {
+		return Reply{}, false
+	}
+	income, expense, err := e.periodTotals(ctx, ledger, day)
+	if err != nil {
+		log.Println("Error fetching totals:", err)
+		return Reply{}, false
+	}
+
+	text := fmt.Sprintf("🌙 *Daily Summary* 🌙\nIncome: %s\nExpense: %s\nNet: %s\n\n%s",
+		e.signedMoney(income), e.money(-expense), e.signedMoney(income-expense),
+		e.buildMutationResponse(transactions, day.label))
+	return Reply{Text: text}, true
+}
+
+// monthlySummary is the month-end summary of ledger for the month of day:
+// income, expenses and net compared with the month before, and the
+// categories most was spent on.
+func (e *Engine) monthlySummary(ctx context.Context, ledger int64, day time.Time) (Reply, bool) {
+	month := monthPeriod(day.Year(), day.Month(), e.loc)
+	previous := monthPeriod(day.Year(), day.Month()-1, e.loc)
+	income, expense, err := e.periodTotals(ctx, ledger, month)
+	if err != nil {
+		log.Println("Error fetching totals:", err)
+		return Reply{}, false
+	}
+	prevIncome, prevExpense, err := e.periodTotals(ctx, ledger, previous)
+	if err != nil {
+		log.Println("Error fetching totals:", err)
+		return Reply{}, false
+	}
+	top, err := e.topExpenseCategories(ctx, ledger, month, topCategories)
+	if err != nil {
+		log.Println("Error fetching category report:", err)
+		return Reply{}, false
+	}
+
+	prevName := previous.start.In(e.loc).Format("January")
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("📅 *Monthly Summary* 📅\nPeriod: %s\n", month.label))
+	sb.WriteString(fmt.Sprintf("\nIncome: %s%s", e.signedMoney(income), compareWith(income, prevIncome, prevName)))
+	sb.WriteString(fmt.Sprintf("\nExpense: %s%s", e.money(-expense), compareWith(expense, prevExpense, prevName)))
+	sb.WriteString(fmt.Sprintf("\nNet: %s (%s in %s)", e.signedMoney(income-expense), e.signedMoney(prevIncome-prevExpense), prevName))
+	if len(top) > 0 {
+		sb.WriteString("\n\n*Top Expenses*")
+		for i, ct := range top {
+			sb.WriteString(fmt.Sprintf("\n%d. %s: %s (%s)", i+1, ct.name, e.money(ct.total), formatPercent(ct.total, expense)))
+		}
+	}
+	return Reply{Text: sb.String()}, true
+}
+
+// compareWith describes the change from previous to current, such as
+// " (▲ 12.5% vs August)". It is empty when there is nothing to compare.
+func compareWith(current, previous int64, name string) string {
+	if previous == 0 {
+		return ""
+	}
+	arrow := "▲"
+	if current < previous {
+		arrow = "▼"
+	}
+	return fmt.Sprintf(" (%s %s vs %s)", arrow, formatPercent(abs(current-previous), previous), name)
+}
+
+// topExpenseCategories returns the n categories ledger spent most on in
+// p, largest first, with expenses as positive amounts.
+func (e *Engine) topExpenseCategories(ctx context.Context, ledger int64, p period, n int) ([]categoryTotal, error) {
+	rows, err := e.db.QueryContext(ctx, `
+		SELECT COALESCE(c.name, ?), -SUM(t.amount) AS total
+		FROM transactions t
+		LEFT JOIN categories c ON c.id = t.category_id
+		WHERE t.ledger_id = ? AND t.type = 'expense' AND t.created_at >= ? AND t.created_at < ?
+		GROUP BY 1
+		ORDER BY total DESC, 1
+		LIMIT ?`,
+		uncategorized, ledger, p.start, p.end, n)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var totals []categoryTotal
+	for rows.Next() {
+		var ct categoryTotal
+		if err := rows.Scan(&ct.name, &ct.total); err != nil {
+			return nil, err
+		}
+		totals = append(totals, ct)
+	}
+	return totals, rows.Err()
+}
@@ -0,0 +1,97 @@
+package engine
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSummaries(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, time.September, 15, 10, 0, 0, 0, time.UTC)
+	e := newTestEngineWithClock(t, func() time.Time { return now })
+	send := func(text string) string {
+		t.Helper()
+		return e.Handle(ctx, Command{Sender: "me", Chat: "family@g.us", Text: text})[0].Text
+	}
+	send("add category food")
+	send("add category rent")
+	send("income\nsalary = 1jt")
+	send("expense\nrice = 300rb #food\nfuel = 100rb")
+
+	now = time.Date(2026, time.October, 31, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"summaries", "🔕 No scheduled summaries"},
+		{"daily summary", "⚠️ Use: daily summary on|off [at HH:MM]"},
+		{"daily summary on at 25:00", `⚠️ Invalid time "25:00", use HH:MM`},
+		{"daily summary on", "🔔 Daily summary on, sent every day at 21:00"},
+		{"monthly summary on at 22:00", "🔔 Monthly summary on, sent on the last day of every month at 22:00"},
+		{"summaries", "• daily: every day at 21:00, next 2026-10-31 21:00\n• monthly: on the last day of every month at 22:00, next 2026-10-31 22:00"},
+	}
+	for _, tt := range tests {
+		if got := send(tt.text); !strings.Contains(got, tt.want) {
+			t.Errorf("%q: reply %q, want %q", tt.text, got, tt.want)
+		}
+	}
+
+	send("income\nsalary = 2jt")
+	send("expense\nrent = 1jt #rent\nlunch = 200rb #food\nparking = 100rb")
+	if notices := e.Tick(ctx); len(notices) != 0 {
+		t.Fatalf("summaries sent early: %+v", notices)
+	}
+
+	now = time.Date(2026, time.October, 31, 21, 0, 0, 0, time.UTC)
+	notices := e.Tick(ctx)
+	if len(notices) != 1 || notices[0].Chat != "family@g.us" {
+		t.Fatalf("expected the daily summary, got %+v", notices)
+	}
+	for _, want := range []string{
+		"🌙 *Daily Summary* 🌙\nIncome: +Rp 2.000.000\nExpense: Rp -1.300.000\nNet: +Rp 700.000\n\n📊 *Transaction Report*\nPeriod: 2026-10-31",
+		"lunch: Rp -200.000",
+	} {
+		if !strings.Contains(notices[0].Reply.Text, want) {
+			t.Errorf("daily summary %q does not contain %q", notices[0].Reply.Text, want)
+		}
+	}
+	if notices := e.Tick(ctx); len(notices) != 0 {
+		t.Fatalf("daily summary sent twice: %+v", notices)
+	}
+
+	now = time.Date(2026, time.October, 31, 22, 0, 0, 0, time.UTC)
+	notices = e.Tick(ctx)
+	if len(notices) != 1 {
+		t.Fatalf("expected the monthly summary, got %+v", notices)
+	}
+	want := "📅 *Monthly Summary* 📅\nPeriod: Month of October 2026\n\n" +
+		"Income: +Rp 2.000.000 (▲ 100.0% vs September)\n" +
+		"Expense: Rp -1.300.000 (▲ 225.0% vs September)\n" +
+		"Net: +Rp 700.000 (+Rp 600.000 in September)\n\n" +
+		"*Top Expenses*\n1. rent: Rp 1.000.000 (76.9%)\n2. food: Rp 200.000 (15.4%)\n3. uncategorized: Rp 100.000 (7.7%)"
+	if notices[0].Reply.Text != want {
+		t.Errorf("monthly summary =\n%s\nwant\n%s", notices[0].Reply.Text, want)
+	}
+
+	// Days missed while the bot was down are not sent one by one, and a
+	// day without transactions sends nothing
+	now = time.Date(2026, time.November, 3, 23, 0, 0, 0, time.UTC)
+	if notices := e.Tick(ctx); len(notices) != 0 {
+		t.Fatalf("expected no summary for an empty day, got %+v", notices)
+	}
+	if got := send("summaries"); !strings.Contains(got, "next 2026-11-04 21:00") || !strings.Contains(got, "next 2026-11-30 22:00") {
+		t.Errorf("summaries after catching up: %q", got)
+	}
+
+	if got := send("daily summary at 7.30"); got != "🔔 Daily summary on, sent every day at 07:30" {
+		t.Errorf("rescheduling: %q", got)
+	}
+	if got := send("daily summary off"); got != "🔕 Daily summary off" {
+		t.Errorf("switching off: %q", got)
+	}
+	if got := send("summaries"); strings.Contains(got, "daily") {
+		t.Errorf("daily summary still scheduled: %q", got)
+	}
+}
@@ -0,0 +1,104 @@
+package engine
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+// jakarta stands in for Asia/Jakarta without depending on the system's
+// timezone database.
+var jakarta = time.FixedZone("WIB", 7*60*60)
+
+func TestParsePeriodInLocation(t *testing.T) {
+	// 23:30 UTC on 31 October is already 1 November in Jakarta.
+	now := time.Date(2026, time.October, 31, 23, 30, 0, 0, time.UTC).In(jakarta)
+	date := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, jakarta) }
+
+	tests := []struct {
+		input      string
+		start, end time.Time
+		label      string
+	}{
+		{"today", date(2026, 11, 1), date(2026, 11, 2), "2026-11-01"},
+		{"yesterday", date(2026, 10, 31), date(2026, 11, 1), "2026-10-31"},
+		{"this month", date(2026, 11, 1), date(2026, 12, 1), "Month of November 2026"},
+		{"2026-10", date(2026, 10, 1), date(2026, 11, 1), "Month of October 2026"},
+		{"from 2026-10-01 to 2026-10-31", date(2026, 10, 1), date(2026, 11, 1), "2026-10-01 to 2026-10-31"},
+	}
+	for _, tt := range tests {
+		p, err := parsePeriod(tt.input, now)
+		if err != nil {
+			t.Errorf("parsePeriod(%q): %v", tt.input, err)
+			continue
+		}
+		if !p.start.Equal(tt.start) || !p.end.Equal(tt.end) || p.label != tt.label {
+			t.Errorf("parsePeriod(%q) = [%s, %s) %q, want [%s, %s) %q",
+				tt.input, p.start, p.end, p.label, tt.start, tt.end, tt.label)
+		}
+		if p.start.Location() != time.UTC || p.end.Location() != time.UTC {
+			t.Errorf("parsePeriod(%q) bounds are not in UTC: [%s, %s)", tt.input, p.start, p.end)
+		}
+	}
+}
+
+func TestHouseholdTimezone(t *testing.T) {
+	ctx := context.Background()
+	// 23:30 on 30 June in Jakarta, still the afternoon of 30 June in UTC.
+	now := time.Date(2025, time.June, 30, 23, 30, 0, 0, jakarta)
+	e := newTestEngineWithClock(t, func() time.Time { return now }, WithLocation(jakarta))
+
+	send := func(text string) string {
+		t.Helper()
+		return e.Handle(ctx, Command{Sender: "me", Chat: "family@g.us", Text: text})[0].Text
+	}
+	check := func(reply string, want, notWant []string) {
+		t.Helper()
+		for _, w := range want {
+			if !strings.Contains(reply, w) {
+				t.Errorf("reply %q does not contain %q", reply, w)
+			}
+		}
+		for _, w := range notWant {
+			if strings.Contains(reply, w) {
+				t.Errorf("reply %q unexpectedly contains %q", reply, w)
+			}
+		}
+	}
+
+	send("add category food")
+	send("budget food = 100.000")
+	send("recurring income monthly 1\nsalary = 1.000")
+	check(send("expense\nlate snack #food = 90.000"), []string{"Date: 2025-06-30"}, nil)
+
+	// Half an hour later it is a new day and a new month in Jakarta, while
+	// UTC is still on 30 June.
+	now = time.Date(2025, time.July, 1, 0, 5, 0, 0, jakarta)
+	notices := e.Tick(ctx)
+	if len(notices) != 1 {
+		t.Fatalf("expected the salary to be recorded at local midnight, got %+v", notices)
+	}
+	check(notices[0].Reply.Text, []string{"#2 salary: +Rp 1.000 (2025-07-01)"}, nil)
+
+	// The new month's budget starts from zero, so no alert.
+	check(send("expense\nbreakfast #food = 20.000"), []string{"Date: 2025-07-01"}, []string{"Budget for food"})
+
+	check(send("today's mutation"),
+		[]string{"Period: 2025-07-01", "⏰ Tue, 01 Jul 2025 00:05", "breakfast", "salary"},
+		[]string{"late snack"})
+	check(send("yesterday's mutation"),
+		[]string{"Period: 2025-06-30", "⏰ Mon, 30 Jun 2025 23:30", "late snack"},
+		[]string{"breakfast"})
+	check(send("last month's mutation"), []string{"late snack"}, []string{"breakfast"})
+	check(send("budget status"), []string{"Month of July 2025", "Used: Rp 20.000"}, nil)
+
+	// Timestamps are stored in UTC whatever the household's timezone.
+	var stored string
+	if err := e.db.QueryRowContext(ctx, "SELECT CAST(created_at AS TEXT) FROM transactions WHERE description = 'breakfast'").Scan(&stored); err != nil {
+		t.Fatalf("read created_at: %v", err)
+	}
+	if stored != "2025-06-30 17:05:00+00:00" {
+		t.Errorf("created_at stored as %q, want 2025-06-30 17:05:00+00:00", stored)
+	}
+}
@@ -0,0 +1,245 @@
+package engine
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"financial-bot/models"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+)
+
+// errNoAmount is returned by parseEntry for a line without "= amount".
+var errNoAmount = errors.New(`missing "= amount"`)
+
+// errNotPositive is returned by parseEntry for a zero amount.
+var errNotPositive = errors.New("amount must be positive")
+
+// entry is a single "description = amount" line with its optional
+// category and account tags.
+type entry struct {
+	desc     string
+	amount   int64
+	category string
+	account  string
+}
+
+// parseEntry parses an income or expense line such as
+// "bread = 25rb #groceries @cash". Amounts that are not positive, including
+// fractions that round to zero, are rejected.
+func parseEntry(line string) (entry, error) {
+	var ent entry
+	line, ent.category = extractCategory(line)
+	line, ent.account = extractAccount(line)
+	parts := strings.SplitN(line, "=", 2)
+	if len(parts) < 2 {
+		return ent, errNoAmount
+	}
+
+	ent.desc = strings.TrimSpace(parts[0])
+	amount, err := parseAmount(parts[1])
+	if err != nil {
+		return ent, err
+	}
+	if amount <= 0 {
+		return ent, errNotPositive
+	}
+	ent.amount = amount
+	return ent, nil
+}
+
+// rejection is an income or expense line that was not recorded, with the
+// reason shown to the sender.
+type rejection struct {
+	line, reason string
+}
+
+// processTransaction records every valid line of an income or expense
+// message in ledger in a single database transaction and reports which
+// lines were recorded and which were rejected. A database error records
+// nothing, not even the default account created for the lines.
+func (e *Engine) processTransaction(ctx context.Context, cmd Command, ledger int64, txType string, lines []string) Reply {
+	var warnings []string
+	var rejected []rejection
+	var pending []*models.Transaction
+
+	dbTx, err := e.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Println("Error starting transactions:", err)
+		return Reply{Text: "❌ Error recording transactions, nothing was recorded"}
+	}
+	defer dbTx.Rollback()
+
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		ent, err := parseEntry(line)
+		if err != nil {
+			rejected = append(rejected, rejection{line, err.Error()})
+			continue
+		}
+		if ent.desc == "" {
+			rejected = append(rejected, rejection{line, "missing description"})
+			continue
+		}
+
+		amount := ent.amount
+		if txType == "expense" {
+			amount = -amount
+		}
+
+		account, err := e.resolveAccount(ctx, dbTx, ent.account)
+		if errors.Is(err, sql.ErrNoRows) {
+			rejected = append(rejected, rejection{line, "unknown account @" + ent.account})
+			continue
+		}
+		if err != nil {
+			log.Println("Error fetching account:", err)
+			return Reply{Text: "❌ Error recording transactions, nothing was recorded"}
+		}
+
+		tx := &models.Transaction{
+			Type:        txType,
+			Description: null.StringFrom(ent.desc),
+			Amount:      amount,
+			CreatedAt:   e.timestamp(),
+			AccountID:   account.ID.Int64,
+			LedgerID:    ledger,
+			Sender:      null.StringFrom(cmd.Sender),
+			SenderName:  null.StringFrom(cmd.SenderName),
+		}
+		if ent.category != "" {
+			category, err := e.findCategory(ctx, ent.category)
+			switch {
+			case err == nil:
+				tx.CategoryID = category.ID
+			case errors.Is(err, sql.ErrNoRows):
+				warnings = append(warnings, fmt.Sprintf("⚠️ Unknown category #%s, %s was recorded without a category", ent.category, ent.desc))
+			default:
+				log.Println("Error fetching category:", err)
+				return Reply{Text: "❌ Error recording transactions, nothing was recorded"}
+			}
+		}
+		pending = append(pending, tx)
+	}
+
+	if len(pending) > 0 {
+		if err := e.addBatch(ctx, dbTx, cmd.Sender, ledger, pending); err != nil {
+			log.Println("Error saving transactions:", err)
+			return Reply{Text: "❌ Error recording transactions, nothing was recorded"}
+		}
+		if err := dbTx.Commit(); err != nil {
+			log.Println("Error saving transactions:", err)
+			return Reply{Text: "❌ Error recording transactions, nothing was recorded"}
+		}
+	}
+	receiptNote := e.attachReceipt(ctx, cmd, pending)
+
+	var recorded []string
+	spent := map[int64]int64{}
+	for _, tx := range pending {
+		recorded = append(recorded, e.formatEntry(tx))
+		if tx.Type == "expense" && tx.CategoryID.Valid {
+			spent[tx.CategoryID.Int64] -= tx.Amount
+		}
+	}
+	warnings = append(warnings, e.budgetAlerts(ctx, ledger, spent)...)
+
+	// Calculate and report current balance
+	balances, err := e.accountBalances(ctx, ledger)
+	if err != nil {
+		log.Println("Error fetching balances:", err)
+	}
+	title := "💰 *Financial Update* 💰"
+	if len(recorded) == 0 {
+		title = "⚠️ *Nothing Recorded* ⚠️"
+	}
+	response := fmt.Sprintf("%s\nDate: %s\n", title, e.localNow().Format("2006-01-02"))
+	if len(recorded) > 0 {
+		response += "✅ Recorded:\n" + strings.Join(recorded, "\n") + "\n\n"
+	}
+	if receiptNote != "" {
+		response += receiptNote + "\n\n"
+	}
+	if len(rejected) > 0 {
+		response += "❌ Rejected:\n"
+		for _, r := range rejected {
+			response += fmt.Sprintf("• %s → %s\n", r.line, r.reason)
+		}
+		response += "\n"
+	}
+	if len(lines) == 0 {
+		response += fmt.Sprintf("Use:\n%s\n<description> = <amount>\n\n", txType)
+	}
+	response += e.formatBalances("New Balance", balances)
+	if len(warnings) > 0 {
+		response += "\n\n" + strings.Join(warnings, "\n")
+	}
+	reply := Reply{Text: response}
+	if len(pending) > 0 {
+		reply.Batch = pending[0].BatchID.Int64
+	}
+	return reply
+}
+
+// insertBatch records transactions under a new batch for sender in
+// ledger, all or nothing. It does nothing when there are no transactions.
+func (e *Engine) insertBatch(ctx context.Context, sender string, ledger int64, transactions []*models.Transaction) error {
+	if len(transactions) == 0 {
+		return nil
+	}
+	dbTx, err := e.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer dbTx.Rollback()
+
+	if err := e.addBatch(ctx, dbTx, sender, ledger, transactions); err != nil {
+		return err
+	}
+	return dbTx.Commit()
+}
+
+// addBatch records transactions under a new batch for sender in ledger as
+// part of a database transaction the caller commits.
+func (e *Engine) addBatch(ctx context.Context, exec boil.ContextExecutor, sender string, ledger int64, transactions []*models.Transaction) error {
+	batch, err := e.newBatch(ctx, exec, sender, ledger)
+	if err != nil {
+		return err
+	}
+	for _, tx := range transactions {
+		tx.BatchID = batch.ID
+		if err := tx.Insert(ctx, exec, boil.Infer()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newBatch starts a batch for the transactions recorded by one message.
+// When the message is an edit, the transactions it replaces are deleted in
+// the same database transaction.
+func (e *Engine) newBatch(ctx context.Context, exec boil.ContextExecutor, sender string, ledger int64) (*models.Batch, error) {
+	if replaced, ok := ctx.Value(replacedKey{}).(models.TransactionSlice); ok {
+		if _, err := replaced.DeleteAll(ctx, exec); err != nil {
+			return nil, err
+		}
+	}
+	batch := &models.Batch{Sender: sender, LedgerID: null.Int64From(ledger), CreatedAt: e.timestamp()}
+	if err := batch.Insert(ctx, exec, boil.Infer()); err != nil {
+		return nil, err
+	}
+	return batch, nil
+}
+
+// formatEntry renders a recorded transaction with its reference ID, which
+// the delete and edit commands accept.
+func (e *Engine) formatEntry(tx *models.Transaction) string {
+	return fmt.Sprintf("#%d %s: %s", tx.ID.Int64, tx.Description.String, e.signedMoney(tx.Amount))
+}
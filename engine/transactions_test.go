@@ -0,0 +1,95 @@
+package engine
+
+import (
+	"context"
+	"financial-bot/models"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestProcessTransactionReportsRejectedLines(t *testing.T) {
+	now := time.Date(2025, time.June, 28, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		message string
+		want    []string
+		notWant []string
+	}{
+		{
+			name:    "every line recorded",
+			message: "expense\nbread = 25rb\nmilk = 10rb",
+			want:    []string{"💰 *Financial Update* 💰", "✅ Recorded:\n#1 bread: Rp -25.000\n#2 milk: Rp -10.000"},
+			notWant: []string{"Rejected"},
+		},
+		{
+			name:    "each rejected line has its reason",
+			message: "expense\nbread = 25rb\n\ncoffee\n= 5rb\nsnack = lots\ntea = 5rb @ovo\nwater = 0\nsugar = 2,5",
+			want: []string{
+				"✅ Recorded:\n#1 bread: Rp -25.000",
+				"❌ Rejected:\n" +
+					"• coffee → missing \"= amount\"\n" +
+					"• = 5rb → missing description\n" +
+					"• snack = lots → invalid amount \"lots\"\n" +
+					"• tea = 5rb @ovo → unknown account @ovo\n" +
+					"• water = 0 → amount must be positive\n" +
+					"• sugar = 2,5 → amount \"2,5\" is not a whole number of rupiah",
+				"New Balance: Rp -25.000",
+			},
+		},
+		{
+			name:    "nothing recorded",
+			message: "income\nsalary",
+			want:    []string{"⚠️ *Nothing Recorded* ⚠️", "• salary → missing \"= amount\"", "New Balance: Rp 0"},
+			notWant: []string{"Financial Update", "Recorded:"},
+		},
+		{
+			name:    "no lines at all",
+			message: "expense",
+			want:    []string{"⚠️ *Nothing Recorded* ⚠️", "Use:\nexpense\n<description> = <amount>"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newTestEngine(t, now)
+			reply := e.Handle(context.Background(), Command{Sender: "me", Text: tt.message})[0].Text
+			for _, want := range tt.want {
+				if !strings.Contains(reply, want) {
+					t.Errorf("reply %q does not contain %q", reply, want)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(reply, notWant) {
+					t.Errorf("reply %q unexpectedly contains %q", reply, notWant)
+				}
+			}
+		})
+	}
+}
+
+func TestProcessTransactionIsAllOrNothing(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEngine(t, time.Date(2025, time.June, 28, 10, 0, 0, 0, time.UTC), WithDefaultAccount("cash"))
+
+	// Make the second insert of the message fail
+	if _, err := e.db.Exec(`CREATE TRIGGER fail_boom BEFORE INSERT ON transactions
+		WHEN NEW.description = 'boom' BEGIN SELECT RAISE(ABORT, 'boom'); END`); err != nil {
+		t.Fatalf("create trigger: %v", err)
+	}
+
+	reply := e.Handle(ctx, Command{Sender: "me", Text: "expense\nbread = 25rb\nboom = 5rb"})[0].Text
+	if !strings.Contains(reply, "❌ Error recording transactions, nothing was recorded") {
+		t.Errorf("unexpected reply: %q", reply)
+	}
+	if reply := e.Handle(ctx, Command{Text: "balance"})[0].Text; !strings.Contains(reply, "Total Balance: Rp 0") {
+		t.Errorf("half the message was recorded: %q", reply)
+	}
+	if reply := e.Handle(ctx, Command{Sender: "me", Text: "undo"})[0].Text; !strings.Contains(reply, "Nothing to undo") {
+		t.Errorf("an empty batch was left behind: %q", reply)
+	}
+	if exists, err := models.Accounts(models.AccountWhere.Name.EQ("cash")).Exists(ctx, e.db); err != nil || exists {
+		t.Errorf("the default account was created for nothing: %v, %v", exists, err)
+	}
+}
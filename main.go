@@ -3,21 +3,19 @@ package main
 import (
 	"context"
 	"database/sql"
-	"financial-bot/models"
+	"financial-bot/api"
+	"financial-bot/config"
+	"financial-bot/database"
+	"financial-bot/engine"
 	"fmt"
-	"github.com/aarondl/null/v8"
 	"log"
 	"os"
 	"os/signal"
-	"path/filepath"
-	"regexp"
-	"strconv"
 	"strings"
 	"syscall"
 	"time"
+	_ "time/tzdata"
 
-	"github.com/aarondl/sqlboiler/v4/boil"
-	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	_ "github.com/mattn/go-sqlite3"
 
 	"go.mau.fi/whatsmeow"
@@ -28,18 +26,26 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
+// maxDocumentSize is the largest photo or document downloaded for the
+// bot, which is plenty for a receipt or a bank statement.
+const maxDocumentSize = 10 << 20
+
 var (
-	db          *sql.DB
 	client      *whatsmeow.Client
+	bot         *engine.Engine
+	cfg         *config.Config
 	currentTime = time.Now
 )
 
-const (
-	financeDBPath  = "data/app.db"
-	whatsappDBPath = "data/whatsapp.db"
-)
-
 func main() {
+	if len(os.Args) > 1 {
+		if run, ok := subcommands[os.Args[1]]; ok {
+			if err := run(os.Args[2:]); err != nil {
+				log.Fatalf("%s failed: %v", os.Args[1], err)
+			}
+			return
+		}
+	}
 
 	var err error
 
@@ -50,29 +56,56 @@ func main() {
 	}
 	fmt.Println("Current working directory:", dir)
 
+	// Load config.yaml (or CONFIG_FILE) with environment overrides
+	cfg, err = config.Load(os.Getenv("CONFIG_FILE"))
+	if err != nil {
+		log.Fatalf("Config error: %v", err)
+	}
+
 	// Initialize databases
-	db, err := initDatabaseNew(financeDBPath, "finance")
+	db, err := database.Open(cfg.Database.Path)
 	if err != nil {
 		log.Fatalf("Finance DB init failed: %v", err)
 	}
 	defer db.Close()
 
-	// Check if database file exists
-	if _, err := os.Stat(financeDBPath); os.IsNotExist(err) {
-		// Create new database
-		file, err := os.Create(financeDBPath)
-		if err != nil {
-			log.Fatal("Failed to create database:", err)
-		}
-		file.Close()
+	opts := []engine.Option{engine.WithAccessControl()}
+	if !cfg.Features.Recurring {
+		opts = append(opts, engine.WithoutRecurring())
+	}
+	bot = newEngine(cfg, db, opts...)
 
-		// Initialize schema
-		initDatabase(db)
+	// The config file's members seed an empty members table; after that
+	// admins manage members through the chat
+	members := make([]engine.Member, 0, len(cfg.Members))
+	for _, m := range cfg.Members {
+		members = append(members, engine.Member{Phone: m.Phone, Name: m.Name, Role: m.Role})
+	}
+	if err := bot.SeedMembers(context.Background(), members); err != nil {
+		log.Fatalf("Failed to seed members: %v", err)
 	}
 
 	// Initialize WhatsApp client
 	initWhatsAppClient()
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Run recurring transactions, summaries and other background jobs
+	go bot.RunScheduler(ctx, time.Minute, deliverNotice)
+
+	// Serve the ledger over HTTP for dashboards and scripts
+	if cfg.Features.HTTPAPI {
+		server := api.New(db, currentTime, cfg.HTTP.Token,
+			api.WithDefaultAccount(cfg.DefaultAccount),
+			api.WithLocation(cfg.Location))
+		go func() {
+			if err := server.ListenAndServe(ctx, fmt.Sprintf(":%d", cfg.HTTP.Port)); err != nil {
+				log.Println("HTTP API stopped:", err)
+			}
+		}()
+	}
+
 	// Listen to Ctrl-C
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
@@ -81,67 +114,43 @@ func main() {
 	client.Disconnect()
 }
 
-func initDatabase(db *sql.DB) {
-	// Create transactions table
-	_, err := db.Exec(`
-	CREATE TABLE IF NOT EXISTS transactions (
-		id INTEGER PRIMARY KEY AUTOINCREMENT,
-		type TEXT NOT NULL CHECK(type IN ('income', 'expense')),
-		description TEXT NOT NULL,
-		amount INTEGER NOT NULL,
-		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
-	)`)
-	if err != nil {
-		log.Fatal("Failed to create transactions table:", err)
-	}
-}
-
-func initDatabaseNew(path string, dbType string) (*sql.DB, error) {
-	// Create directory if needed
-	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
-		return nil, fmt.Errorf("failed to create data directory: %w", err)
-	}
-
-	// Open database connection
-	db, err := sql.Open("sqlite3", path)
-	if err != nil {
-		return nil, fmt.Errorf("failed to open database: %w", err)
-	}
-
-	// Enable foreign keys
-	db.Exec("PRAGMA foreign_keys = ON;")
-
-	// Run migrations if it's the finance database
-	if dbType == "finance" {
-		if err := migrateDatabase(db, "app"); err != nil {
-			db.Close()
-			return nil, fmt.Errorf("database migration failed: %w", err)
-		}
-	}
-
-	if dbType == "whatsapp" {
-		if err := migrateDatabase(db, "whatsapp"); err != nil {
-			db.Close()
-			return nil, fmt.Errorf("database migration failed: %w", err)
+// newEngine returns the bookkeeping engine configured by cfg, with opts
+// applied last.
+func newEngine(cfg *config.Config, db *sql.DB, opts ...engine.Option) *engine.Engine {
+	mappings := make(map[string]engine.ImportMapping, len(cfg.Import.Mappings))
+	for name, m := range cfg.Import.Mappings {
+		mappings[name] = engine.ImportMapping{
+			Date: m.Date, DateLayouts: m.DateLayouts, Description: m.Description,
+			Amount: m.Amount, Debit: m.Debit, Credit: m.Credit,
 		}
 	}
-
-	return db, nil
+	return engine.New(db, currentTime, append([]engine.Option{
+		engine.WithDefaultAccount(cfg.DefaultAccount),
+		engine.WithLocation(cfg.Location),
+		engine.WithCurrency(cfg.Currency),
+		engine.WithImportMappings(mappings),
+		engine.WithReceiptDir(cfg.Database.ReceiptsPath),
+	}, opts...)...)
 }
 
 func initWhatsAppClient() {
 	ctx := context.Background()
 	// WhatsApp database setup
 	//container := sqlstore.NewWithDB(waDb, "sqlite3", nil)
-	container, err := sqlstore.New(ctx, "sqlite3", "whatsapp.db?_foreign_keys=on", nil)
+	container, err := sqlstore.New(ctx, "sqlite3", cfg.Database.WhatsAppPath+"?_foreign_keys=on", nil)
+	// The bot is useless without WhatsApp, and the scheduler would deliver
+	// to a missing client
 	if err != nil {
-		log.Println("Failed to connect to database:", err)
-		return
+		log.Fatalf("Failed to connect to the WhatsApp database: %v", err)
 	}
 	// If you want multiple devices, use container.GetFirstDevice() instead
-	deviceStore, _ := container.GetFirstDevice(ctx)
+	deviceStore, err := container.GetFirstDevice(ctx)
+	if err != nil {
+		log.Fatalf("Failed to load the WhatsApp device: %v", err)
+	}
 	client = whatsmeow.NewClient(deviceStore, nil)
 	client.AddEventHandler(eventHandler)
+	startCatchUp()
 
 	// Connect to WhatsApp
 	if client.Store.ID == nil {
@@ -178,226 +187,207 @@ func eventHandler(evt interface{}) {
 		log.Printf("Received message from %s in chat %s",
 			v.Info.Sender, v.Info.Chat)
 		handleMessage(v)
+	case *events.OfflineSyncPreview:
+		log.Printf("Catching up on %d messages sent while offline", v.Messages)
+	case *events.OfflineSyncCompleted:
+		finishCatchUp()
+	case *events.HistorySync:
+		handleHistorySync(v)
+	case *events.Connected:
+		armCatchUp()
+	case *events.Disconnected:
+		startCatchUp()
 	}
 }
 
-func handleMessage(msg *events.Message) {
-	// Only handle text messages
-	if msg.Message.GetConversation() == "" {
-		return
+// messageText returns the text of m, whichever of WhatsApp's text-bearing
+// message types it is, and the ID of the message it replies to. Photos,
+// videos and documents carry their text as a caption.
+func messageText(m *waProto.Message) (text, quotedID string) {
+	var info *waProto.ContextInfo
+	switch {
+	case m.GetConversation() != "":
+		return m.GetConversation(), ""
+	case m.GetExtendedTextMessage() != nil:
+		text, info = m.GetExtendedTextMessage().GetText(), m.GetExtendedTextMessage().GetContextInfo()
+	case m.GetImageMessage() != nil:
+		text, info = m.GetImageMessage().GetCaption(), m.GetImageMessage().GetContextInfo()
+	case m.GetDocumentMessage() != nil:
+		text, info = m.GetDocumentMessage().GetCaption(), m.GetDocumentMessage().GetContextInfo()
+	case m.GetVideoMessage() != nil:
+		text, info = m.GetVideoMessage().GetCaption(), m.GetVideoMessage().GetContextInfo()
 	}
+	return text, info.GetStanzaID()
+}
 
-	// Verify sender (only you and your wife)
-	sender := msg.Info.Sender.String()
-	if !isAuthorizedUser(sender) {
+func handleMessage(msg *events.Message) {
+	// The bot's own messages come back from its other devices
+	if msg.Info.IsFromMe {
 		return
 	}
-
-	content := strings.ToLower(strings.TrimSpace(msg.Message.GetConversation()))
-	args := strings.Split(content, "\n")
-
-	args[0] = strings.TrimSpace(args[0])
-	switch args[0] {
-	case "income":
-		processTransaction(msg.Info.Chat, "income", args[1:])
-	case "expense":
-		processTransaction(msg.Info.Chat, "expense", args[1:])
-	case "today's mutation":
-		getMutations(msg.Info.Chat, currentTime().Format("2006-01-02"))
-	case "month's mutation":
-		getMonthlyMutations(msg.Info.Chat)
-	default:
-		if strings.HasPrefix(args[0], "mutation date ") {
-			date := strings.TrimPrefix(args[0], "mutation date ")
-			getMutations(msg.Info.Chat, date)
+	// Messages delivered again after a reconnect are handled only once
+	ctx := context.Background()
+	if protocol := msg.Message.GetProtocolMessage(); protocol != nil {
+		if amendHeldBack(protocol) {
+			return
 		}
+		cmd := engine.Command{Sender: msg.Info.Sender.String(), Chat: msg.Info.Chat.String(), MessageID: msg.Info.ID, SentAt: msg.Info.Timestamp}
+		sendReplies(msg.Info.Chat, bot.ProcessOnce(ctx, cmd, func() []engine.Reply {
+			return handleProtocolMessage(msg, protocol)
+		}))
+		return
 	}
-}
 
-func isAuthorizedUser(sender string) bool {
-	me, exists := os.LookupEnv("ME")
-	if !exists {
-		fmt.Println("GITHUB_TOKEN environment variable not found")
-		return false
+	cmd, ok := commandOf(ctx, msg)
+	if !ok || holdBack(cmd) {
+		return
 	}
+	sendReplies(msg.Info.Chat, bot.ProcessOnce(ctx, cmd, func() []engine.Reply {
+		return bot.Handle(ctx, cmd)
+	}))
+}
 
-	if me == "" {
-		fmt.Println("GITHUB_TOKEN is empty")
-		return false
+// commandOf turns msg into a command for the bot. ok is false for messages
+// without text.
+func commandOf(ctx context.Context, msg *events.Message) (cmd engine.Command, ok bool) {
+	text, quotedID := messageText(msg.Message)
+	cmd = engine.Command{
+		Sender:     msg.Info.Sender.String(),
+		SenderName: msg.Info.PushName,
+		Chat:       msg.Info.Chat.String(),
+		Text:       text,
+		QuotedID:   quotedID,
+		MessageID:  msg.Info.ID,
+		SentAt:     msg.Info.Timestamp,
 	}
-
-	you, exists := os.LookupEnv("YOU")
-	if !exists {
-		fmt.Println("GITHUB_TOKEN environment variable not found")
-		return false
+	// Only handle messages with text
+	if cmd.Text == "" {
+		return cmd, false
 	}
 
-	if you == "" {
-		fmt.Println("GITHUB_TOKEN is empty")
-		return false
+	// Captioned photos and documents are downloaded only if the command
+	// turns out to use them
+	var media whatsmeow.DownloadableMessage
+	var size uint64
+	attachment := &engine.Document{}
+	if img := msg.Message.GetImageMessage(); img != nil {
+		media, size = img, img.GetFileLength()
+		attachment.MimeType = img.GetMimetype()
+	} else if doc := msg.Message.GetDocumentMessage(); doc != nil {
+		media, size = doc, doc.GetFileLength()
+		attachment.FileName, attachment.MimeType = doc.GetFileName(), doc.GetMimetype()
 	}
-	// Replace with your and your wife's phone numbers
-	authorizedNumbers := []string{
-		me,  // Your number
-		you, // Wife's number
-	}
-	for _, num := range authorizedNumbers {
-		if sender == num {
-			return true
+	if media != nil {
+		attachment.Download = func() ([]byte, error) {
+			if size > maxDocumentSize {
+				return nil, fmt.Errorf("%s attachment of %d bytes is too large", attachment.MimeType, size)
+			}
+			return client.Download(ctx, media)
 		}
+		cmd.Document = attachment
 	}
-	return false
+	return cmd, true
 }
 
-func processTransaction(chat types.JID, txType string, lines []string) {
-	totalAmount := 0
-
-	for _, line := range lines {
-		parts := strings.SplitN(line, "=", 2)
-		if len(parts) < 2 {
-			continue
-		}
+// handleProtocolMessage applies edits and deletions of earlier messages to
+// the transactions they recorded.
+func handleProtocolMessage(msg *events.Message, protocol *waProto.ProtocolMessage) []engine.Reply {
+	cmd := engine.Command{
+		Sender:     msg.Info.Sender.String(),
+		SenderName: msg.Info.PushName,
+		Chat:       msg.Info.Chat.String(),
+		MessageID:  protocol.GetKey().GetID(),
+	}
+	switch protocol.GetType() {
+	case waProto.ProtocolMessage_MESSAGE_EDIT:
+		cmd.Text, cmd.QuotedID = messageText(protocol.GetEditedMessage())
+		return bot.Edit(context.Background(), cmd)
+	case waProto.ProtocolMessage_REVOKE:
+		return bot.Revoke(context.Background(), cmd)
+	}
+	return nil
+}
 
-		desc := strings.TrimSpace(parts[0])
-		amountStr := regexp.MustCompile(`[^0-9]`).ReplaceAllString(parts[1], "")
-		amount, err := strconv.Atoi(amountStr)
-		if err != nil {
+// sendReplies sends replies to chat, linking each sent update to the batch
+// it reports on so replies quoting it act on those transactions.
+func sendReplies(chat types.JID, replies []engine.Reply) {
+	for _, reply := range replies {
+		if reply.Document != nil {
+			sendDocument(chat, reply.Document, reply.Text)
 			continue
 		}
-
-		if txType == "expense" {
-			amount = -amount
-		} else {
-			totalAmount += amount
-		}
-
-		// Save to database
-		tx := &models.Transaction{
-			Type:        txType,
-			Description: null.StringFrom(desc),
-			Amount:      int64(amount),
-			CreatedAt:   currentTime(),
+		id := sendMessage(chat, reply.Text)
+		if err := bot.LinkMessage(context.Background(), chat.String(), id, reply.Batch); err != nil {
+			log.Println("Error linking message:", err)
 		}
-		tx.Insert(context.Background(), db, boil.Infer())
 	}
-
-	// Calculate and send current balance
-	balance := getCurrentBalance()
-	response := fmt.Sprintf("💰 *Financial Update* 💰\nDate: %s\nNew Balance: Rp %s",
-		currentTime().Format("2006-01-02"),
-		formatCurrency(balance))
-
-	sendMessage(chat, response)
 }
 
-func getMutations(chat types.JID, date string) {
-	start, err := time.Parse("2006-01-02", date)
+func deliverNotice(notice engine.Notice) {
+	chat, err := types.ParseJID(notice.Chat)
 	if err != nil {
-		sendMessage(chat, "⚠️ Invalid date format. Use YYYY-MM-DD")
+		log.Printf("Invalid chat %q for notice: %v", notice.Chat, err)
 		return
 	}
-	end := start.Add(24 * time.Hour)
-
-	transactions, err := models.Transactions(
-		qm.Where("created_at >= ? AND created_at < ?", start, end),
-		qm.OrderBy("created_at ASC"),
-	).All(context.Background(), db)
-
-	if err != nil {
-		log.Println("Error fetching transactions:", err)
-		sendMessage(chat, "❌ Error fetching transactions")
-		return
-	}
-
-	response := buildMutationResponse(transactions, date)
-	sendMessage(chat, response)
+	sendReplies(chat, []engine.Reply{notice.Reply})
 }
 
-func getMonthlyMutations(chat types.JID) {
-	now := currentTime()
-	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
-	end := start.AddDate(0, 1, 0)
-	monthYear := now.Format("January 2006")
-
-	transactions, err := models.Transactions(
-		qm.Where("created_at >= ? AND created_at < ?", start, end),
-		qm.OrderBy("created_at ASC"),
-	).All(context.Background(), db)
-
+// sendMessage sends text to chat and returns the ID of the sent message,
+// or "" when sending failed.
+func sendMessage(chat types.JID, text string) string {
+	resp, err := client.SendMessage(context.Background(), chat, &waProto.Message{
+		Conversation: proto.String(text),
+	})
 	if err != nil {
-		log.Println("Error fetching monthly transactions:", err)
-		sendMessage(chat, "❌ Error fetching monthly transactions")
-		return
+		log.Println("Error sending message:", err)
+		return ""
 	}
-
-	response := buildMutationResponse(transactions, "Month of "+monthYear)
-	sendMessage(chat, response)
-}
-
-func getCurrentBalance() int64 {
-	var balance int64
-	db.QueryRow("SELECT COALESCE(SUM(amount), 0) FROM transactions").Scan(&balance)
-	return balance
+	return resp.ID
 }
 
-func formatCurrency(amount int64) string {
-	str := fmt.Sprintf("%d", amount)
-	var parts []string
-
-	// Handle negative numbers
-	negative := false
-	if amount < 0 {
-		negative = true
-		str = str[1:]
-	}
-
-	// Format with thousand separators
-	for len(str) > 3 {
-		parts = append([]string{str[len(str)-3:]}, parts...)
-		str = str[:len(str)-3]
+// sendDocument uploads doc to WhatsApp and sends it with caption, as a
+// photo when it is an image.
+func sendDocument(chat types.JID, doc *engine.Document, caption string) {
+	mediaType := whatsmeow.MediaDocument
+	if strings.HasPrefix(doc.MimeType, "image/") {
+		mediaType = whatsmeow.MediaImage
 	}
-	if str != "" {
-		parts = append([]string{str}, parts...)
-	}
-
-	result := strings.Join(parts, ".")
-	if negative {
-		result = "-" + result
+	uploaded, err := client.Upload(context.Background(), doc.Data, mediaType)
+	if err != nil {
+		log.Println("Error uploading document:", err)
+		sendMessage(chat, "❌ Error sending "+doc.FileName)
+		return
 	}
-	return result
-}
 
-func buildMutationResponse(transactions []*models.Transaction, period string) string {
-	if len(transactions) == 0 {
-		return fmt.Sprintf("📊 *Transaction Report*\nPeriod: %s\n\nNo transactions found", period)
+	message := &waProto.Message{
+		DocumentMessage: &waProto.DocumentMessage{
+			URL:           proto.String(uploaded.URL),
+			DirectPath:    proto.String(uploaded.DirectPath),
+			MediaKey:      uploaded.MediaKey,
+			FileEncSHA256: uploaded.FileEncSHA256,
+			FileSHA256:    uploaded.FileSHA256,
+			FileLength:    proto.Uint64(uploaded.FileLength),
+			Mimetype:      proto.String(doc.MimeType),
+			FileName:      proto.String(doc.FileName),
+			Title:         proto.String(doc.FileName),
+			Caption:       proto.String(caption),
+		},
 	}
-
-	var sb strings.Builder
-	sb.WriteString(fmt.Sprintf("📊 *Transaction Report*\nPeriod: %s\n\n", period))
-
-	var total int64
-	for _, tx := range transactions {
-		total += tx.Amount
-		sign := ""
-		if tx.Amount > 0 {
-			sign = "+"
+	if mediaType == whatsmeow.MediaImage {
+		message = &waProto.Message{
+			ImageMessage: &waProto.ImageMessage{
+				URL:           proto.String(uploaded.URL),
+				DirectPath:    proto.String(uploaded.DirectPath),
+				MediaKey:      uploaded.MediaKey,
+				FileEncSHA256: uploaded.FileEncSHA256,
+				FileSHA256:    uploaded.FileSHA256,
+				FileLength:    proto.Uint64(uploaded.FileLength),
+				Mimetype:      proto.String(doc.MimeType),
+				Caption:       proto.String(caption),
+			},
 		}
-		sb.WriteString(fmt.Sprintf("⏰ %s\n%s: %sRp %s\n\n",
-			tx.CreatedAt.Format("Mon, 02 Jan 2006 15:04"),
-			tx.Description.String,
-			sign,
-			formatCurrency(tx.Amount)))
 	}
-
-	sb.WriteString(fmt.Sprintf("💵 *Total Balance*: Rp %s", formatCurrency(total)))
-	return sb.String()
-}
-
-func sendMessage(chat types.JID, text string) {
-	_, err := client.SendMessage(context.Background(), chat, &waProto.Message{
-		Conversation: proto.String(text),
-	})
-	if err != nil {
-		log.Println("Error sending message:", err)
+	if _, err := client.SendMessage(context.Background(), chat, message); err != nil {
+		log.Println("Error sending document:", err)
 	}
 }
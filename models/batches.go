@@ -0,0 +1,1661 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// Batch is an object representing the database table.
+type Batch struct {
+	ID        null.Int64 `boil:"id" json:"id,omitempty" toml:"id" yaml:"id,omitempty"`
+	Sender    string     `boil:"sender" json:"sender" toml:"sender" yaml:"sender"`
+	CreatedAt time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	LedgerID  null.Int64 `boil:"ledger_id" json:"ledger_id,omitempty" toml:"ledger_id" yaml:"ledger_id,omitempty"`
+
+	R *batchR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L batchL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var BatchColumns = struct {
+	ID        string
+	Sender    string
+	CreatedAt string
+	LedgerID  string
+}{
+	ID:        "id",
+	Sender:    "sender",
+	CreatedAt: "created_at",
+	LedgerID:  "ledger_id",
+}
+
+var BatchTableColumns = struct {
+	ID        string
+	Sender    string
+	CreatedAt string
+	LedgerID  string
+}{
+	ID:        "batches.id",
+	Sender:    "batches.sender",
+	CreatedAt: "batches.created_at",
+	LedgerID:  "batches.ledger_id",
+}
+
+// Generated where
+
+var BatchWhere = struct {
+	ID        whereHelpernull_Int64
+	Sender    whereHelperstring
+	CreatedAt whereHelpertime_Time
+	LedgerID  whereHelpernull_Int64
+}{
+	ID:        whereHelpernull_Int64{field: "\"batches\".\"id\""},
+	Sender:    whereHelperstring{field: "\"batches\".\"sender\""},
+	CreatedAt: whereHelpertime_Time{field: "\"batches\".\"created_at\""},
+	LedgerID:  whereHelpernull_Int64{field: "\"batches\".\"ledger_id\""},
+}
+
+// BatchRels is where relationship names are stored.
+var BatchRels = struct {
+	Ledger       string
+	Messages     string
+	Transactions string
+}{
+	Ledger:       "Ledger",
+	Messages:     "Messages",
+	Transactions: "Transactions",
+}
+
+// batchR is where relationships are stored.
+type batchR struct {
+	Ledger       *Ledger          `boil:"Ledger" json:"Ledger" toml:"Ledger" yaml:"Ledger"`
+	Messages     MessageSlice     `boil:"Messages" json:"Messages" toml:"Messages" yaml:"Messages"`
+	Transactions TransactionSlice `boil:"Transactions" json:"Transactions" toml:"Transactions" yaml:"Transactions"`
+}
+
+// NewStruct creates a new relationship struct
+func (*batchR) NewStruct() *batchR {
+	return &batchR{}
+}
+
+func (o *Batch) GetLedger() *Ledger {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetLedger()
+}
+
+func (r *batchR) GetLedger() *Ledger {
+	if r == nil {
+		return nil
+	}
+
+	return r.Ledger
+}
+
+func (o *Batch) GetMessages() MessageSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetMessages()
+}
+
+func (r *batchR) GetMessages() MessageSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Messages
+}
+
+func (o *Batch) GetTransactions() TransactionSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTransactions()
+}
+
+func (r *batchR) GetTransactions() TransactionSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Transactions
+}
+
+// batchL is where Load methods for each relationship are stored.
+type batchL struct{}
+
+var (
+	batchAllColumns            = []string{"id", "sender", "created_at", "ledger_id"}
+	batchColumnsWithoutDefault = []string{"sender"}
+	batchColumnsWithDefault    = []string{"id", "created_at", "ledger_id"}
+	batchPrimaryKeyColumns     = []string{"id"}
+	batchGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// BatchSlice is an alias for a slice of pointers to Batch.
+	// This should almost always be used instead of []Batch.
+	BatchSlice []*Batch
+	// BatchHook is the signature for custom Batch hook methods
+	BatchHook func(context.Context, boil.ContextExecutor, *Batch) error
+
+	batchQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	batchType                 = reflect.TypeOf(&Batch{})
+	batchMapping              = queries.MakeStructMapping(batchType)
+	batchPrimaryKeyMapping, _ = queries.BindMapping(batchType, batchMapping, batchPrimaryKeyColumns)
+	batchInsertCacheMut       sync.RWMutex
+	batchInsertCache          = make(map[string]insertCache)
+	batchUpdateCacheMut       sync.RWMutex
+	batchUpdateCache          = make(map[string]updateCache)
+	batchUpsertCacheMut       sync.RWMutex
+	batchUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var batchAfterSelectMu sync.Mutex
+var batchAfterSelectHooks []BatchHook
+
+var batchBeforeInsertMu sync.Mutex
+var batchBeforeInsertHooks []BatchHook
+var batchAfterInsertMu sync.Mutex
+var batchAfterInsertHooks []BatchHook
+
+var batchBeforeUpdateMu sync.Mutex
+var batchBeforeUpdateHooks []BatchHook
+var batchAfterUpdateMu sync.Mutex
+var batchAfterUpdateHooks []BatchHook
+
+var batchBeforeDeleteMu sync.Mutex
+var batchBeforeDeleteHooks []BatchHook
+var batchAfterDeleteMu sync.Mutex
+var batchAfterDeleteHooks []BatchHook
+
+var batchBeforeUpsertMu sync.Mutex
+var batchBeforeUpsertHooks []BatchHook
+var batchAfterUpsertMu sync.Mutex
+var batchAfterUpsertHooks []BatchHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Batch) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range batchAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Batch) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range batchBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Batch) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range batchAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Batch) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range batchBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Batch) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range batchAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Batch) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range batchBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Batch) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range batchAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Batch) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range batchBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Batch) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range batchAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddBatchHook registers your hook function for all future operations.
+func AddBatchHook(hookPoint boil.HookPoint, batchHook BatchHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		batchAfterSelectMu.Lock()
+		batchAfterSelectHooks = append(batchAfterSelectHooks, batchHook)
+		batchAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		batchBeforeInsertMu.Lock()
+		batchBeforeInsertHooks = append(batchBeforeInsertHooks, batchHook)
+		batchBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		batchAfterInsertMu.Lock()
+		batchAfterInsertHooks = append(batchAfterInsertHooks, batchHook)
+		batchAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		batchBeforeUpdateMu.Lock()
+		batchBeforeUpdateHooks = append(batchBeforeUpdateHooks, batchHook)
+		batchBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		batchAfterUpdateMu.Lock()
+		batchAfterUpdateHooks = append(batchAfterUpdateHooks, batchHook)
+		batchAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		batchBeforeDeleteMu.Lock()
+		batchBeforeDeleteHooks = append(batchBeforeDeleteHooks, batchHook)
+		batchBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		batchAfterDeleteMu.Lock()
+		batchAfterDeleteHooks = append(batchAfterDeleteHooks, batchHook)
+		batchAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		batchBeforeUpsertMu.Lock()
+		batchBeforeUpsertHooks = append(batchBeforeUpsertHooks, batchHook)
+		batchBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		batchAfterUpsertMu.Lock()
+		batchAfterUpsertHooks = append(batchAfterUpsertHooks, batchHook)
+		batchAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single batch record from the query.
+func (q batchQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Batch, error) {
+	o := &Batch{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for batches")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Batch records from the query.
+func (q batchQuery) All(ctx context.Context, exec boil.ContextExecutor) (BatchSlice, error) {
+	var o []*Batch
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Batch slice")
+	}
+
+	if len(batchAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Batch records in the query.
+func (q batchQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count batches rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q batchQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if batches exists")
+	}
+
+	return count > 0, nil
+}
+
+// Ledger pointed to by the foreign key.
+func (o *Batch) Ledger(mods ...qm.QueryMod) ledgerQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.LedgerID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Ledgers(queryMods...)
+}
+
+// Messages retrieves all the message's Messages with an executor.
+func (o *Batch) Messages(mods ...qm.QueryMod) messageQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"messages\".\"batch_id\"=?", o.ID),
+	)
+
+	return Messages(queryMods...)
+}
+
+// Transactions retrieves all the transaction's Transactions with an executor.
+func (o *Batch) Transactions(mods ...qm.QueryMod) transactionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"transactions\".\"batch_id\"=?", o.ID),
+	)
+
+	return Transactions(queryMods...)
+}
+
+// LoadLedger allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (batchL) LoadLedger(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBatch interface{}, mods queries.Applicator) error {
+	var slice []*Batch
+	var object *Batch
+
+	if singular {
+		var ok bool
+		object, ok = maybeBatch.(*Batch)
+		if !ok {
+			object = new(Batch)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBatch)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBatch))
+			}
+		}
+	} else {
+		s, ok := maybeBatch.(*[]*Batch)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBatch)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBatch))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &batchR{}
+		}
+		if !queries.IsNil(object.LedgerID) {
+			args[object.LedgerID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &batchR{}
+			}
+
+			if !queries.IsNil(obj.LedgerID) {
+				args[obj.LedgerID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`ledgers`),
+		qm.WhereIn(`ledgers.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Ledger")
+	}
+
+	var resultSlice []*Ledger
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Ledger")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for ledgers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for ledgers")
+	}
+
+	if len(ledgerAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Ledger = foreign
+		if foreign.R == nil {
+			foreign.R = &ledgerR{}
+		}
+		foreign.R.Batches = append(foreign.R.Batches, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.LedgerID, foreign.ID) {
+				local.R.Ledger = foreign
+				if foreign.R == nil {
+					foreign.R = &ledgerR{}
+				}
+				foreign.R.Batches = append(foreign.R.Batches, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMessages allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (batchL) LoadMessages(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBatch interface{}, mods queries.Applicator) error {
+	var slice []*Batch
+	var object *Batch
+
+	if singular {
+		var ok bool
+		object, ok = maybeBatch.(*Batch)
+		if !ok {
+			object = new(Batch)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBatch)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBatch))
+			}
+		}
+	} else {
+		s, ok := maybeBatch.(*[]*Batch)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBatch)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBatch))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &batchR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &batchR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`messages`),
+		qm.WhereIn(`messages.batch_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load messages")
+	}
+
+	var resultSlice []*Message
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice messages")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on messages")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for messages")
+	}
+
+	if len(messageAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Messages = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &messageR{}
+			}
+			foreign.R.Batch = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.BatchID) {
+				local.R.Messages = append(local.R.Messages, foreign)
+				if foreign.R == nil {
+					foreign.R = &messageR{}
+				}
+				foreign.R.Batch = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTransactions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (batchL) LoadTransactions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBatch interface{}, mods queries.Applicator) error {
+	var slice []*Batch
+	var object *Batch
+
+	if singular {
+		var ok bool
+		object, ok = maybeBatch.(*Batch)
+		if !ok {
+			object = new(Batch)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBatch)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBatch))
+			}
+		}
+	} else {
+		s, ok := maybeBatch.(*[]*Batch)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBatch)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBatch))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &batchR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &batchR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`transactions`),
+		qm.WhereIn(`transactions.batch_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load transactions")
+	}
+
+	var resultSlice []*Transaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice transactions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on transactions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transactions")
+	}
+
+	if len(transactionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Transactions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &transactionR{}
+			}
+			foreign.R.Batch = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.BatchID) {
+				local.R.Transactions = append(local.R.Transactions, foreign)
+				if foreign.R == nil {
+					foreign.R = &transactionR{}
+				}
+				foreign.R.Batch = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetLedger of the batch to the related item.
+// Sets o.R.Ledger to related.
+// Adds o to related.R.Batches.
+func (o *Batch) SetLedger(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Ledger) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"batches\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"ledger_id"}),
+		strmangle.WhereClause("\"", "\"", 0, batchPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.LedgerID, related.ID)
+	if o.R == nil {
+		o.R = &batchR{
+			Ledger: related,
+		}
+	} else {
+		o.R.Ledger = related
+	}
+
+	if related.R == nil {
+		related.R = &ledgerR{
+			Batches: BatchSlice{o},
+		}
+	} else {
+		related.R.Batches = append(related.R.Batches, o)
+	}
+
+	return nil
+}
+
+// RemoveLedger relationship.
+// Sets o.R.Ledger to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Batch) RemoveLedger(ctx context.Context, exec boil.ContextExecutor, related *Ledger) error {
+	var err error
+
+	queries.SetScanner(&o.LedgerID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("ledger_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Ledger = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Batches {
+		if queries.Equal(o.LedgerID, ri.LedgerID) {
+			continue
+		}
+
+		ln := len(related.R.Batches)
+		if ln > 1 && i < ln-1 {
+			related.R.Batches[i] = related.R.Batches[ln-1]
+		}
+		related.R.Batches = related.R.Batches[:ln-1]
+		break
+	}
+	return nil
+}
+
+// AddMessages adds the given related objects to the existing relationships
+// of the batch, optionally inserting them as new records.
+// Appends related to o.R.Messages.
+// Sets related.R.Batch appropriately.
+func (o *Batch) AddMessages(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Message) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.BatchID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"messages\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"batch_id"}),
+				strmangle.WhereClause("\"", "\"", 0, messagePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.BatchID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &batchR{
+			Messages: related,
+		}
+	} else {
+		o.R.Messages = append(o.R.Messages, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &messageR{
+				Batch: o,
+			}
+		} else {
+			rel.R.Batch = o
+		}
+	}
+	return nil
+}
+
+// AddTransactions adds the given related objects to the existing relationships
+// of the batch, optionally inserting them as new records.
+// Appends related to o.R.Transactions.
+// Sets related.R.Batch appropriately.
+func (o *Batch) AddTransactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Transaction) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.BatchID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"transactions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"batch_id"}),
+				strmangle.WhereClause("\"", "\"", 0, transactionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.BatchID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &batchR{
+			Transactions: related,
+		}
+	} else {
+		o.R.Transactions = append(o.R.Transactions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &transactionR{
+				Batch: o,
+			}
+		} else {
+			rel.R.Batch = o
+		}
+	}
+	return nil
+}
+
+// SetTransactions removes all previously related items of the
+// batch replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Batch's Transactions accordingly.
+// Replaces o.R.Transactions with related.
+// Sets related.R.Batch's Transactions accordingly.
+func (o *Batch) SetTransactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Transaction) error {
+	query := "update \"transactions\" set \"batch_id\" = null where \"batch_id\" = ?"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Transactions {
+			queries.SetScanner(&rel.BatchID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Batch = nil
+		}
+		o.R.Transactions = nil
+	}
+
+	return o.AddTransactions(ctx, exec, insert, related...)
+}
+
+// RemoveTransactions relationships from objects passed in.
+// Removes related items from R.Transactions (uses pointer comparison, removal does not keep order)
+// Sets related.R.Batch.
+func (o *Batch) RemoveTransactions(ctx context.Context, exec boil.ContextExecutor, related ...*Transaction) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.BatchID, nil)
+		if rel.R != nil {
+			rel.R.Batch = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("batch_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Transactions {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Transactions)
+			if ln > 1 && i < ln-1 {
+				o.R.Transactions[i] = o.R.Transactions[ln-1]
+			}
+			o.R.Transactions = o.R.Transactions[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// Batches retrieves all the records using an executor.
+func Batches(mods ...qm.QueryMod) batchQuery {
+	mods = append(mods, qm.From("\"batches\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"batches\".*"})
+	}
+
+	return batchQuery{q}
+}
+
+// FindBatch retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindBatch(ctx context.Context, exec boil.ContextExecutor, iD null.Int64, selectCols ...string) (*Batch, error) {
+	batchObj := &Batch{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"batches\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, batchObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from batches")
+	}
+
+	if err = batchObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return batchObj, err
+	}
+
+	return batchObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Batch) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no batches provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(batchColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	batchInsertCacheMut.RLock()
+	cache, cached := batchInsertCache[key]
+	batchInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			batchAllColumns,
+			batchColumnsWithDefault,
+			batchColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, batchGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(batchType, batchMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(batchType, batchMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"batches\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"batches\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into batches")
+	}
+
+	if !cached {
+		batchInsertCacheMut.Lock()
+		batchInsertCache[key] = cache
+		batchInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Batch.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Batch) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	batchUpdateCacheMut.RLock()
+	cache, cached := batchUpdateCache[key]
+	batchUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			batchAllColumns,
+			batchPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, batchGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update batches, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"batches\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, batchPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(batchType, batchMapping, append(wl, batchPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update batches row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for batches")
+	}
+
+	if !cached {
+		batchUpdateCacheMut.Lock()
+		batchUpdateCache[key] = cache
+		batchUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q batchQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for batches")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for batches")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o BatchSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), batchPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"batches\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, batchPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in batch slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all batch")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Batch) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no batches provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(batchColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	batchUpsertCacheMut.RLock()
+	cache, cached := batchUpsertCache[key]
+	batchUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			batchAllColumns,
+			batchColumnsWithDefault,
+			batchColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			batchAllColumns,
+			batchPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert batches, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(batchAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(batchPrimaryKeyColumns))
+			copy(conflict, batchPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"batches\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(batchType, batchMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(batchType, batchMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert batches")
+	}
+
+	if !cached {
+		batchUpsertCacheMut.Lock()
+		batchUpsertCache[key] = cache
+		batchUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Batch record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Batch) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Batch provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), batchPrimaryKeyMapping)
+	sql := "DELETE FROM \"batches\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from batches")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for batches")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q batchQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no batchQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from batches")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for batches")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o BatchSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(batchBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), batchPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"batches\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, batchPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from batch slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for batches")
+	}
+
+	if len(batchAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Batch) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindBatch(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *BatchSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := BatchSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), batchPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"batches\".* FROM \"batches\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, batchPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in BatchSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// BatchExists checks if the Batch row exists.
+func BatchExists(ctx context.Context, exec boil.ContextExecutor, iD null.Int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"batches\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if batches exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Batch row exists.
+func (o *Batch) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return BatchExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1382 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testBatches(t *testing.T) {
+	t.Parallel()
+
+	query := Batches()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testBatchesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Batch{}
+	if err = randomize.Struct(seed, o, batchDBTypes, true, batchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Batch struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Batches().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testBatchesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Batch{}
+	if err = randomize.Struct(seed, o, batchDBTypes, true, batchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Batch struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Batches().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Batches().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testBatchesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Batch{}
+	if err = randomize.Struct(seed, o, batchDBTypes, true, batchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Batch struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := BatchSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Batches().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testBatchesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Batch{}
+	if err = randomize.Struct(seed, o, batchDBTypes, true, batchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Batch struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := BatchExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if Batch exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected BatchExists to return true, but got false.")
+	}
+}
+
+func testBatchesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Batch{}
+	if err = randomize.Struct(seed, o, batchDBTypes, true, batchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Batch struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	batchFound, err := FindBatch(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if batchFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testBatchesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Batch{}
+	if err = randomize.Struct(seed, o, batchDBTypes, true, batchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Batch struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Batches().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testBatchesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Batch{}
+	if err = randomize.Struct(seed, o, batchDBTypes, true, batchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Batch struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Batches().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testBatchesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	batchOne := &Batch{}
+	batchTwo := &Batch{}
+	if err = randomize.Struct(seed, batchOne, batchDBTypes, false, batchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Batch struct: %s", err)
+	}
+	if err = randomize.Struct(seed, batchTwo, batchDBTypes, false, batchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Batch struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = batchOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = batchTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Batches().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testBatchesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	batchOne := &Batch{}
+	batchTwo := &Batch{}
+	if err = randomize.Struct(seed, batchOne, batchDBTypes, false, batchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Batch struct: %s", err)
+	}
+	if err = randomize.Struct(seed, batchTwo, batchDBTypes, false, batchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Batch struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = batchOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = batchTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Batches().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func batchBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *Batch) error {
+	*o = Batch{}
+	return nil
+}
+
+func batchAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *Batch) error {
+	*o = Batch{}
+	return nil
+}
+
+func batchAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *Batch) error {
+	*o = Batch{}
+	return nil
+}
+
+func batchBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Batch) error {
+	*o = Batch{}
+	return nil
+}
+
+func batchAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Batch) error {
+	*o = Batch{}
+	return nil
+}
+
+func batchBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Batch) error {
+	*o = Batch{}
+	return nil
+}
+
+func batchAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Batch) error {
+	*o = Batch{}
+	return nil
+}
+
+func batchBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Batch) error {
+	*o = Batch{}
+	return nil
+}
+
+func batchAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Batch) error {
+	*o = Batch{}
+	return nil
+}
+
+func testBatchesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &Batch{}
+	o := &Batch{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, batchDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize Batch object: %s", err)
+	}
+
+	AddBatchHook(boil.BeforeInsertHook, batchBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	batchBeforeInsertHooks = []BatchHook{}
+
+	AddBatchHook(boil.AfterInsertHook, batchAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	batchAfterInsertHooks = []BatchHook{}
+
+	AddBatchHook(boil.AfterSelectHook, batchAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	batchAfterSelectHooks = []BatchHook{}
+
+	AddBatchHook(boil.BeforeUpdateHook, batchBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	batchBeforeUpdateHooks = []BatchHook{}
+
+	AddBatchHook(boil.AfterUpdateHook, batchAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	batchAfterUpdateHooks = []BatchHook{}
+
+	AddBatchHook(boil.BeforeDeleteHook, batchBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	batchBeforeDeleteHooks = []BatchHook{}
+
+	AddBatchHook(boil.AfterDeleteHook, batchAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	batchAfterDeleteHooks = []BatchHook{}
+
+	AddBatchHook(boil.BeforeUpsertHook, batchBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	batchBeforeUpsertHooks = []BatchHook{}
+
+	AddBatchHook(boil.AfterUpsertHook, batchAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	batchAfterUpsertHooks = []BatchHook{}
+}
+
+func testBatchesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Batch{}
+	if err = randomize.Struct(seed, o, batchDBTypes, true, batchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Batch struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Batches().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testBatchesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Batch{}
+	if err = randomize.Struct(seed, o, batchDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Batch struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(batchPrimaryKeyColumns, batchColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Batches().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testBatchToManyMessages(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Batch
+	var b, c Message
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, batchDBTypes, true, batchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Batch struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, messageDBTypes, false, messageColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, messageDBTypes, false, messageColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.BatchID, a.ID)
+	queries.Assign(&c.BatchID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Messages().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.BatchID, b.BatchID) {
+			bFound = true
+		}
+		if queries.Equal(v.BatchID, c.BatchID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := BatchSlice{&a}
+	if err = a.L.LoadMessages(ctx, tx, false, (*[]*Batch)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Messages); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Messages = nil
+	if err = a.L.LoadMessages(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Messages); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testBatchToManyTransactions(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Batch
+	var b, c Transaction
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, batchDBTypes, true, batchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Batch struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, transactionDBTypes, false, transactionColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, transactionDBTypes, false, transactionColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.BatchID, a.ID)
+	queries.Assign(&c.BatchID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Transactions().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.BatchID, b.BatchID) {
+			bFound = true
+		}
+		if queries.Equal(v.BatchID, c.BatchID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := BatchSlice{&a}
+	if err = a.L.LoadTransactions(ctx, tx, false, (*[]*Batch)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Transactions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Transactions = nil
+	if err = a.L.LoadTransactions(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Transactions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testBatchToManyAddOpMessages(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Batch
+	var b, c, d, e Message
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, batchDBTypes, false, strmangle.SetComplement(batchPrimaryKeyColumns, batchColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Message{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, messageDBTypes, false, strmangle.SetComplement(messagePrimaryKeyColumns, messageColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Message{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddMessages(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.BatchID) {
+			t.Error("foreign key was wrong value", a.ID, first.BatchID)
+		}
+		if !queries.Equal(a.ID, second.BatchID) {
+			t.Error("foreign key was wrong value", a.ID, second.BatchID)
+		}
+
+		if first.R.Batch != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Batch != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Messages[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Messages[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Messages().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testBatchToManyAddOpTransactions(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Batch
+	var b, c, d, e Transaction
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, batchDBTypes, false, strmangle.SetComplement(batchPrimaryKeyColumns, batchColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Transaction{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Transaction{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddTransactions(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.BatchID) {
+			t.Error("foreign key was wrong value", a.ID, first.BatchID)
+		}
+		if !queries.Equal(a.ID, second.BatchID) {
+			t.Error("foreign key was wrong value", a.ID, second.BatchID)
+		}
+
+		if first.R.Batch != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Batch != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Transactions[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Transactions[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Transactions().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testBatchToManySetOpTransactions(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Batch
+	var b, c, d, e Transaction
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, batchDBTypes, false, strmangle.SetComplement(batchPrimaryKeyColumns, batchColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Transaction{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetTransactions(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Transactions().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetTransactions(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Transactions().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.BatchID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.BatchID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.BatchID) {
+		t.Error("foreign key was wrong value", a.ID, d.BatchID)
+	}
+	if !queries.Equal(a.ID, e.BatchID) {
+		t.Error("foreign key was wrong value", a.ID, e.BatchID)
+	}
+
+	if b.R.Batch != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Batch != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Batch != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Batch != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.Transactions[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.Transactions[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testBatchToManyRemoveOpTransactions(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Batch
+	var b, c, d, e Transaction
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, batchDBTypes, false, strmangle.SetComplement(batchPrimaryKeyColumns, batchColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Transaction{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddTransactions(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Transactions().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveTransactions(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Transactions().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.BatchID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.BatchID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Batch != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Batch != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Batch != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Batch != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.Transactions) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.Transactions[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.Transactions[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testBatchToOneLedgerUsingLedger(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Batch
+	var foreign Ledger
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, batchDBTypes, true, batchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Batch struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, ledgerDBTypes, true, ledgerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Ledger struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.LedgerID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Ledger().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddLedgerHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Ledger) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := BatchSlice{&local}
+	if err = local.L.LoadLedger(ctx, tx, false, (*[]*Batch)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Ledger == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Ledger = nil
+	if err = local.L.LoadLedger(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Ledger == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testBatchToOneSetOpLedgerUsingLedger(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Batch
+	var b, c Ledger
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, batchDBTypes, false, strmangle.SetComplement(batchPrimaryKeyColumns, batchColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, ledgerDBTypes, false, strmangle.SetComplement(ledgerPrimaryKeyColumns, ledgerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, ledgerDBTypes, false, strmangle.SetComplement(ledgerPrimaryKeyColumns, ledgerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Ledger{&b, &c} {
+		err = a.SetLedger(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Ledger != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Batches[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.LedgerID, x.ID) {
+			t.Error("foreign key was wrong value", a.LedgerID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.LedgerID))
+		reflect.Indirect(reflect.ValueOf(&a.LedgerID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.LedgerID, x.ID) {
+			t.Error("foreign key was wrong value", a.LedgerID, x.ID)
+		}
+	}
+}
+
+func testBatchToOneRemoveOpLedgerUsingLedger(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Batch
+	var b Ledger
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, batchDBTypes, false, strmangle.SetComplement(batchPrimaryKeyColumns, batchColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, ledgerDBTypes, false, strmangle.SetComplement(ledgerPrimaryKeyColumns, ledgerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetLedger(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveLedger(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Ledger().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Ledger != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.LedgerID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.Batches) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testBatchesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Batch{}
+	if err = randomize.Struct(seed, o, batchDBTypes, true, batchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Batch struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testBatchesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Batch{}
+	if err = randomize.Struct(seed, o, batchDBTypes, true, batchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Batch struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := BatchSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testBatchesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Batch{}
+	if err = randomize.Struct(seed, o, batchDBTypes, true, batchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Batch struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Batches().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	batchDBTypes = map[string]string{`ID`: `INTEGER`, `Sender`: `TEXT`, `CreatedAt`: `DATETIME`, `LedgerID`: `INTEGER`}
+	_            = bytes.MinRead
+)
+
+func testBatchesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(batchPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(batchAllColumns) == len(batchPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Batch{}
+	if err = randomize.Struct(seed, o, batchDBTypes, true, batchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Batch struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Batches().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, batchDBTypes, true, batchPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Batch struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testBatchesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(batchAllColumns) == len(batchPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Batch{}
+	if err = randomize.Struct(seed, o, batchDBTypes, true, batchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Batch struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Batches().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, batchDBTypes, true, batchPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Batch struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(batchAllColumns, batchPrimaryKeyColumns) {
+		fields = batchAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			batchAllColumns,
+			batchPrimaryKeyColumns,
+		)
+		fields = strmangle.SetComplement(fields, batchGeneratedColumns)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := BatchSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testBatchesUpsert(t *testing.T) {
+	t.Parallel()
+	if len(batchAllColumns) == len(batchPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := Batch{}
+	if err = randomize.Struct(seed, &o, batchDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Batch struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Batch: %s", err)
+	}
+
+	count, err := Batches().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, batchDBTypes, false, batchPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Batch struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Batch: %s", err)
+	}
+
+	count, err = Batches().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -7,7 +7,26 @@ import "testing"
 
 // TestToOne tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToOne(t *testing.T) {}
+func TestToOne(t *testing.T) {
+	t.Run("BatchToLedgerUsingLedger", testBatchToOneLedgerUsingLedger)
+	t.Run("BudgetToCategoryUsingCategory", testBudgetToOneCategoryUsingCategory)
+	t.Run("BudgetToLedgerUsingLedger", testBudgetToOneLedgerUsingLedger)
+	t.Run("ChatToLedgerUsingLedger", testChatToOneLedgerUsingLedger)
+	t.Run("CounterpartyToLedgerUsingLedger", testCounterpartyToOneLedgerUsingLedger)
+	t.Run("GoalToLedgerUsingLedger", testGoalToOneLedgerUsingLedger)
+	t.Run("MemberToLedgerUsingLedger", testMemberToOneLedgerUsingLedger)
+	t.Run("MessageToBatchUsingBatch", testMessageToOneBatchUsingBatch)
+	t.Run("RecurringTransactionToLedgerUsingLedger", testRecurringTransactionToOneLedgerUsingLedger)
+	t.Run("RecurringTransactionToCategoryUsingCategory", testRecurringTransactionToOneCategoryUsingCategory)
+	t.Run("RecurringTransactionToAccountUsingAccount", testRecurringTransactionToOneAccountUsingAccount)
+	t.Run("TransactionToReceiptUsingReceipt", testTransactionToOneReceiptUsingReceipt)
+	t.Run("TransactionToCounterpartyUsingCounterparty", testTransactionToOneCounterpartyUsingCounterparty)
+	t.Run("TransactionToGoalUsingGoal", testTransactionToOneGoalUsingGoal)
+	t.Run("TransactionToLedgerUsingLedger", testTransactionToOneLedgerUsingLedger)
+	t.Run("TransactionToBatchUsingBatch", testTransactionToOneBatchUsingBatch)
+	t.Run("TransactionToAccountUsingAccount", testTransactionToOneAccountUsingAccount)
+	t.Run("TransactionToCategoryUsingCategory", testTransactionToOneCategoryUsingCategory)
+}
 
 // TestOneToOne tests cannot be run in parallel
 // or deadlocks can occur.
@@ -15,15 +34,61 @@ func TestOneToOne(t *testing.T) {}
 
 // TestToMany tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToMany(t *testing.T) {}
+func TestToMany(t *testing.T) {
+	t.Run("AccountToRecurringTransactions", testAccountToManyRecurringTransactions)
+	t.Run("AccountToTransactions", testAccountToManyTransactions)
+	t.Run("BatchToMessages", testBatchToManyMessages)
+	t.Run("BatchToTransactions", testBatchToManyTransactions)
+	t.Run("CategoryToBudgets", testCategoryToManyBudgets)
+	t.Run("CategoryToRecurringTransactions", testCategoryToManyRecurringTransactions)
+	t.Run("CategoryToTransactions", testCategoryToManyTransactions)
+	t.Run("CounterpartyToTransactions", testCounterpartyToManyTransactions)
+	t.Run("GoalToTransactions", testGoalToManyTransactions)
+	t.Run("LedgerToBatches", testLedgerToManyBatches)
+	t.Run("LedgerToBudgets", testLedgerToManyBudgets)
+	t.Run("LedgerToChats", testLedgerToManyChats)
+	t.Run("LedgerToCounterparties", testLedgerToManyCounterparties)
+	t.Run("LedgerToGoals", testLedgerToManyGoals)
+	t.Run("LedgerToMembers", testLedgerToManyMembers)
+	t.Run("LedgerToRecurringTransactions", testLedgerToManyRecurringTransactions)
+	t.Run("LedgerToTransactions", testLedgerToManyTransactions)
+	t.Run("ReceiptToTransactions", testReceiptToManyTransactions)
+}
 
 // TestToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToOneSet(t *testing.T) {}
+func TestToOneSet(t *testing.T) {
+	t.Run("BatchToLedgerUsingBatches", testBatchToOneSetOpLedgerUsingLedger)
+	t.Run("BudgetToCategoryUsingBudgets", testBudgetToOneSetOpCategoryUsingCategory)
+	t.Run("BudgetToLedgerUsingBudgets", testBudgetToOneSetOpLedgerUsingLedger)
+	t.Run("ChatToLedgerUsingChats", testChatToOneSetOpLedgerUsingLedger)
+	t.Run("CounterpartyToLedgerUsingCounterparties", testCounterpartyToOneSetOpLedgerUsingLedger)
+	t.Run("GoalToLedgerUsingGoals", testGoalToOneSetOpLedgerUsingLedger)
+	t.Run("MemberToLedgerUsingMembers", testMemberToOneSetOpLedgerUsingLedger)
+	t.Run("MessageToBatchUsingMessages", testMessageToOneSetOpBatchUsingBatch)
+	t.Run("RecurringTransactionToLedgerUsingRecurringTransactions", testRecurringTransactionToOneSetOpLedgerUsingLedger)
+	t.Run("RecurringTransactionToCategoryUsingRecurringTransactions", testRecurringTransactionToOneSetOpCategoryUsingCategory)
+	t.Run("RecurringTransactionToAccountUsingRecurringTransactions", testRecurringTransactionToOneSetOpAccountUsingAccount)
+	t.Run("TransactionToReceiptUsingTransactions", testTransactionToOneSetOpReceiptUsingReceipt)
+	t.Run("TransactionToCounterpartyUsingTransactions", testTransactionToOneSetOpCounterpartyUsingCounterparty)
+	t.Run("TransactionToGoalUsingTransactions", testTransactionToOneSetOpGoalUsingGoal)
+	t.Run("TransactionToLedgerUsingTransactions", testTransactionToOneSetOpLedgerUsingLedger)
+	t.Run("TransactionToBatchUsingTransactions", testTransactionToOneSetOpBatchUsingBatch)
+	t.Run("TransactionToAccountUsingTransactions", testTransactionToOneSetOpAccountUsingAccount)
+	t.Run("TransactionToCategoryUsingTransactions", testTransactionToOneSetOpCategoryUsingCategory)
+}
 
 // TestToOneRemove tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToOneRemove(t *testing.T) {}
+func TestToOneRemove(t *testing.T) {
+	t.Run("BatchToLedgerUsingBatches", testBatchToOneRemoveOpLedgerUsingLedger)
+	t.Run("RecurringTransactionToCategoryUsingRecurringTransactions", testRecurringTransactionToOneRemoveOpCategoryUsingCategory)
+	t.Run("TransactionToReceiptUsingTransactions", testTransactionToOneRemoveOpReceiptUsingReceipt)
+	t.Run("TransactionToCounterpartyUsingTransactions", testTransactionToOneRemoveOpCounterpartyUsingCounterparty)
+	t.Run("TransactionToGoalUsingTransactions", testTransactionToOneRemoveOpGoalUsingGoal)
+	t.Run("TransactionToBatchUsingTransactions", testTransactionToOneRemoveOpBatchUsingBatch)
+	t.Run("TransactionToCategoryUsingTransactions", testTransactionToOneRemoveOpCategoryUsingCategory)
+}
 
 // TestOneToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
@@ -35,12 +100,47 @@ func TestOneToOneRemove(t *testing.T) {}
 
 // TestToManyAdd tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToManyAdd(t *testing.T) {}
+func TestToManyAdd(t *testing.T) {
+	t.Run("AccountToRecurringTransactions", testAccountToManyAddOpRecurringTransactions)
+	t.Run("AccountToTransactions", testAccountToManyAddOpTransactions)
+	t.Run("BatchToMessages", testBatchToManyAddOpMessages)
+	t.Run("BatchToTransactions", testBatchToManyAddOpTransactions)
+	t.Run("CategoryToBudgets", testCategoryToManyAddOpBudgets)
+	t.Run("CategoryToRecurringTransactions", testCategoryToManyAddOpRecurringTransactions)
+	t.Run("CategoryToTransactions", testCategoryToManyAddOpTransactions)
+	t.Run("CounterpartyToTransactions", testCounterpartyToManyAddOpTransactions)
+	t.Run("GoalToTransactions", testGoalToManyAddOpTransactions)
+	t.Run("LedgerToBatches", testLedgerToManyAddOpBatches)
+	t.Run("LedgerToBudgets", testLedgerToManyAddOpBudgets)
+	t.Run("LedgerToChats", testLedgerToManyAddOpChats)
+	t.Run("LedgerToCounterparties", testLedgerToManyAddOpCounterparties)
+	t.Run("LedgerToGoals", testLedgerToManyAddOpGoals)
+	t.Run("LedgerToMembers", testLedgerToManyAddOpMembers)
+	t.Run("LedgerToRecurringTransactions", testLedgerToManyAddOpRecurringTransactions)
+	t.Run("LedgerToTransactions", testLedgerToManyAddOpTransactions)
+	t.Run("ReceiptToTransactions", testReceiptToManyAddOpTransactions)
+}
 
 // TestToManySet tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToManySet(t *testing.T) {}
+func TestToManySet(t *testing.T) {
+	t.Run("BatchToTransactions", testBatchToManySetOpTransactions)
+	t.Run("CategoryToRecurringTransactions", testCategoryToManySetOpRecurringTransactions)
+	t.Run("CategoryToTransactions", testCategoryToManySetOpTransactions)
+	t.Run("CounterpartyToTransactions", testCounterpartyToManySetOpTransactions)
+	t.Run("GoalToTransactions", testGoalToManySetOpTransactions)
+	t.Run("LedgerToBatches", testLedgerToManySetOpBatches)
+	t.Run("ReceiptToTransactions", testReceiptToManySetOpTransactions)
+}
 
 // TestToManyRemove tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToManyRemove(t *testing.T) {}
+func TestToManyRemove(t *testing.T) {
+	t.Run("BatchToTransactions", testBatchToManyRemoveOpTransactions)
+	t.Run("CategoryToRecurringTransactions", testCategoryToManyRemoveOpRecurringTransactions)
+	t.Run("CategoryToTransactions", testCategoryToManyRemoveOpTransactions)
+	t.Run("CounterpartyToTransactions", testCounterpartyToManyRemoveOpTransactions)
+	t.Run("GoalToTransactions", testGoalToManyRemoveOpTransactions)
+	t.Run("LedgerToBatches", testLedgerToManyRemoveOpBatches)
+	t.Run("ReceiptToTransactions", testReceiptToManyRemoveOpTransactions)
+}
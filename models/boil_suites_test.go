@@ -12,70 +12,322 @@ import "testing"
 // It does NOT run each operation group in parallel.
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
+	t.Run("Accounts", testAccounts)
+	t.Run("Batches", testBatches)
+	t.Run("Budgets", testBudgets)
+	t.Run("Categories", testCategories)
+	t.Run("Chats", testChats)
+	t.Run("Counterparties", testCounterparties)
+	t.Run("Goals", testGoals)
+	t.Run("Ledgers", testLedgers)
+	t.Run("Members", testMembers)
+	t.Run("Messages", testMessages)
+	t.Run("ProcessedMessages", testProcessedMessages)
+	t.Run("Receipts", testReceipts)
+	t.Run("RecurringTransactions", testRecurringTransactions)
+	t.Run("Summaries", testSummaries)
 	t.Run("Transactions", testTransactions)
 }
 
 func TestDelete(t *testing.T) {
+	t.Run("Accounts", testAccountsDelete)
+	t.Run("Batches", testBatchesDelete)
+	t.Run("Budgets", testBudgetsDelete)
+	t.Run("Categories", testCategoriesDelete)
+	t.Run("Chats", testChatsDelete)
+	t.Run("Counterparties", testCounterpartiesDelete)
+	t.Run("Goals", testGoalsDelete)
+	t.Run("Ledgers", testLedgersDelete)
+	t.Run("Members", testMembersDelete)
+	t.Run("Messages", testMessagesDelete)
+	t.Run("ProcessedMessages", testProcessedMessagesDelete)
+	t.Run("Receipts", testReceiptsDelete)
+	t.Run("RecurringTransactions", testRecurringTransactionsDelete)
+	t.Run("Summaries", testSummariesDelete)
 	t.Run("Transactions", testTransactionsDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
+	t.Run("Accounts", testAccountsQueryDeleteAll)
+	t.Run("Batches", testBatchesQueryDeleteAll)
+	t.Run("Budgets", testBudgetsQueryDeleteAll)
+	t.Run("Categories", testCategoriesQueryDeleteAll)
+	t.Run("Chats", testChatsQueryDeleteAll)
+	t.Run("Counterparties", testCounterpartiesQueryDeleteAll)
+	t.Run("Goals", testGoalsQueryDeleteAll)
+	t.Run("Ledgers", testLedgersQueryDeleteAll)
+	t.Run("Members", testMembersQueryDeleteAll)
+	t.Run("Messages", testMessagesQueryDeleteAll)
+	t.Run("ProcessedMessages", testProcessedMessagesQueryDeleteAll)
+	t.Run("Receipts", testReceiptsQueryDeleteAll)
+	t.Run("RecurringTransactions", testRecurringTransactionsQueryDeleteAll)
+	t.Run("Summaries", testSummariesQueryDeleteAll)
 	t.Run("Transactions", testTransactionsQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
+	t.Run("Accounts", testAccountsSliceDeleteAll)
+	t.Run("Batches", testBatchesSliceDeleteAll)
+	t.Run("Budgets", testBudgetsSliceDeleteAll)
+	t.Run("Categories", testCategoriesSliceDeleteAll)
+	t.Run("Chats", testChatsSliceDeleteAll)
+	t.Run("Counterparties", testCounterpartiesSliceDeleteAll)
+	t.Run("Goals", testGoalsSliceDeleteAll)
+	t.Run("Ledgers", testLedgersSliceDeleteAll)
+	t.Run("Members", testMembersSliceDeleteAll)
+	t.Run("Messages", testMessagesSliceDeleteAll)
+	t.Run("ProcessedMessages", testProcessedMessagesSliceDeleteAll)
+	t.Run("Receipts", testReceiptsSliceDeleteAll)
+	t.Run("RecurringTransactions", testRecurringTransactionsSliceDeleteAll)
+	t.Run("Summaries", testSummariesSliceDeleteAll)
 	t.Run("Transactions", testTransactionsSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
+	t.Run("Accounts", testAccountsExists)
+	t.Run("Batches", testBatchesExists)
+	t.Run("Budgets", testBudgetsExists)
+	t.Run("Categories", testCategoriesExists)
+	t.Run("Chats", testChatsExists)
+	t.Run("Counterparties", testCounterpartiesExists)
+	t.Run("Goals", testGoalsExists)
+	t.Run("Ledgers", testLedgersExists)
+	t.Run("Members", testMembersExists)
+	t.Run("Messages", testMessagesExists)
+	t.Run("ProcessedMessages", testProcessedMessagesExists)
+	t.Run("Receipts", testReceiptsExists)
+	t.Run("RecurringTransactions", testRecurringTransactionsExists)
+	t.Run("Summaries", testSummariesExists)
 	t.Run("Transactions", testTransactionsExists)
 }
 
 func TestFind(t *testing.T) {
+	t.Run("Accounts", testAccountsFind)
+	t.Run("Batches", testBatchesFind)
+	t.Run("Budgets", testBudgetsFind)
+	t.Run("Categories", testCategoriesFind)
+	t.Run("Chats", testChatsFind)
+	t.Run("Counterparties", testCounterpartiesFind)
+	t.Run("Goals", testGoalsFind)
+	t.Run("Ledgers", testLedgersFind)
+	t.Run("Members", testMembersFind)
+	t.Run("Messages", testMessagesFind)
+	t.Run("ProcessedMessages", testProcessedMessagesFind)
+	t.Run("Receipts", testReceiptsFind)
+	t.Run("RecurringTransactions", testRecurringTransactionsFind)
+	t.Run("Summaries", testSummariesFind)
 	t.Run("Transactions", testTransactionsFind)
 }
 
 func TestBind(t *testing.T) {
+	t.Run("Accounts", testAccountsBind)
+	t.Run("Batches", testBatchesBind)
+	t.Run("Budgets", testBudgetsBind)
+	t.Run("Categories", testCategoriesBind)
+	t.Run("Chats", testChatsBind)
+	t.Run("Counterparties", testCounterpartiesBind)
+	t.Run("Goals", testGoalsBind)
+	t.Run("Ledgers", testLedgersBind)
+	t.Run("Members", testMembersBind)
+	t.Run("Messages", testMessagesBind)
+	t.Run("ProcessedMessages", testProcessedMessagesBind)
+	t.Run("Receipts", testReceiptsBind)
+	t.Run("RecurringTransactions", testRecurringTransactionsBind)
+	t.Run("Summaries", testSummariesBind)
 	t.Run("Transactions", testTransactionsBind)
 }
 
 func TestOne(t *testing.T) {
+	t.Run("Accounts", testAccountsOne)
+	t.Run("Batches", testBatchesOne)
+	t.Run("Budgets", testBudgetsOne)
+	t.Run("Categories", testCategoriesOne)
+	t.Run("Chats", testChatsOne)
+	t.Run("Counterparties", testCounterpartiesOne)
+	t.Run("Goals", testGoalsOne)
+	t.Run("Ledgers", testLedgersOne)
+	t.Run("Members", testMembersOne)
+	t.Run("Messages", testMessagesOne)
+	t.Run("ProcessedMessages", testProcessedMessagesOne)
+	t.Run("Receipts", testReceiptsOne)
+	t.Run("RecurringTransactions", testRecurringTransactionsOne)
+	t.Run("Summaries", testSummariesOne)
 	t.Run("Transactions", testTransactionsOne)
 }
 
 func TestAll(t *testing.T) {
+	t.Run("Accounts", testAccountsAll)
+	t.Run("Batches", testBatchesAll)
+	t.Run("Budgets", testBudgetsAll)
+	t.Run("Categories", testCategoriesAll)
+	t.Run("Chats", testChatsAll)
+	t.Run("Counterparties", testCounterpartiesAll)
+	t.Run("Goals", testGoalsAll)
+	t.Run("Ledgers", testLedgersAll)
+	t.Run("Members", testMembersAll)
+	t.Run("Messages", testMessagesAll)
+	t.Run("ProcessedMessages", testProcessedMessagesAll)
+	t.Run("Receipts", testReceiptsAll)
+	t.Run("RecurringTransactions", testRecurringTransactionsAll)
+	t.Run("Summaries", testSummariesAll)
 	t.Run("Transactions", testTransactionsAll)
 }
 
 func TestCount(t *testing.T) {
+	t.Run("Accounts", testAccountsCount)
+	t.Run("Batches", testBatchesCount)
+	t.Run("Budgets", testBudgetsCount)
+	t.Run("Categories", testCategoriesCount)
+	t.Run("Chats", testChatsCount)
+	t.Run("Counterparties", testCounterpartiesCount)
+	t.Run("Goals", testGoalsCount)
+	t.Run("Ledgers", testLedgersCount)
+	t.Run("Members", testMembersCount)
+	t.Run("Messages", testMessagesCount)
+	t.Run("ProcessedMessages", testProcessedMessagesCount)
+	t.Run("Receipts", testReceiptsCount)
+	t.Run("RecurringTransactions", testRecurringTransactionsCount)
+	t.Run("Summaries", testSummariesCount)
 	t.Run("Transactions", testTransactionsCount)
 }
 
 func TestHooks(t *testing.T) {
+	t.Run("Accounts", testAccountsHooks)
+	t.Run("Batches", testBatchesHooks)
+	t.Run("Budgets", testBudgetsHooks)
+	t.Run("Categories", testCategoriesHooks)
+	t.Run("Chats", testChatsHooks)
+	t.Run("Counterparties", testCounterpartiesHooks)
+	t.Run("Goals", testGoalsHooks)
+	t.Run("Ledgers", testLedgersHooks)
+	t.Run("Members", testMembersHooks)
+	t.Run("Messages", testMessagesHooks)
+	t.Run("ProcessedMessages", testProcessedMessagesHooks)
+	t.Run("Receipts", testReceiptsHooks)
+	t.Run("RecurringTransactions", testRecurringTransactionsHooks)
+	t.Run("Summaries", testSummariesHooks)
 	t.Run("Transactions", testTransactionsHooks)
 }
 
 func TestInsert(t *testing.T) {
+	t.Run("Accounts", testAccountsInsert)
+	t.Run("Accounts", testAccountsInsertWhitelist)
+	t.Run("Batches", testBatchesInsert)
+	t.Run("Batches", testBatchesInsertWhitelist)
+	t.Run("Budgets", testBudgetsInsert)
+	t.Run("Budgets", testBudgetsInsertWhitelist)
+	t.Run("Categories", testCategoriesInsert)
+	t.Run("Categories", testCategoriesInsertWhitelist)
+	t.Run("Chats", testChatsInsert)
+	t.Run("Chats", testChatsInsertWhitelist)
+	t.Run("Counterparties", testCounterpartiesInsert)
+	t.Run("Counterparties", testCounterpartiesInsertWhitelist)
+	t.Run("Goals", testGoalsInsert)
+	t.Run("Goals", testGoalsInsertWhitelist)
+	t.Run("Ledgers", testLedgersInsert)
+	t.Run("Ledgers", testLedgersInsertWhitelist)
+	t.Run("Members", testMembersInsert)
+	t.Run("Members", testMembersInsertWhitelist)
+	t.Run("Messages", testMessagesInsert)
+	t.Run("Messages", testMessagesInsertWhitelist)
+	t.Run("ProcessedMessages", testProcessedMessagesInsert)
+	t.Run("ProcessedMessages", testProcessedMessagesInsertWhitelist)
+	t.Run("Receipts", testReceiptsInsert)
+	t.Run("Receipts", testReceiptsInsertWhitelist)
+	t.Run("RecurringTransactions", testRecurringTransactionsInsert)
+	t.Run("RecurringTransactions", testRecurringTransactionsInsertWhitelist)
+	t.Run("Summaries", testSummariesInsert)
+	t.Run("Summaries", testSummariesInsertWhitelist)
 	t.Run("Transactions", testTransactionsInsert)
 	t.Run("Transactions", testTransactionsInsertWhitelist)
 }
 
 func TestReload(t *testing.T) {
+	t.Run("Accounts", testAccountsReload)
+	t.Run("Batches", testBatchesReload)
+	t.Run("Budgets", testBudgetsReload)
+	t.Run("Categories", testCategoriesReload)
+	t.Run("Chats", testChatsReload)
+	t.Run("Counterparties", testCounterpartiesReload)
+	t.Run("Goals", testGoalsReload)
+	t.Run("Ledgers", testLedgersReload)
+	t.Run("Members", testMembersReload)
+	t.Run("Messages", testMessagesReload)
+	t.Run("ProcessedMessages", testProcessedMessagesReload)
+	t.Run("Receipts", testReceiptsReload)
+	t.Run("RecurringTransactions", testRecurringTransactionsReload)
+	t.Run("Summaries", testSummariesReload)
 	t.Run("Transactions", testTransactionsReload)
 }
 
 func TestReloadAll(t *testing.T) {
+	t.Run("Accounts", testAccountsReloadAll)
+	t.Run("Batches", testBatchesReloadAll)
+	t.Run("Budgets", testBudgetsReloadAll)
+	t.Run("Categories", testCategoriesReloadAll)
+	t.Run("Chats", testChatsReloadAll)
+	t.Run("Counterparties", testCounterpartiesReloadAll)
+	t.Run("Goals", testGoalsReloadAll)
+	t.Run("Ledgers", testLedgersReloadAll)
+	t.Run("Members", testMembersReloadAll)
+	t.Run("Messages", testMessagesReloadAll)
+	t.Run("ProcessedMessages", testProcessedMessagesReloadAll)
+	t.Run("Receipts", testReceiptsReloadAll)
+	t.Run("RecurringTransactions", testRecurringTransactionsReloadAll)
+	t.Run("Summaries", testSummariesReloadAll)
 	t.Run("Transactions", testTransactionsReloadAll)
 }
 
 func TestSelect(t *testing.T) {
+	t.Run("Accounts", testAccountsSelect)
+	t.Run("Batches", testBatchesSelect)
+	t.Run("Budgets", testBudgetsSelect)
+	t.Run("Categories", testCategoriesSelect)
+	t.Run("Chats", testChatsSelect)
+	t.Run("Counterparties", testCounterpartiesSelect)
+	t.Run("Goals", testGoalsSelect)
+	t.Run("Ledgers", testLedgersSelect)
+	t.Run("Members", testMembersSelect)
+	t.Run("Messages", testMessagesSelect)
+	t.Run("ProcessedMessages", testProcessedMessagesSelect)
+	t.Run("Receipts", testReceiptsSelect)
+	t.Run("RecurringTransactions", testRecurringTransactionsSelect)
+	t.Run("Summaries", testSummariesSelect)
 	t.Run("Transactions", testTransactionsSelect)
 }
 
 func TestUpdate(t *testing.T) {
+	t.Run("Accounts", testAccountsUpdate)
+	t.Run("Batches", testBatchesUpdate)
+	t.Run("Budgets", testBudgetsUpdate)
+	t.Run("Categories", testCategoriesUpdate)
+	t.Run("Chats", testChatsUpdate)
+	t.Run("Counterparties", testCounterpartiesUpdate)
+	t.Run("Goals", testGoalsUpdate)
+	t.Run("Ledgers", testLedgersUpdate)
+	t.Run("Members", testMembersUpdate)
+	t.Run("Messages", testMessagesUpdate)
+	t.Run("ProcessedMessages", testProcessedMessagesUpdate)
+	t.Run("Receipts", testReceiptsUpdate)
+	t.Run("RecurringTransactions", testRecurringTransactionsUpdate)
+	t.Run("Summaries", testSummariesUpdate)
 	t.Run("Transactions", testTransactionsUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
+	t.Run("Accounts", testAccountsSliceUpdateAll)
+	t.Run("Batches", testBatchesSliceUpdateAll)
+	t.Run("Budgets", testBudgetsSliceUpdateAll)
+	t.Run("Categories", testCategoriesSliceUpdateAll)
+	t.Run("Chats", testChatsSliceUpdateAll)
+	t.Run("Counterparties", testCounterpartiesSliceUpdateAll)
+	t.Run("Goals", testGoalsSliceUpdateAll)
+	t.Run("Ledgers", testLedgersSliceUpdateAll)
+	t.Run("Members", testMembersSliceUpdateAll)
+	t.Run("Messages", testMessagesSliceUpdateAll)
+	t.Run("ProcessedMessages", testProcessedMessagesSliceUpdateAll)
+	t.Run("Receipts", testReceiptsSliceUpdateAll)
+	t.Run("RecurringTransactions", testRecurringTransactionsSliceUpdateAll)
+	t.Run("Summaries", testSummariesSliceUpdateAll)
 	t.Run("Transactions", testTransactionsSliceUpdateAll)
 }
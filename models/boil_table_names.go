@@ -4,7 +4,35 @@
 package models
 
 var TableNames = struct {
-	Transactions string
+	Accounts              string
+	Batches               string
+	Budgets               string
+	Categories            string
+	Chats                 string
+	Counterparties        string
+	Goals                 string
+	Ledgers               string
+	Members               string
+	Messages              string
+	ProcessedMessages     string
+	Receipts              string
+	RecurringTransactions string
+	Summaries             string
+	Transactions          string
 }{
-	Transactions: "transactions",
+	Accounts:              "accounts",
+	Batches:               "batches",
+	Budgets:               "budgets",
+	Categories:            "categories",
+	Chats:                 "chats",
+	Counterparties:        "counterparties",
+	Goals:                 "goals",
+	Ledgers:               "ledgers",
+	Members:               "members",
+	Messages:              "messages",
+	ProcessedMessages:     "processed_messages",
+	Receipts:              "receipts",
+	RecurringTransactions: "recurring_transactions",
+	Summaries:             "summaries",
+	Transactions:          "transactions",
 }
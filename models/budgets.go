@@ -0,0 +1,1404 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// Budget is an object representing the database table.
+type Budget struct {
+	ID         null.Int64 `boil:"id" json:"id,omitempty" toml:"id" yaml:"id,omitempty"`
+	LedgerID   int64      `boil:"ledger_id" json:"ledger_id" toml:"ledger_id" yaml:"ledger_id"`
+	CategoryID int64      `boil:"category_id" json:"category_id" toml:"category_id" yaml:"category_id"`
+	Amount     int64      `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	CreatedAt  time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt  time.Time  `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *budgetR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L budgetL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var BudgetColumns = struct {
+	ID         string
+	LedgerID   string
+	CategoryID string
+	Amount     string
+	CreatedAt  string
+	UpdatedAt  string
+}{
+	ID:         "id",
+	LedgerID:   "ledger_id",
+	CategoryID: "category_id",
+	Amount:     "amount",
+	CreatedAt:  "created_at",
+	UpdatedAt:  "updated_at",
+}
+
+var BudgetTableColumns = struct {
+	ID         string
+	LedgerID   string
+	CategoryID string
+	Amount     string
+	CreatedAt  string
+	UpdatedAt  string
+}{
+	ID:         "budgets.id",
+	LedgerID:   "budgets.ledger_id",
+	CategoryID: "budgets.category_id",
+	Amount:     "budgets.amount",
+	CreatedAt:  "budgets.created_at",
+	UpdatedAt:  "budgets.updated_at",
+}
+
+// Generated where
+
+type whereHelperint64 struct{ field string }
+
+func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var BudgetWhere = struct {
+	ID         whereHelpernull_Int64
+	LedgerID   whereHelperint64
+	CategoryID whereHelperint64
+	Amount     whereHelperint64
+	CreatedAt  whereHelpertime_Time
+	UpdatedAt  whereHelpertime_Time
+}{
+	ID:         whereHelpernull_Int64{field: "\"budgets\".\"id\""},
+	LedgerID:   whereHelperint64{field: "\"budgets\".\"ledger_id\""},
+	CategoryID: whereHelperint64{field: "\"budgets\".\"category_id\""},
+	Amount:     whereHelperint64{field: "\"budgets\".\"amount\""},
+	CreatedAt:  whereHelpertime_Time{field: "\"budgets\".\"created_at\""},
+	UpdatedAt:  whereHelpertime_Time{field: "\"budgets\".\"updated_at\""},
+}
+
+// BudgetRels is where relationship names are stored.
+var BudgetRels = struct {
+	Category string
+	Ledger   string
+}{
+	Category: "Category",
+	Ledger:   "Ledger",
+}
+
+// budgetR is where relationships are stored.
+type budgetR struct {
+	Category *Category `boil:"Category" json:"Category" toml:"Category" yaml:"Category"`
+	Ledger   *Ledger   `boil:"Ledger" json:"Ledger" toml:"Ledger" yaml:"Ledger"`
+}
+
+// NewStruct creates a new relationship struct
+func (*budgetR) NewStruct() *budgetR {
+	return &budgetR{}
+}
+
+func (o *Budget) GetCategory() *Category {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCategory()
+}
+
+func (r *budgetR) GetCategory() *Category {
+	if r == nil {
+		return nil
+	}
+
+	return r.Category
+}
+
+func (o *Budget) GetLedger() *Ledger {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetLedger()
+}
+
+func (r *budgetR) GetLedger() *Ledger {
+	if r == nil {
+		return nil
+	}
+
+	return r.Ledger
+}
+
+// budgetL is where Load methods for each relationship are stored.
+type budgetL struct{}
+
+var (
+	budgetAllColumns            = []string{"id", "ledger_id", "category_id", "amount", "created_at", "updated_at"}
+	budgetColumnsWithoutDefault = []string{"ledger_id", "category_id", "amount"}
+	budgetColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	budgetPrimaryKeyColumns     = []string{"id"}
+	budgetGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// BudgetSlice is an alias for a slice of pointers to Budget.
+	// This should almost always be used instead of []Budget.
+	BudgetSlice []*Budget
+	// BudgetHook is the signature for custom Budget hook methods
+	BudgetHook func(context.Context, boil.ContextExecutor, *Budget) error
+
+	budgetQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	budgetType                 = reflect.TypeOf(&Budget{})
+	budgetMapping              = queries.MakeStructMapping(budgetType)
+	budgetPrimaryKeyMapping, _ = queries.BindMapping(budgetType, budgetMapping, budgetPrimaryKeyColumns)
+	budgetInsertCacheMut       sync.RWMutex
+	budgetInsertCache          = make(map[string]insertCache)
+	budgetUpdateCacheMut       sync.RWMutex
+	budgetUpdateCache          = make(map[string]updateCache)
+	budgetUpsertCacheMut       sync.RWMutex
+	budgetUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var budgetAfterSelectMu sync.Mutex
+var budgetAfterSelectHooks []BudgetHook
+
+var budgetBeforeInsertMu sync.Mutex
+var budgetBeforeInsertHooks []BudgetHook
+var budgetAfterInsertMu sync.Mutex
+var budgetAfterInsertHooks []BudgetHook
+
+var budgetBeforeUpdateMu sync.Mutex
+var budgetBeforeUpdateHooks []BudgetHook
+var budgetAfterUpdateMu sync.Mutex
+var budgetAfterUpdateHooks []BudgetHook
+
+var budgetBeforeDeleteMu sync.Mutex
+var budgetBeforeDeleteHooks []BudgetHook
+var budgetAfterDeleteMu sync.Mutex
+var budgetAfterDeleteHooks []BudgetHook
+
+var budgetBeforeUpsertMu sync.Mutex
+var budgetBeforeUpsertHooks []BudgetHook
+var budgetAfterUpsertMu sync.Mutex
+var budgetAfterUpsertHooks []BudgetHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Budget) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range budgetAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Budget) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range budgetBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Budget) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range budgetAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Budget) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range budgetBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Budget) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range budgetAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Budget) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range budgetBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Budget) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range budgetAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Budget) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range budgetBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Budget) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range budgetAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddBudgetHook registers your hook function for all future operations.
+func AddBudgetHook(hookPoint boil.HookPoint, budgetHook BudgetHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		budgetAfterSelectMu.Lock()
+		budgetAfterSelectHooks = append(budgetAfterSelectHooks, budgetHook)
+		budgetAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		budgetBeforeInsertMu.Lock()
+		budgetBeforeInsertHooks = append(budgetBeforeInsertHooks, budgetHook)
+		budgetBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		budgetAfterInsertMu.Lock()
+		budgetAfterInsertHooks = append(budgetAfterInsertHooks, budgetHook)
+		budgetAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		budgetBeforeUpdateMu.Lock()
+		budgetBeforeUpdateHooks = append(budgetBeforeUpdateHooks, budgetHook)
+		budgetBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		budgetAfterUpdateMu.Lock()
+		budgetAfterUpdateHooks = append(budgetAfterUpdateHooks, budgetHook)
+		budgetAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		budgetBeforeDeleteMu.Lock()
+		budgetBeforeDeleteHooks = append(budgetBeforeDeleteHooks, budgetHook)
+		budgetBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		budgetAfterDeleteMu.Lock()
+		budgetAfterDeleteHooks = append(budgetAfterDeleteHooks, budgetHook)
+		budgetAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		budgetBeforeUpsertMu.Lock()
+		budgetBeforeUpsertHooks = append(budgetBeforeUpsertHooks, budgetHook)
+		budgetBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		budgetAfterUpsertMu.Lock()
+		budgetAfterUpsertHooks = append(budgetAfterUpsertHooks, budgetHook)
+		budgetAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single budget record from the query.
+func (q budgetQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Budget, error) {
+	o := &Budget{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for budgets")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Budget records from the query.
+func (q budgetQuery) All(ctx context.Context, exec boil.ContextExecutor) (BudgetSlice, error) {
+	var o []*Budget
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Budget slice")
+	}
+
+	if len(budgetAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Budget records in the query.
+func (q budgetQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count budgets rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q budgetQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if budgets exists")
+	}
+
+	return count > 0, nil
+}
+
+// Category pointed to by the foreign key.
+func (o *Budget) Category(mods ...qm.QueryMod) categoryQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CategoryID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Categories(queryMods...)
+}
+
+// Ledger pointed to by the foreign key.
+func (o *Budget) Ledger(mods ...qm.QueryMod) ledgerQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.LedgerID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Ledgers(queryMods...)
+}
+
+// LoadCategory allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (budgetL) LoadCategory(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBudget interface{}, mods queries.Applicator) error {
+	var slice []*Budget
+	var object *Budget
+
+	if singular {
+		var ok bool
+		object, ok = maybeBudget.(*Budget)
+		if !ok {
+			object = new(Budget)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBudget)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBudget))
+			}
+		}
+	} else {
+		s, ok := maybeBudget.(*[]*Budget)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBudget)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBudget))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &budgetR{}
+		}
+		if !queries.IsNil(object.CategoryID) {
+			args[object.CategoryID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &budgetR{}
+			}
+
+			if !queries.IsNil(obj.CategoryID) {
+				args[obj.CategoryID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`categories`),
+		qm.WhereIn(`categories.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Category")
+	}
+
+	var resultSlice []*Category
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Category")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for categories")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for categories")
+	}
+
+	if len(categoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Category = foreign
+		if foreign.R == nil {
+			foreign.R = &categoryR{}
+		}
+		foreign.R.Budgets = append(foreign.R.Budgets, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.CategoryID, foreign.ID) {
+				local.R.Category = foreign
+				if foreign.R == nil {
+					foreign.R = &categoryR{}
+				}
+				foreign.R.Budgets = append(foreign.R.Budgets, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadLedger allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (budgetL) LoadLedger(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBudget interface{}, mods queries.Applicator) error {
+	var slice []*Budget
+	var object *Budget
+
+	if singular {
+		var ok bool
+		object, ok = maybeBudget.(*Budget)
+		if !ok {
+			object = new(Budget)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBudget)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBudget))
+			}
+		}
+	} else {
+		s, ok := maybeBudget.(*[]*Budget)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBudget)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBudget))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &budgetR{}
+		}
+		if !queries.IsNil(object.LedgerID) {
+			args[object.LedgerID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &budgetR{}
+			}
+
+			if !queries.IsNil(obj.LedgerID) {
+				args[obj.LedgerID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`ledgers`),
+		qm.WhereIn(`ledgers.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Ledger")
+	}
+
+	var resultSlice []*Ledger
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Ledger")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for ledgers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for ledgers")
+	}
+
+	if len(ledgerAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Ledger = foreign
+		if foreign.R == nil {
+			foreign.R = &ledgerR{}
+		}
+		foreign.R.Budgets = append(foreign.R.Budgets, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.LedgerID, foreign.ID) {
+				local.R.Ledger = foreign
+				if foreign.R == nil {
+					foreign.R = &ledgerR{}
+				}
+				foreign.R.Budgets = append(foreign.R.Budgets, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCategory of the budget to the related item.
+// Sets o.R.Category to related.
+// Adds o to related.R.Budgets.
+func (o *Budget) SetCategory(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Category) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"budgets\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"category_id"}),
+		strmangle.WhereClause("\"", "\"", 0, budgetPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.CategoryID, related.ID)
+	if o.R == nil {
+		o.R = &budgetR{
+			Category: related,
+		}
+	} else {
+		o.R.Category = related
+	}
+
+	if related.R == nil {
+		related.R = &categoryR{
+			Budgets: BudgetSlice{o},
+		}
+	} else {
+		related.R.Budgets = append(related.R.Budgets, o)
+	}
+
+	return nil
+}
+
+// SetLedger of the budget to the related item.
+// Sets o.R.Ledger to related.
+// Adds o to related.R.Budgets.
+func (o *Budget) SetLedger(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Ledger) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"budgets\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"ledger_id"}),
+		strmangle.WhereClause("\"", "\"", 0, budgetPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.LedgerID, related.ID)
+	if o.R == nil {
+		o.R = &budgetR{
+			Ledger: related,
+		}
+	} else {
+		o.R.Ledger = related
+	}
+
+	if related.R == nil {
+		related.R = &ledgerR{
+			Budgets: BudgetSlice{o},
+		}
+	} else {
+		related.R.Budgets = append(related.R.Budgets, o)
+	}
+
+	return nil
+}
+
+// Budgets retrieves all the records using an executor.
+func Budgets(mods ...qm.QueryMod) budgetQuery {
+	mods = append(mods, qm.From("\"budgets\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"budgets\".*"})
+	}
+
+	return budgetQuery{q}
+}
+
+// FindBudget retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindBudget(ctx context.Context, exec boil.ContextExecutor, iD null.Int64, selectCols ...string) (*Budget, error) {
+	budgetObj := &Budget{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"budgets\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, budgetObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from budgets")
+	}
+
+	if err = budgetObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return budgetObj, err
+	}
+
+	return budgetObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Budget) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no budgets provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(budgetColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	budgetInsertCacheMut.RLock()
+	cache, cached := budgetInsertCache[key]
+	budgetInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			budgetAllColumns,
+			budgetColumnsWithDefault,
+			budgetColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, budgetGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(budgetType, budgetMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(budgetType, budgetMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"budgets\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"budgets\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into budgets")
+	}
+
+	if !cached {
+		budgetInsertCacheMut.Lock()
+		budgetInsertCache[key] = cache
+		budgetInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Budget.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Budget) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	budgetUpdateCacheMut.RLock()
+	cache, cached := budgetUpdateCache[key]
+	budgetUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			budgetAllColumns,
+			budgetPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, budgetGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update budgets, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"budgets\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, budgetPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(budgetType, budgetMapping, append(wl, budgetPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update budgets row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for budgets")
+	}
+
+	if !cached {
+		budgetUpdateCacheMut.Lock()
+		budgetUpdateCache[key] = cache
+		budgetUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q budgetQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for budgets")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for budgets")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o BudgetSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), budgetPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"budgets\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, budgetPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in budget slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all budget")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Budget) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no budgets provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(budgetColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	budgetUpsertCacheMut.RLock()
+	cache, cached := budgetUpsertCache[key]
+	budgetUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			budgetAllColumns,
+			budgetColumnsWithDefault,
+			budgetColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			budgetAllColumns,
+			budgetPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert budgets, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(budgetAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(budgetPrimaryKeyColumns))
+			copy(conflict, budgetPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"budgets\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(budgetType, budgetMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(budgetType, budgetMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert budgets")
+	}
+
+	if !cached {
+		budgetUpsertCacheMut.Lock()
+		budgetUpsertCache[key] = cache
+		budgetUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Budget record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Budget) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Budget provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), budgetPrimaryKeyMapping)
+	sql := "DELETE FROM \"budgets\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from budgets")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for budgets")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q budgetQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no budgetQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from budgets")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for budgets")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o BudgetSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(budgetBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), budgetPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"budgets\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, budgetPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from budget slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for budgets")
+	}
+
+	if len(budgetAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Budget) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindBudget(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *BudgetSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := BudgetSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), budgetPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"budgets\".* FROM \"budgets\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, budgetPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in BudgetSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// BudgetExists checks if the Budget row exists.
+func BudgetExists(ctx context.Context, exec boil.ContextExecutor, iD null.Int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"budgets\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if budgets exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Budget row exists.
+func (o *Budget) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return BudgetExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,969 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testBudgets(t *testing.T) {
+	t.Parallel()
+
+	query := Budgets()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testBudgetsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Budget{}
+	if err = randomize.Struct(seed, o, budgetDBTypes, true, budgetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Budget struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Budgets().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testBudgetsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Budget{}
+	if err = randomize.Struct(seed, o, budgetDBTypes, true, budgetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Budget struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Budgets().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Budgets().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testBudgetsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Budget{}
+	if err = randomize.Struct(seed, o, budgetDBTypes, true, budgetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Budget struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := BudgetSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Budgets().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testBudgetsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Budget{}
+	if err = randomize.Struct(seed, o, budgetDBTypes, true, budgetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Budget struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := BudgetExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if Budget exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected BudgetExists to return true, but got false.")
+	}
+}
+
+func testBudgetsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Budget{}
+	if err = randomize.Struct(seed, o, budgetDBTypes, true, budgetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Budget struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	budgetFound, err := FindBudget(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if budgetFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testBudgetsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Budget{}
+	if err = randomize.Struct(seed, o, budgetDBTypes, true, budgetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Budget struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Budgets().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testBudgetsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Budget{}
+	if err = randomize.Struct(seed, o, budgetDBTypes, true, budgetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Budget struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Budgets().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testBudgetsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	budgetOne := &Budget{}
+	budgetTwo := &Budget{}
+	if err = randomize.Struct(seed, budgetOne, budgetDBTypes, false, budgetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Budget struct: %s", err)
+	}
+	if err = randomize.Struct(seed, budgetTwo, budgetDBTypes, false, budgetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Budget struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = budgetOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = budgetTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Budgets().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testBudgetsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	budgetOne := &Budget{}
+	budgetTwo := &Budget{}
+	if err = randomize.Struct(seed, budgetOne, budgetDBTypes, false, budgetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Budget struct: %s", err)
+	}
+	if err = randomize.Struct(seed, budgetTwo, budgetDBTypes, false, budgetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Budget struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = budgetOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = budgetTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Budgets().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func budgetBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *Budget) error {
+	*o = Budget{}
+	return nil
+}
+
+func budgetAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *Budget) error {
+	*o = Budget{}
+	return nil
+}
+
+func budgetAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *Budget) error {
+	*o = Budget{}
+	return nil
+}
+
+func budgetBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Budget) error {
+	*o = Budget{}
+	return nil
+}
+
+func budgetAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Budget) error {
+	*o = Budget{}
+	return nil
+}
+
+func budgetBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Budget) error {
+	*o = Budget{}
+	return nil
+}
+
+func budgetAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Budget) error {
+	*o = Budget{}
+	return nil
+}
+
+func budgetBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Budget) error {
+	*o = Budget{}
+	return nil
+}
+
+func budgetAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Budget) error {
+	*o = Budget{}
+	return nil
+}
+
+func testBudgetsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &Budget{}
+	o := &Budget{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, budgetDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize Budget object: %s", err)
+	}
+
+	AddBudgetHook(boil.BeforeInsertHook, budgetBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	budgetBeforeInsertHooks = []BudgetHook{}
+
+	AddBudgetHook(boil.AfterInsertHook, budgetAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	budgetAfterInsertHooks = []BudgetHook{}
+
+	AddBudgetHook(boil.AfterSelectHook, budgetAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	budgetAfterSelectHooks = []BudgetHook{}
+
+	AddBudgetHook(boil.BeforeUpdateHook, budgetBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	budgetBeforeUpdateHooks = []BudgetHook{}
+
+	AddBudgetHook(boil.AfterUpdateHook, budgetAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	budgetAfterUpdateHooks = []BudgetHook{}
+
+	AddBudgetHook(boil.BeforeDeleteHook, budgetBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	budgetBeforeDeleteHooks = []BudgetHook{}
+
+	AddBudgetHook(boil.AfterDeleteHook, budgetAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	budgetAfterDeleteHooks = []BudgetHook{}
+
+	AddBudgetHook(boil.BeforeUpsertHook, budgetBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	budgetBeforeUpsertHooks = []BudgetHook{}
+
+	AddBudgetHook(boil.AfterUpsertHook, budgetAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	budgetAfterUpsertHooks = []BudgetHook{}
+}
+
+func testBudgetsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Budget{}
+	if err = randomize.Struct(seed, o, budgetDBTypes, true, budgetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Budget struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Budgets().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testBudgetsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Budget{}
+	if err = randomize.Struct(seed, o, budgetDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Budget struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(budgetPrimaryKeyColumns, budgetColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Budgets().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testBudgetToOneCategoryUsingCategory(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Budget
+	var foreign Category
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, budgetDBTypes, false, budgetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Budget struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, categoryDBTypes, true, categoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Category struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.CategoryID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Category().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddCategoryHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Category) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := BudgetSlice{&local}
+	if err = local.L.LoadCategory(ctx, tx, false, (*[]*Budget)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Category == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Category = nil
+	if err = local.L.LoadCategory(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Category == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testBudgetToOneLedgerUsingLedger(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Budget
+	var foreign Ledger
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, budgetDBTypes, false, budgetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Budget struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, ledgerDBTypes, true, ledgerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Ledger struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.LedgerID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Ledger().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddLedgerHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Ledger) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := BudgetSlice{&local}
+	if err = local.L.LoadLedger(ctx, tx, false, (*[]*Budget)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Ledger == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Ledger = nil
+	if err = local.L.LoadLedger(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Ledger == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testBudgetToOneSetOpCategoryUsingCategory(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Budget
+	var b, c Category
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, budgetDBTypes, false, strmangle.SetComplement(budgetPrimaryKeyColumns, budgetColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, categoryDBTypes, false, strmangle.SetComplement(categoryPrimaryKeyColumns, categoryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, categoryDBTypes, false, strmangle.SetComplement(categoryPrimaryKeyColumns, categoryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Category{&b, &c} {
+		err = a.SetCategory(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Category != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Budgets[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.CategoryID, x.ID) {
+			t.Error("foreign key was wrong value", a.CategoryID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.CategoryID))
+		reflect.Indirect(reflect.ValueOf(&a.CategoryID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.CategoryID, x.ID) {
+			t.Error("foreign key was wrong value", a.CategoryID, x.ID)
+		}
+	}
+}
+func testBudgetToOneSetOpLedgerUsingLedger(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Budget
+	var b, c Ledger
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, budgetDBTypes, false, strmangle.SetComplement(budgetPrimaryKeyColumns, budgetColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, ledgerDBTypes, false, strmangle.SetComplement(ledgerPrimaryKeyColumns, ledgerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, ledgerDBTypes, false, strmangle.SetComplement(ledgerPrimaryKeyColumns, ledgerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Ledger{&b, &c} {
+		err = a.SetLedger(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Ledger != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Budgets[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.LedgerID, x.ID) {
+			t.Error("foreign key was wrong value", a.LedgerID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.LedgerID))
+		reflect.Indirect(reflect.ValueOf(&a.LedgerID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.LedgerID, x.ID) {
+			t.Error("foreign key was wrong value", a.LedgerID, x.ID)
+		}
+	}
+}
+
+func testBudgetsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Budget{}
+	if err = randomize.Struct(seed, o, budgetDBTypes, true, budgetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Budget struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testBudgetsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Budget{}
+	if err = randomize.Struct(seed, o, budgetDBTypes, true, budgetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Budget struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := BudgetSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testBudgetsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Budget{}
+	if err = randomize.Struct(seed, o, budgetDBTypes, true, budgetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Budget struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Budgets().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	budgetDBTypes = map[string]string{`ID`: `INTEGER`, `LedgerID`: `INTEGER`, `CategoryID`: `INTEGER`, `Amount`: `INTEGER`, `CreatedAt`: `DATETIME`, `UpdatedAt`: `DATETIME`}
+	_             = bytes.MinRead
+)
+
+func testBudgetsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(budgetPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(budgetAllColumns) == len(budgetPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Budget{}
+	if err = randomize.Struct(seed, o, budgetDBTypes, true, budgetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Budget struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Budgets().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, budgetDBTypes, true, budgetPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Budget struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testBudgetsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(budgetAllColumns) == len(budgetPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Budget{}
+	if err = randomize.Struct(seed, o, budgetDBTypes, true, budgetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Budget struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Budgets().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, budgetDBTypes, true, budgetPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Budget struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(budgetAllColumns, budgetPrimaryKeyColumns) {
+		fields = budgetAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			budgetAllColumns,
+			budgetPrimaryKeyColumns,
+		)
+		fields = strmangle.SetComplement(fields, budgetGeneratedColumns)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := BudgetSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testBudgetsUpsert(t *testing.T) {
+	t.Parallel()
+	if len(budgetAllColumns) == len(budgetPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := Budget{}
+	if err = randomize.Struct(seed, &o, budgetDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Budget struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Budget: %s", err)
+	}
+
+	count, err := Budgets().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, budgetDBTypes, false, budgetPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Budget struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Budget: %s", err)
+	}
+
+	count, err = Budgets().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
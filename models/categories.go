@@ -0,0 +1,1693 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// Category is an object representing the database table.
+type Category struct {
+	ID        null.Int64 `boil:"id" json:"id,omitempty" toml:"id" yaml:"id,omitempty"`
+	Name      string     `boil:"name" json:"name" toml:"name" yaml:"name"`
+	CreatedAt time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *categoryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L categoryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var CategoryColumns = struct {
+	ID        string
+	Name      string
+	CreatedAt string
+}{
+	ID:        "id",
+	Name:      "name",
+	CreatedAt: "created_at",
+}
+
+var CategoryTableColumns = struct {
+	ID        string
+	Name      string
+	CreatedAt string
+}{
+	ID:        "categories.id",
+	Name:      "categories.name",
+	CreatedAt: "categories.created_at",
+}
+
+// Generated where
+
+var CategoryWhere = struct {
+	ID        whereHelpernull_Int64
+	Name      whereHelperstring
+	CreatedAt whereHelpertime_Time
+}{
+	ID:        whereHelpernull_Int64{field: "\"categories\".\"id\""},
+	Name:      whereHelperstring{field: "\"categories\".\"name\""},
+	CreatedAt: whereHelpertime_Time{field: "\"categories\".\"created_at\""},
+}
+
+// CategoryRels is where relationship names are stored.
+var CategoryRels = struct {
+	Budgets               string
+	RecurringTransactions string
+	Transactions          string
+}{
+	Budgets:               "Budgets",
+	RecurringTransactions: "RecurringTransactions",
+	Transactions:          "Transactions",
+}
+
+// categoryR is where relationships are stored.
+type categoryR struct {
+	Budgets               BudgetSlice               `boil:"Budgets" json:"Budgets" toml:"Budgets" yaml:"Budgets"`
+	RecurringTransactions RecurringTransactionSlice `boil:"RecurringTransactions" json:"RecurringTransactions" toml:"RecurringTransactions" yaml:"RecurringTransactions"`
+	Transactions          TransactionSlice          `boil:"Transactions" json:"Transactions" toml:"Transactions" yaml:"Transactions"`
+}
+
+// NewStruct creates a new relationship struct
+func (*categoryR) NewStruct() *categoryR {
+	return &categoryR{}
+}
+
+func (o *Category) GetBudgets() BudgetSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetBudgets()
+}
+
+func (r *categoryR) GetBudgets() BudgetSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Budgets
+}
+
+func (o *Category) GetRecurringTransactions() RecurringTransactionSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetRecurringTransactions()
+}
+
+func (r *categoryR) GetRecurringTransactions() RecurringTransactionSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.RecurringTransactions
+}
+
+func (o *Category) GetTransactions() TransactionSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTransactions()
+}
+
+func (r *categoryR) GetTransactions() TransactionSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Transactions
+}
+
+// categoryL is where Load methods for each relationship are stored.
+type categoryL struct{}
+
+var (
+	categoryAllColumns            = []string{"id", "name", "created_at"}
+	categoryColumnsWithoutDefault = []string{"name"}
+	categoryColumnsWithDefault    = []string{"id", "created_at"}
+	categoryPrimaryKeyColumns     = []string{"id"}
+	categoryGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// CategorySlice is an alias for a slice of pointers to Category.
+	// This should almost always be used instead of []Category.
+	CategorySlice []*Category
+	// CategoryHook is the signature for custom Category hook methods
+	CategoryHook func(context.Context, boil.ContextExecutor, *Category) error
+
+	categoryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	categoryType                 = reflect.TypeOf(&Category{})
+	categoryMapping              = queries.MakeStructMapping(categoryType)
+	categoryPrimaryKeyMapping, _ = queries.BindMapping(categoryType, categoryMapping, categoryPrimaryKeyColumns)
+	categoryInsertCacheMut       sync.RWMutex
+	categoryInsertCache          = make(map[string]insertCache)
+	categoryUpdateCacheMut       sync.RWMutex
+	categoryUpdateCache          = make(map[string]updateCache)
+	categoryUpsertCacheMut       sync.RWMutex
+	categoryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var categoryAfterSelectMu sync.Mutex
+var categoryAfterSelectHooks []CategoryHook
+
+var categoryBeforeInsertMu sync.Mutex
+var categoryBeforeInsertHooks []CategoryHook
+var categoryAfterInsertMu sync.Mutex
+var categoryAfterInsertHooks []CategoryHook
+
+var categoryBeforeUpdateMu sync.Mutex
+var categoryBeforeUpdateHooks []CategoryHook
+var categoryAfterUpdateMu sync.Mutex
+var categoryAfterUpdateHooks []CategoryHook
+
+var categoryBeforeDeleteMu sync.Mutex
+var categoryBeforeDeleteHooks []CategoryHook
+var categoryAfterDeleteMu sync.Mutex
+var categoryAfterDeleteHooks []CategoryHook
+
+var categoryBeforeUpsertMu sync.Mutex
+var categoryBeforeUpsertHooks []CategoryHook
+var categoryAfterUpsertMu sync.Mutex
+var categoryAfterUpsertHooks []CategoryHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Category) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range categoryAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Category) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range categoryBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Category) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range categoryAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Category) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range categoryBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Category) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range categoryAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Category) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range categoryBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Category) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range categoryAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Category) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range categoryBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Category) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range categoryAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddCategoryHook registers your hook function for all future operations.
+func AddCategoryHook(hookPoint boil.HookPoint, categoryHook CategoryHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		categoryAfterSelectMu.Lock()
+		categoryAfterSelectHooks = append(categoryAfterSelectHooks, categoryHook)
+		categoryAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		categoryBeforeInsertMu.Lock()
+		categoryBeforeInsertHooks = append(categoryBeforeInsertHooks, categoryHook)
+		categoryBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		categoryAfterInsertMu.Lock()
+		categoryAfterInsertHooks = append(categoryAfterInsertHooks, categoryHook)
+		categoryAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		categoryBeforeUpdateMu.Lock()
+		categoryBeforeUpdateHooks = append(categoryBeforeUpdateHooks, categoryHook)
+		categoryBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		categoryAfterUpdateMu.Lock()
+		categoryAfterUpdateHooks = append(categoryAfterUpdateHooks, categoryHook)
+		categoryAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		categoryBeforeDeleteMu.Lock()
+		categoryBeforeDeleteHooks = append(categoryBeforeDeleteHooks, categoryHook)
+		categoryBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		categoryAfterDeleteMu.Lock()
+		categoryAfterDeleteHooks = append(categoryAfterDeleteHooks, categoryHook)
+		categoryAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		categoryBeforeUpsertMu.Lock()
+		categoryBeforeUpsertHooks = append(categoryBeforeUpsertHooks, categoryHook)
+		categoryBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		categoryAfterUpsertMu.Lock()
+		categoryAfterUpsertHooks = append(categoryAfterUpsertHooks, categoryHook)
+		categoryAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single category record from the query.
+func (q categoryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Category, error) {
+	o := &Category{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for categories")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Category records from the query.
+func (q categoryQuery) All(ctx context.Context, exec boil.ContextExecutor) (CategorySlice, error) {
+	var o []*Category
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Category slice")
+	}
+
+	if len(categoryAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Category records in the query.
+func (q categoryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count categories rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q categoryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if categories exists")
+	}
+
+	return count > 0, nil
+}
+
+// Budgets retrieves all the budget's Budgets with an executor.
+func (o *Category) Budgets(mods ...qm.QueryMod) budgetQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"budgets\".\"category_id\"=?", o.ID),
+	)
+
+	return Budgets(queryMods...)
+}
+
+// RecurringTransactions retrieves all the recurring_transaction's RecurringTransactions with an executor.
+func (o *Category) RecurringTransactions(mods ...qm.QueryMod) recurringTransactionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"recurring_transactions\".\"category_id\"=?", o.ID),
+	)
+
+	return RecurringTransactions(queryMods...)
+}
+
+// Transactions retrieves all the transaction's Transactions with an executor.
+func (o *Category) Transactions(mods ...qm.QueryMod) transactionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"transactions\".\"category_id\"=?", o.ID),
+	)
+
+	return Transactions(queryMods...)
+}
+
+// LoadBudgets allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (categoryL) LoadBudgets(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCategory interface{}, mods queries.Applicator) error {
+	var slice []*Category
+	var object *Category
+
+	if singular {
+		var ok bool
+		object, ok = maybeCategory.(*Category)
+		if !ok {
+			object = new(Category)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeCategory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeCategory))
+			}
+		}
+	} else {
+		s, ok := maybeCategory.(*[]*Category)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeCategory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeCategory))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &categoryR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &categoryR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`budgets`),
+		qm.WhereIn(`budgets.category_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load budgets")
+	}
+
+	var resultSlice []*Budget
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice budgets")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on budgets")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for budgets")
+	}
+
+	if len(budgetAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Budgets = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &budgetR{}
+			}
+			foreign.R.Category = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CategoryID) {
+				local.R.Budgets = append(local.R.Budgets, foreign)
+				if foreign.R == nil {
+					foreign.R = &budgetR{}
+				}
+				foreign.R.Category = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRecurringTransactions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (categoryL) LoadRecurringTransactions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCategory interface{}, mods queries.Applicator) error {
+	var slice []*Category
+	var object *Category
+
+	if singular {
+		var ok bool
+		object, ok = maybeCategory.(*Category)
+		if !ok {
+			object = new(Category)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeCategory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeCategory))
+			}
+		}
+	} else {
+		s, ok := maybeCategory.(*[]*Category)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeCategory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeCategory))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &categoryR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &categoryR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`recurring_transactions`),
+		qm.WhereIn(`recurring_transactions.category_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load recurring_transactions")
+	}
+
+	var resultSlice []*RecurringTransaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice recurring_transactions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on recurring_transactions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for recurring_transactions")
+	}
+
+	if len(recurringTransactionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RecurringTransactions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &recurringTransactionR{}
+			}
+			foreign.R.Category = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CategoryID) {
+				local.R.RecurringTransactions = append(local.R.RecurringTransactions, foreign)
+				if foreign.R == nil {
+					foreign.R = &recurringTransactionR{}
+				}
+				foreign.R.Category = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTransactions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (categoryL) LoadTransactions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCategory interface{}, mods queries.Applicator) error {
+	var slice []*Category
+	var object *Category
+
+	if singular {
+		var ok bool
+		object, ok = maybeCategory.(*Category)
+		if !ok {
+			object = new(Category)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeCategory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeCategory))
+			}
+		}
+	} else {
+		s, ok := maybeCategory.(*[]*Category)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeCategory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeCategory))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &categoryR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &categoryR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`transactions`),
+		qm.WhereIn(`transactions.category_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load transactions")
+	}
+
+	var resultSlice []*Transaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice transactions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on transactions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transactions")
+	}
+
+	if len(transactionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Transactions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &transactionR{}
+			}
+			foreign.R.Category = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CategoryID) {
+				local.R.Transactions = append(local.R.Transactions, foreign)
+				if foreign.R == nil {
+					foreign.R = &transactionR{}
+				}
+				foreign.R.Category = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddBudgets adds the given related objects to the existing relationships
+// of the category, optionally inserting them as new records.
+// Appends related to o.R.Budgets.
+// Sets related.R.Category appropriately.
+func (o *Category) AddBudgets(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Budget) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CategoryID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"budgets\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"category_id"}),
+				strmangle.WhereClause("\"", "\"", 0, budgetPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.CategoryID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &categoryR{
+			Budgets: related,
+		}
+	} else {
+		o.R.Budgets = append(o.R.Budgets, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &budgetR{
+				Category: o,
+			}
+		} else {
+			rel.R.Category = o
+		}
+	}
+	return nil
+}
+
+// AddRecurringTransactions adds the given related objects to the existing relationships
+// of the category, optionally inserting them as new records.
+// Appends related to o.R.RecurringTransactions.
+// Sets related.R.Category appropriately.
+func (o *Category) AddRecurringTransactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RecurringTransaction) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CategoryID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"recurring_transactions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"category_id"}),
+				strmangle.WhereClause("\"", "\"", 0, recurringTransactionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.CategoryID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &categoryR{
+			RecurringTransactions: related,
+		}
+	} else {
+		o.R.RecurringTransactions = append(o.R.RecurringTransactions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &recurringTransactionR{
+				Category: o,
+			}
+		} else {
+			rel.R.Category = o
+		}
+	}
+	return nil
+}
+
+// SetRecurringTransactions removes all previously related items of the
+// category replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Category's RecurringTransactions accordingly.
+// Replaces o.R.RecurringTransactions with related.
+// Sets related.R.Category's RecurringTransactions accordingly.
+func (o *Category) SetRecurringTransactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RecurringTransaction) error {
+	query := "update \"recurring_transactions\" set \"category_id\" = null where \"category_id\" = ?"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.RecurringTransactions {
+			queries.SetScanner(&rel.CategoryID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Category = nil
+		}
+		o.R.RecurringTransactions = nil
+	}
+
+	return o.AddRecurringTransactions(ctx, exec, insert, related...)
+}
+
+// RemoveRecurringTransactions relationships from objects passed in.
+// Removes related items from R.RecurringTransactions (uses pointer comparison, removal does not keep order)
+// Sets related.R.Category.
+func (o *Category) RemoveRecurringTransactions(ctx context.Context, exec boil.ContextExecutor, related ...*RecurringTransaction) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CategoryID, nil)
+		if rel.R != nil {
+			rel.R.Category = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("category_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.RecurringTransactions {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.RecurringTransactions)
+			if ln > 1 && i < ln-1 {
+				o.R.RecurringTransactions[i] = o.R.RecurringTransactions[ln-1]
+			}
+			o.R.RecurringTransactions = o.R.RecurringTransactions[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddTransactions adds the given related objects to the existing relationships
+// of the category, optionally inserting them as new records.
+// Appends related to o.R.Transactions.
+// Sets related.R.Category appropriately.
+func (o *Category) AddTransactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Transaction) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CategoryID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"transactions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"category_id"}),
+				strmangle.WhereClause("\"", "\"", 0, transactionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.CategoryID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &categoryR{
+			Transactions: related,
+		}
+	} else {
+		o.R.Transactions = append(o.R.Transactions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &transactionR{
+				Category: o,
+			}
+		} else {
+			rel.R.Category = o
+		}
+	}
+	return nil
+}
+
+// SetTransactions removes all previously related items of the
+// category replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Category's Transactions accordingly.
+// Replaces o.R.Transactions with related.
+// Sets related.R.Category's Transactions accordingly.
+func (o *Category) SetTransactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Transaction) error {
+	query := "update \"transactions\" set \"category_id\" = null where \"category_id\" = ?"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Transactions {
+			queries.SetScanner(&rel.CategoryID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Category = nil
+		}
+		o.R.Transactions = nil
+	}
+
+	return o.AddTransactions(ctx, exec, insert, related...)
+}
+
+// RemoveTransactions relationships from objects passed in.
+// Removes related items from R.Transactions (uses pointer comparison, removal does not keep order)
+// Sets related.R.Category.
+func (o *Category) RemoveTransactions(ctx context.Context, exec boil.ContextExecutor, related ...*Transaction) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CategoryID, nil)
+		if rel.R != nil {
+			rel.R.Category = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("category_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Transactions {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Transactions)
+			if ln > 1 && i < ln-1 {
+				o.R.Transactions[i] = o.R.Transactions[ln-1]
+			}
+			o.R.Transactions = o.R.Transactions[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// Categories retrieves all the records using an executor.
+func Categories(mods ...qm.QueryMod) categoryQuery {
+	mods = append(mods, qm.From("\"categories\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"categories\".*"})
+	}
+
+	return categoryQuery{q}
+}
+
+// FindCategory retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindCategory(ctx context.Context, exec boil.ContextExecutor, iD null.Int64, selectCols ...string) (*Category, error) {
+	categoryObj := &Category{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"categories\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, categoryObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from categories")
+	}
+
+	if err = categoryObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return categoryObj, err
+	}
+
+	return categoryObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Category) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no categories provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(categoryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	categoryInsertCacheMut.RLock()
+	cache, cached := categoryInsertCache[key]
+	categoryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			categoryAllColumns,
+			categoryColumnsWithDefault,
+			categoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, categoryGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(categoryType, categoryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(categoryType, categoryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"categories\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"categories\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into categories")
+	}
+
+	if !cached {
+		categoryInsertCacheMut.Lock()
+		categoryInsertCache[key] = cache
+		categoryInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Category.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Category) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	categoryUpdateCacheMut.RLock()
+	cache, cached := categoryUpdateCache[key]
+	categoryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			categoryAllColumns,
+			categoryPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, categoryGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update categories, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"categories\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, categoryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(categoryType, categoryMapping, append(wl, categoryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update categories row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for categories")
+	}
+
+	if !cached {
+		categoryUpdateCacheMut.Lock()
+		categoryUpdateCache[key] = cache
+		categoryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q categoryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for categories")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for categories")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o CategorySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), categoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"categories\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, categoryPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in category slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all category")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Category) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no categories provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(categoryColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	categoryUpsertCacheMut.RLock()
+	cache, cached := categoryUpsertCache[key]
+	categoryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			categoryAllColumns,
+			categoryColumnsWithDefault,
+			categoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			categoryAllColumns,
+			categoryPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert categories, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(categoryAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(categoryPrimaryKeyColumns))
+			copy(conflict, categoryPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"categories\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(categoryType, categoryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(categoryType, categoryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert categories")
+	}
+
+	if !cached {
+		categoryUpsertCacheMut.Lock()
+		categoryUpsertCache[key] = cache
+		categoryUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Category record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Category) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Category provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), categoryPrimaryKeyMapping)
+	sql := "DELETE FROM \"categories\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from categories")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for categories")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q categoryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no categoryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from categories")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for categories")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o CategorySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(categoryBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), categoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"categories\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, categoryPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from category slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for categories")
+	}
+
+	if len(categoryAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Category) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindCategory(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *CategorySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := CategorySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), categoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"categories\".* FROM \"categories\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, categoryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in CategorySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// CategoryExists checks if the Category row exists.
+func CategoryExists(ctx context.Context, exec boil.ContextExecutor, iD null.Int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"categories\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if categories exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Category row exists.
+func (o *Category) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return CategoryExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1156 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// Chat is an object representing the database table.
+type Chat struct {
+	ID        null.Int64 `boil:"id" json:"id,omitempty" toml:"id" yaml:"id,omitempty"`
+	Jid       string     `boil:"jid" json:"jid" toml:"jid" yaml:"jid"`
+	LedgerID  int64      `boil:"ledger_id" json:"ledger_id" toml:"ledger_id" yaml:"ledger_id"`
+	CreatedAt time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *chatR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L chatL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ChatColumns = struct {
+	ID        string
+	Jid       string
+	LedgerID  string
+	CreatedAt string
+}{
+	ID:        "id",
+	Jid:       "jid",
+	LedgerID:  "ledger_id",
+	CreatedAt: "created_at",
+}
+
+var ChatTableColumns = struct {
+	ID        string
+	Jid       string
+	LedgerID  string
+	CreatedAt string
+}{
+	ID:        "chats.id",
+	Jid:       "chats.jid",
+	LedgerID:  "chats.ledger_id",
+	CreatedAt: "chats.created_at",
+}
+
+// Generated where
+
+var ChatWhere = struct {
+	ID        whereHelpernull_Int64
+	Jid       whereHelperstring
+	LedgerID  whereHelperint64
+	CreatedAt whereHelpertime_Time
+}{
+	ID:        whereHelpernull_Int64{field: "\"chats\".\"id\""},
+	Jid:       whereHelperstring{field: "\"chats\".\"jid\""},
+	LedgerID:  whereHelperint64{field: "\"chats\".\"ledger_id\""},
+	CreatedAt: whereHelpertime_Time{field: "\"chats\".\"created_at\""},
+}
+
+// ChatRels is where relationship names are stored.
+var ChatRels = struct {
+	Ledger string
+}{
+	Ledger: "Ledger",
+}
+
+// chatR is where relationships are stored.
+type chatR struct {
+	Ledger *Ledger `boil:"Ledger" json:"Ledger" toml:"Ledger" yaml:"Ledger"`
+}
+
+// NewStruct creates a new relationship struct
+func (*chatR) NewStruct() *chatR {
+	return &chatR{}
+}
+
+func (o *Chat) GetLedger() *Ledger {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetLedger()
+}
+
+func (r *chatR) GetLedger() *Ledger {
+	if r == nil {
+		return nil
+	}
+
+	return r.Ledger
+}
+
+// chatL is where Load methods for each relationship are stored.
+type chatL struct{}
+
+var (
+	chatAllColumns            = []string{"id", "jid", "ledger_id", "created_at"}
+	chatColumnsWithoutDefault = []string{"jid", "ledger_id"}
+	chatColumnsWithDefault    = []string{"id", "created_at"}
+	chatPrimaryKeyColumns     = []string{"id"}
+	chatGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// ChatSlice is an alias for a slice of pointers to Chat.
+	// This should almost always be used instead of []Chat.
+	ChatSlice []*Chat
+	// ChatHook is the signature for custom Chat hook methods
+	ChatHook func(context.Context, boil.ContextExecutor, *Chat) error
+
+	chatQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	chatType                 = reflect.TypeOf(&Chat{})
+	chatMapping              = queries.MakeStructMapping(chatType)
+	chatPrimaryKeyMapping, _ = queries.BindMapping(chatType, chatMapping, chatPrimaryKeyColumns)
+	chatInsertCacheMut       sync.RWMutex
+	chatInsertCache          = make(map[string]insertCache)
+	chatUpdateCacheMut       sync.RWMutex
+	chatUpdateCache          = make(map[string]updateCache)
+	chatUpsertCacheMut       sync.RWMutex
+	chatUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var chatAfterSelectMu sync.Mutex
+var chatAfterSelectHooks []ChatHook
+
+var chatBeforeInsertMu sync.Mutex
+var chatBeforeInsertHooks []ChatHook
+var chatAfterInsertMu sync.Mutex
+var chatAfterInsertHooks []ChatHook
+
+var chatBeforeUpdateMu sync.Mutex
+var chatBeforeUpdateHooks []ChatHook
+var chatAfterUpdateMu sync.Mutex
+var chatAfterUpdateHooks []ChatHook
+
+var chatBeforeDeleteMu sync.Mutex
+var chatBeforeDeleteHooks []ChatHook
+var chatAfterDeleteMu sync.Mutex
+var chatAfterDeleteHooks []ChatHook
+
+var chatBeforeUpsertMu sync.Mutex
+var chatBeforeUpsertHooks []ChatHook
+var chatAfterUpsertMu sync.Mutex
+var chatAfterUpsertHooks []ChatHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Chat) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Chat) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Chat) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Chat) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Chat) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Chat) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Chat) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Chat) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Chat) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddChatHook registers your hook function for all future operations.
+func AddChatHook(hookPoint boil.HookPoint, chatHook ChatHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		chatAfterSelectMu.Lock()
+		chatAfterSelectHooks = append(chatAfterSelectHooks, chatHook)
+		chatAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		chatBeforeInsertMu.Lock()
+		chatBeforeInsertHooks = append(chatBeforeInsertHooks, chatHook)
+		chatBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		chatAfterInsertMu.Lock()
+		chatAfterInsertHooks = append(chatAfterInsertHooks, chatHook)
+		chatAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		chatBeforeUpdateMu.Lock()
+		chatBeforeUpdateHooks = append(chatBeforeUpdateHooks, chatHook)
+		chatBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		chatAfterUpdateMu.Lock()
+		chatAfterUpdateHooks = append(chatAfterUpdateHooks, chatHook)
+		chatAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		chatBeforeDeleteMu.Lock()
+		chatBeforeDeleteHooks = append(chatBeforeDeleteHooks, chatHook)
+		chatBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		chatAfterDeleteMu.Lock()
+		chatAfterDeleteHooks = append(chatAfterDeleteHooks, chatHook)
+		chatAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		chatBeforeUpsertMu.Lock()
+		chatBeforeUpsertHooks = append(chatBeforeUpsertHooks, chatHook)
+		chatBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		chatAfterUpsertMu.Lock()
+		chatAfterUpsertHooks = append(chatAfterUpsertHooks, chatHook)
+		chatAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single chat record from the query.
+func (q chatQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Chat, error) {
+	o := &Chat{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for chats")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Chat records from the query.
+func (q chatQuery) All(ctx context.Context, exec boil.ContextExecutor) (ChatSlice, error) {
+	var o []*Chat
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Chat slice")
+	}
+
+	if len(chatAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Chat records in the query.
+func (q chatQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count chats rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q chatQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if chats exists")
+	}
+
+	return count > 0, nil
+}
+
+// Ledger pointed to by the foreign key.
+func (o *Chat) Ledger(mods ...qm.QueryMod) ledgerQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.LedgerID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Ledgers(queryMods...)
+}
+
+// LoadLedger allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (chatL) LoadLedger(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChat interface{}, mods queries.Applicator) error {
+	var slice []*Chat
+	var object *Chat
+
+	if singular {
+		var ok bool
+		object, ok = maybeChat.(*Chat)
+		if !ok {
+			object = new(Chat)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeChat)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeChat))
+			}
+		}
+	} else {
+		s, ok := maybeChat.(*[]*Chat)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeChat)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeChat))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &chatR{}
+		}
+		if !queries.IsNil(object.LedgerID) {
+			args[object.LedgerID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatR{}
+			}
+
+			if !queries.IsNil(obj.LedgerID) {
+				args[obj.LedgerID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`ledgers`),
+		qm.WhereIn(`ledgers.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Ledger")
+	}
+
+	var resultSlice []*Ledger
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Ledger")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for ledgers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for ledgers")
+	}
+
+	if len(ledgerAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Ledger = foreign
+		if foreign.R == nil {
+			foreign.R = &ledgerR{}
+		}
+		foreign.R.Chats = append(foreign.R.Chats, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.LedgerID, foreign.ID) {
+				local.R.Ledger = foreign
+				if foreign.R == nil {
+					foreign.R = &ledgerR{}
+				}
+				foreign.R.Chats = append(foreign.R.Chats, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetLedger of the chat to the related item.
+// Sets o.R.Ledger to related.
+// Adds o to related.R.Chats.
+func (o *Chat) SetLedger(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Ledger) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"chats\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"ledger_id"}),
+		strmangle.WhereClause("\"", "\"", 0, chatPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.LedgerID, related.ID)
+	if o.R == nil {
+		o.R = &chatR{
+			Ledger: related,
+		}
+	} else {
+		o.R.Ledger = related
+	}
+
+	if related.R == nil {
+		related.R = &ledgerR{
+			Chats: ChatSlice{o},
+		}
+	} else {
+		related.R.Chats = append(related.R.Chats, o)
+	}
+
+	return nil
+}
+
+// Chats retrieves all the records using an executor.
+func Chats(mods ...qm.QueryMod) chatQuery {
+	mods = append(mods, qm.From("\"chats\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"chats\".*"})
+	}
+
+	return chatQuery{q}
+}
+
+// FindChat retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindChat(ctx context.Context, exec boil.ContextExecutor, iD null.Int64, selectCols ...string) (*Chat, error) {
+	chatObj := &Chat{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"chats\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, chatObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from chats")
+	}
+
+	if err = chatObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return chatObj, err
+	}
+
+	return chatObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Chat) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no chats provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(chatColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	chatInsertCacheMut.RLock()
+	cache, cached := chatInsertCache[key]
+	chatInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			chatAllColumns,
+			chatColumnsWithDefault,
+			chatColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, chatGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(chatType, chatMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(chatType, chatMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"chats\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"chats\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into chats")
+	}
+
+	if !cached {
+		chatInsertCacheMut.Lock()
+		chatInsertCache[key] = cache
+		chatInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Chat.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Chat) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	chatUpdateCacheMut.RLock()
+	cache, cached := chatUpdateCache[key]
+	chatUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			chatAllColumns,
+			chatPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, chatGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update chats, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"chats\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, chatPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(chatType, chatMapping, append(wl, chatPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update chats row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for chats")
+	}
+
+	if !cached {
+		chatUpdateCacheMut.Lock()
+		chatUpdateCache[key] = cache
+		chatUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q chatQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for chats")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for chats")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ChatSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"chats\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, chatPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in chat slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all chat")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Chat) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no chats provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(chatColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	chatUpsertCacheMut.RLock()
+	cache, cached := chatUpsertCache[key]
+	chatUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			chatAllColumns,
+			chatColumnsWithDefault,
+			chatColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			chatAllColumns,
+			chatPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert chats, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(chatAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(chatPrimaryKeyColumns))
+			copy(conflict, chatPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"chats\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(chatType, chatMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(chatType, chatMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert chats")
+	}
+
+	if !cached {
+		chatUpsertCacheMut.Lock()
+		chatUpsertCache[key] = cache
+		chatUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Chat record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Chat) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Chat provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), chatPrimaryKeyMapping)
+	sql := "DELETE FROM \"chats\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from chats")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for chats")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q chatQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no chatQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from chats")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for chats")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ChatSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(chatBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"chats\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, chatPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from chat slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for chats")
+	}
+
+	if len(chatAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Chat) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindChat(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChatSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ChatSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"chats\".* FROM \"chats\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, chatPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ChatSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ChatExists checks if the Chat row exists.
+func ChatExists(ctx context.Context, exec boil.ContextExecutor, iD null.Int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"chats\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if chats exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Chat row exists.
+func (o *Chat) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ChatExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,851 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testChats(t *testing.T) {
+	t.Parallel()
+
+	query := Chats()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testChatsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Chat{}
+	if err = randomize.Struct(seed, o, chatDBTypes, true, chatColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Chat struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Chats().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testChatsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Chat{}
+	if err = randomize.Struct(seed, o, chatDBTypes, true, chatColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Chat struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Chats().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Chats().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testChatsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Chat{}
+	if err = randomize.Struct(seed, o, chatDBTypes, true, chatColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Chat struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ChatSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Chats().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testChatsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Chat{}
+	if err = randomize.Struct(seed, o, chatDBTypes, true, chatColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Chat struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ChatExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if Chat exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ChatExists to return true, but got false.")
+	}
+}
+
+func testChatsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Chat{}
+	if err = randomize.Struct(seed, o, chatDBTypes, true, chatColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Chat struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	chatFound, err := FindChat(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if chatFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testChatsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Chat{}
+	if err = randomize.Struct(seed, o, chatDBTypes, true, chatColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Chat struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Chats().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testChatsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Chat{}
+	if err = randomize.Struct(seed, o, chatDBTypes, true, chatColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Chat struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Chats().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testChatsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	chatOne := &Chat{}
+	chatTwo := &Chat{}
+	if err = randomize.Struct(seed, chatOne, chatDBTypes, false, chatColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Chat struct: %s", err)
+	}
+	if err = randomize.Struct(seed, chatTwo, chatDBTypes, false, chatColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Chat struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = chatOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = chatTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Chats().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testChatsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	chatOne := &Chat{}
+	chatTwo := &Chat{}
+	if err = randomize.Struct(seed, chatOne, chatDBTypes, false, chatColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Chat struct: %s", err)
+	}
+	if err = randomize.Struct(seed, chatTwo, chatDBTypes, false, chatColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Chat struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = chatOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = chatTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Chats().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func chatBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *Chat) error {
+	*o = Chat{}
+	return nil
+}
+
+func chatAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *Chat) error {
+	*o = Chat{}
+	return nil
+}
+
+func chatAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *Chat) error {
+	*o = Chat{}
+	return nil
+}
+
+func chatBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Chat) error {
+	*o = Chat{}
+	return nil
+}
+
+func chatAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Chat) error {
+	*o = Chat{}
+	return nil
+}
+
+func chatBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Chat) error {
+	*o = Chat{}
+	return nil
+}
+
+func chatAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Chat) error {
+	*o = Chat{}
+	return nil
+}
+
+func chatBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Chat) error {
+	*o = Chat{}
+	return nil
+}
+
+func chatAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Chat) error {
+	*o = Chat{}
+	return nil
+}
+
+func testChatsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &Chat{}
+	o := &Chat{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, chatDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize Chat object: %s", err)
+	}
+
+	AddChatHook(boil.BeforeInsertHook, chatBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	chatBeforeInsertHooks = []ChatHook{}
+
+	AddChatHook(boil.AfterInsertHook, chatAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	chatAfterInsertHooks = []ChatHook{}
+
+	AddChatHook(boil.AfterSelectHook, chatAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	chatAfterSelectHooks = []ChatHook{}
+
+	AddChatHook(boil.BeforeUpdateHook, chatBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	chatBeforeUpdateHooks = []ChatHook{}
+
+	AddChatHook(boil.AfterUpdateHook, chatAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	chatAfterUpdateHooks = []ChatHook{}
+
+	AddChatHook(boil.BeforeDeleteHook, chatBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	chatBeforeDeleteHooks = []ChatHook{}
+
+	AddChatHook(boil.AfterDeleteHook, chatAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	chatAfterDeleteHooks = []ChatHook{}
+
+	AddChatHook(boil.BeforeUpsertHook, chatBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	chatBeforeUpsertHooks = []ChatHook{}
+
+	AddChatHook(boil.AfterUpsertHook, chatAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	chatAfterUpsertHooks = []ChatHook{}
+}
+
+func testChatsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Chat{}
+	if err = randomize.Struct(seed, o, chatDBTypes, true, chatColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Chat struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Chats().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testChatsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Chat{}
+	if err = randomize.Struct(seed, o, chatDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Chat struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(chatPrimaryKeyColumns, chatColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Chats().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testChatToOneLedgerUsingLedger(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Chat
+	var foreign Ledger
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, chatDBTypes, false, chatColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Chat struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, ledgerDBTypes, true, ledgerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Ledger struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.LedgerID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Ledger().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddLedgerHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Ledger) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := ChatSlice{&local}
+	if err = local.L.LoadLedger(ctx, tx, false, (*[]*Chat)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Ledger == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Ledger = nil
+	if err = local.L.LoadLedger(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Ledger == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testChatToOneSetOpLedgerUsingLedger(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Chat
+	var b, c Ledger
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, chatDBTypes, false, strmangle.SetComplement(chatPrimaryKeyColumns, chatColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, ledgerDBTypes, false, strmangle.SetComplement(ledgerPrimaryKeyColumns, ledgerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, ledgerDBTypes, false, strmangle.SetComplement(ledgerPrimaryKeyColumns, ledgerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Ledger{&b, &c} {
+		err = a.SetLedger(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Ledger != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Chats[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.LedgerID, x.ID) {
+			t.Error("foreign key was wrong value", a.LedgerID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.LedgerID))
+		reflect.Indirect(reflect.ValueOf(&a.LedgerID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.LedgerID, x.ID) {
+			t.Error("foreign key was wrong value", a.LedgerID, x.ID)
+		}
+	}
+}
+
+func testChatsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Chat{}
+	if err = randomize.Struct(seed, o, chatDBTypes, true, chatColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Chat struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testChatsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Chat{}
+	if err = randomize.Struct(seed, o, chatDBTypes, true, chatColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Chat struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ChatSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testChatsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Chat{}
+	if err = randomize.Struct(seed, o, chatDBTypes, true, chatColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Chat struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Chats().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	chatDBTypes = map[string]string{`ID`: `INTEGER`, `Jid`: `TEXT`, `LedgerID`: `INTEGER`, `CreatedAt`: `DATETIME`}
+	_           = bytes.MinRead
+)
+
+func testChatsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(chatPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(chatAllColumns) == len(chatPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Chat{}
+	if err = randomize.Struct(seed, o, chatDBTypes, true, chatColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Chat struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Chats().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, chatDBTypes, true, chatPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Chat struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testChatsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(chatAllColumns) == len(chatPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Chat{}
+	if err = randomize.Struct(seed, o, chatDBTypes, true, chatColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Chat struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Chats().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, chatDBTypes, true, chatPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Chat struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(chatAllColumns, chatPrimaryKeyColumns) {
+		fields = chatAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			chatAllColumns,
+			chatPrimaryKeyColumns,
+		)
+		fields = strmangle.SetComplement(fields, chatGeneratedColumns)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ChatSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testChatsUpsert(t *testing.T) {
+	t.Parallel()
+	if len(chatAllColumns) == len(chatPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := Chat{}
+	if err = randomize.Struct(seed, &o, chatDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Chat struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Chat: %s", err)
+	}
+
+	count, err := Chats().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, chatDBTypes, false, chatPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Chat struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Chat: %s", err)
+	}
+
+	count, err = Chats().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
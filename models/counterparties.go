@@ -0,0 +1,1474 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// Counterparty is an object representing the database table.
+type Counterparty struct {
+	ID         null.Int64 `boil:"id" json:"id,omitempty" toml:"id" yaml:"id,omitempty"`
+	LedgerID   int64      `boil:"ledger_id" json:"ledger_id" toml:"ledger_id" yaml:"ledger_id"`
+	Name       string     `boil:"name" json:"name" toml:"name" yaml:"name"`
+	DueDate    null.Time  `boil:"due_date" json:"due_date,omitempty" toml:"due_date" yaml:"due_date,omitempty"`
+	Chat       string     `boil:"chat" json:"chat" toml:"chat" yaml:"chat"`
+	RemindedAt null.Time  `boil:"reminded_at" json:"reminded_at,omitempty" toml:"reminded_at" yaml:"reminded_at,omitempty"`
+	CreatedAt  time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *counterpartyR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L counterpartyL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var CounterpartyColumns = struct {
+	ID         string
+	LedgerID   string
+	Name       string
+	DueDate    string
+	Chat       string
+	RemindedAt string
+	CreatedAt  string
+}{
+	ID:         "id",
+	LedgerID:   "ledger_id",
+	Name:       "name",
+	DueDate:    "due_date",
+	Chat:       "chat",
+	RemindedAt: "reminded_at",
+	CreatedAt:  "created_at",
+}
+
+var CounterpartyTableColumns = struct {
+	ID         string
+	LedgerID   string
+	Name       string
+	DueDate    string
+	Chat       string
+	RemindedAt string
+	CreatedAt  string
+}{
+	ID:         "counterparties.id",
+	LedgerID:   "counterparties.ledger_id",
+	Name:       "counterparties.name",
+	DueDate:    "counterparties.due_date",
+	Chat:       "counterparties.chat",
+	RemindedAt: "counterparties.reminded_at",
+	CreatedAt:  "counterparties.created_at",
+}
+
+// Generated where
+
+type whereHelpernull_Time struct{ field string }
+
+func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var CounterpartyWhere = struct {
+	ID         whereHelpernull_Int64
+	LedgerID   whereHelperint64
+	Name       whereHelperstring
+	DueDate    whereHelpernull_Time
+	Chat       whereHelperstring
+	RemindedAt whereHelpernull_Time
+	CreatedAt  whereHelpertime_Time
+}{
+	ID:         whereHelpernull_Int64{field: "\"counterparties\".\"id\""},
+	LedgerID:   whereHelperint64{field: "\"counterparties\".\"ledger_id\""},
+	Name:       whereHelperstring{field: "\"counterparties\".\"name\""},
+	DueDate:    whereHelpernull_Time{field: "\"counterparties\".\"due_date\""},
+	Chat:       whereHelperstring{field: "\"counterparties\".\"chat\""},
+	RemindedAt: whereHelpernull_Time{field: "\"counterparties\".\"reminded_at\""},
+	CreatedAt:  whereHelpertime_Time{field: "\"counterparties\".\"created_at\""},
+}
+
+// CounterpartyRels is where relationship names are stored.
+var CounterpartyRels = struct {
+	Ledger       string
+	Transactions string
+}{
+	Ledger:       "Ledger",
+	Transactions: "Transactions",
+}
+
+// counterpartyR is where relationships are stored.
+type counterpartyR struct {
+	Ledger       *Ledger          `boil:"Ledger" json:"Ledger" toml:"Ledger" yaml:"Ledger"`
+	Transactions TransactionSlice `boil:"Transactions" json:"Transactions" toml:"Transactions" yaml:"Transactions"`
+}
+
+// NewStruct creates a new relationship struct
+func (*counterpartyR) NewStruct() *counterpartyR {
+	return &counterpartyR{}
+}
+
+func (o *Counterparty) GetLedger() *Ledger {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetLedger()
+}
+
+func (r *counterpartyR) GetLedger() *Ledger {
+	if r == nil {
+		return nil
+	}
+
+	return r.Ledger
+}
+
+func (o *Counterparty) GetTransactions() TransactionSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTransactions()
+}
+
+func (r *counterpartyR) GetTransactions() TransactionSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Transactions
+}
+
+// counterpartyL is where Load methods for each relationship are stored.
+type counterpartyL struct{}
+
+var (
+	counterpartyAllColumns            = []string{"id", "ledger_id", "name", "due_date", "chat", "reminded_at", "created_at"}
+	counterpartyColumnsWithoutDefault = []string{"ledger_id", "name"}
+	counterpartyColumnsWithDefault    = []string{"id", "due_date", "chat", "reminded_at", "created_at"}
+	counterpartyPrimaryKeyColumns     = []string{"id"}
+	counterpartyGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// CounterpartySlice is an alias for a slice of pointers to Counterparty.
+	// This should almost always be used instead of []Counterparty.
+	CounterpartySlice []*Counterparty
+	// CounterpartyHook is the signature for custom Counterparty hook methods
+	CounterpartyHook func(context.Context, boil.ContextExecutor, *Counterparty) error
+
+	counterpartyQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	counterpartyType                 = reflect.TypeOf(&Counterparty{})
+	counterpartyMapping              = queries.MakeStructMapping(counterpartyType)
+	counterpartyPrimaryKeyMapping, _ = queries.BindMapping(counterpartyType, counterpartyMapping, counterpartyPrimaryKeyColumns)
+	counterpartyInsertCacheMut       sync.RWMutex
+	counterpartyInsertCache          = make(map[string]insertCache)
+	counterpartyUpdateCacheMut       sync.RWMutex
+	counterpartyUpdateCache          = make(map[string]updateCache)
+	counterpartyUpsertCacheMut       sync.RWMutex
+	counterpartyUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var counterpartyAfterSelectMu sync.Mutex
+var counterpartyAfterSelectHooks []CounterpartyHook
+
+var counterpartyBeforeInsertMu sync.Mutex
+var counterpartyBeforeInsertHooks []CounterpartyHook
+var counterpartyAfterInsertMu sync.Mutex
+var counterpartyAfterInsertHooks []CounterpartyHook
+
+var counterpartyBeforeUpdateMu sync.Mutex
+var counterpartyBeforeUpdateHooks []CounterpartyHook
+var counterpartyAfterUpdateMu sync.Mutex
+var counterpartyAfterUpdateHooks []CounterpartyHook
+
+var counterpartyBeforeDeleteMu sync.Mutex
+var counterpartyBeforeDeleteHooks []CounterpartyHook
+var counterpartyAfterDeleteMu sync.Mutex
+var counterpartyAfterDeleteHooks []CounterpartyHook
+
+var counterpartyBeforeUpsertMu sync.Mutex
+var counterpartyBeforeUpsertHooks []CounterpartyHook
+var counterpartyAfterUpsertMu sync.Mutex
+var counterpartyAfterUpsertHooks []CounterpartyHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Counterparty) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range counterpartyAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Counterparty) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range counterpartyBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Counterparty) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range counterpartyAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Counterparty) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range counterpartyBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Counterparty) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range counterpartyAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Counterparty) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range counterpartyBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Counterparty) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range counterpartyAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Counterparty) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range counterpartyBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Counterparty) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range counterpartyAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddCounterpartyHook registers your hook function for all future operations.
+func AddCounterpartyHook(hookPoint boil.HookPoint, counterpartyHook CounterpartyHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		counterpartyAfterSelectMu.Lock()
+		counterpartyAfterSelectHooks = append(counterpartyAfterSelectHooks, counterpartyHook)
+		counterpartyAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		counterpartyBeforeInsertMu.Lock()
+		counterpartyBeforeInsertHooks = append(counterpartyBeforeInsertHooks, counterpartyHook)
+		counterpartyBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		counterpartyAfterInsertMu.Lock()
+		counterpartyAfterInsertHooks = append(counterpartyAfterInsertHooks, counterpartyHook)
+		counterpartyAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		counterpartyBeforeUpdateMu.Lock()
+		counterpartyBeforeUpdateHooks = append(counterpartyBeforeUpdateHooks, counterpartyHook)
+		counterpartyBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		counterpartyAfterUpdateMu.Lock()
+		counterpartyAfterUpdateHooks = append(counterpartyAfterUpdateHooks, counterpartyHook)
+		counterpartyAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		counterpartyBeforeDeleteMu.Lock()
+		counterpartyBeforeDeleteHooks = append(counterpartyBeforeDeleteHooks, counterpartyHook)
+		counterpartyBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		counterpartyAfterDeleteMu.Lock()
+		counterpartyAfterDeleteHooks = append(counterpartyAfterDeleteHooks, counterpartyHook)
+		counterpartyAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		counterpartyBeforeUpsertMu.Lock()
+		counterpartyBeforeUpsertHooks = append(counterpartyBeforeUpsertHooks, counterpartyHook)
+		counterpartyBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		counterpartyAfterUpsertMu.Lock()
+		counterpartyAfterUpsertHooks = append(counterpartyAfterUpsertHooks, counterpartyHook)
+		counterpartyAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single counterparty record from the query.
+func (q counterpartyQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Counterparty, error) {
+	o := &Counterparty{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for counterparties")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Counterparty records from the query.
+func (q counterpartyQuery) All(ctx context.Context, exec boil.ContextExecutor) (CounterpartySlice, error) {
+	var o []*Counterparty
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Counterparty slice")
+	}
+
+	if len(counterpartyAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Counterparty records in the query.
+func (q counterpartyQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count counterparties rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q counterpartyQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if counterparties exists")
+	}
+
+	return count > 0, nil
+}
+
+// Ledger pointed to by the foreign key.
+func (o *Counterparty) Ledger(mods ...qm.QueryMod) ledgerQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.LedgerID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Ledgers(queryMods...)
+}
+
+// Transactions retrieves all the transaction's Transactions with an executor.
+func (o *Counterparty) Transactions(mods ...qm.QueryMod) transactionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"transactions\".\"counterparty_id\"=?", o.ID),
+	)
+
+	return Transactions(queryMods...)
+}
+
+// LoadLedger allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (counterpartyL) LoadLedger(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCounterparty interface{}, mods queries.Applicator) error {
+	var slice []*Counterparty
+	var object *Counterparty
+
+	if singular {
+		var ok bool
+		object, ok = maybeCounterparty.(*Counterparty)
+		if !ok {
+			object = new(Counterparty)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeCounterparty)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeCounterparty))
+			}
+		}
+	} else {
+		s, ok := maybeCounterparty.(*[]*Counterparty)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeCounterparty)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeCounterparty))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &counterpartyR{}
+		}
+		if !queries.IsNil(object.LedgerID) {
+			args[object.LedgerID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &counterpartyR{}
+			}
+
+			if !queries.IsNil(obj.LedgerID) {
+				args[obj.LedgerID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`ledgers`),
+		qm.WhereIn(`ledgers.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Ledger")
+	}
+
+	var resultSlice []*Ledger
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Ledger")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for ledgers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for ledgers")
+	}
+
+	if len(ledgerAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Ledger = foreign
+		if foreign.R == nil {
+			foreign.R = &ledgerR{}
+		}
+		foreign.R.Counterparties = append(foreign.R.Counterparties, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.LedgerID, foreign.ID) {
+				local.R.Ledger = foreign
+				if foreign.R == nil {
+					foreign.R = &ledgerR{}
+				}
+				foreign.R.Counterparties = append(foreign.R.Counterparties, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTransactions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (counterpartyL) LoadTransactions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCounterparty interface{}, mods queries.Applicator) error {
+	var slice []*Counterparty
+	var object *Counterparty
+
+	if singular {
+		var ok bool
+		object, ok = maybeCounterparty.(*Counterparty)
+		if !ok {
+			object = new(Counterparty)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeCounterparty)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeCounterparty))
+			}
+		}
+	} else {
+		s, ok := maybeCounterparty.(*[]*Counterparty)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeCounterparty)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeCounterparty))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &counterpartyR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &counterpartyR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`transactions`),
+		qm.WhereIn(`transactions.counterparty_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load transactions")
+	}
+
+	var resultSlice []*Transaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice transactions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on transactions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transactions")
+	}
+
+	if len(transactionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Transactions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &transactionR{}
+			}
+			foreign.R.Counterparty = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CounterpartyID) {
+				local.R.Transactions = append(local.R.Transactions, foreign)
+				if foreign.R == nil {
+					foreign.R = &transactionR{}
+				}
+				foreign.R.Counterparty = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetLedger of the counterparty to the related item.
+// Sets o.R.Ledger to related.
+// Adds o to related.R.Counterparties.
+func (o *Counterparty) SetLedger(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Ledger) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"counterparties\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"ledger_id"}),
+		strmangle.WhereClause("\"", "\"", 0, counterpartyPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.LedgerID, related.ID)
+	if o.R == nil {
+		o.R = &counterpartyR{
+			Ledger: related,
+		}
+	} else {
+		o.R.Ledger = related
+	}
+
+	if related.R == nil {
+		related.R = &ledgerR{
+			Counterparties: CounterpartySlice{o},
+		}
+	} else {
+		related.R.Counterparties = append(related.R.Counterparties, o)
+	}
+
+	return nil
+}
+
+// AddTransactions adds the given related objects to the existing relationships
+// of the counterparty, optionally inserting them as new records.
+// Appends related to o.R.Transactions.
+// Sets related.R.Counterparty appropriately.
+func (o *Counterparty) AddTransactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Transaction) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CounterpartyID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"transactions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"counterparty_id"}),
+				strmangle.WhereClause("\"", "\"", 0, transactionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.CounterpartyID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &counterpartyR{
+			Transactions: related,
+		}
+	} else {
+		o.R.Transactions = append(o.R.Transactions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &transactionR{
+				Counterparty: o,
+			}
+		} else {
+			rel.R.Counterparty = o
+		}
+	}
+	return nil
+}
+
+// SetTransactions removes all previously related items of the
+// counterparty replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Counterparty's Transactions accordingly.
+// Replaces o.R.Transactions with related.
+// Sets related.R.Counterparty's Transactions accordingly.
+func (o *Counterparty) SetTransactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Transaction) error {
+	query := "update \"transactions\" set \"counterparty_id\" = null where \"counterparty_id\" = ?"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Transactions {
+			queries.SetScanner(&rel.CounterpartyID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Counterparty = nil
+		}
+		o.R.Transactions = nil
+	}
+
+	return o.AddTransactions(ctx, exec, insert, related...)
+}
+
+// RemoveTransactions relationships from objects passed in.
+// Removes related items from R.Transactions (uses pointer comparison, removal does not keep order)
+// Sets related.R.Counterparty.
+func (o *Counterparty) RemoveTransactions(ctx context.Context, exec boil.ContextExecutor, related ...*Transaction) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CounterpartyID, nil)
+		if rel.R != nil {
+			rel.R.Counterparty = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("counterparty_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Transactions {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Transactions)
+			if ln > 1 && i < ln-1 {
+				o.R.Transactions[i] = o.R.Transactions[ln-1]
+			}
+			o.R.Transactions = o.R.Transactions[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// Counterparties retrieves all the records using an executor.
+func Counterparties(mods ...qm.QueryMod) counterpartyQuery {
+	mods = append(mods, qm.From("\"counterparties\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"counterparties\".*"})
+	}
+
+	return counterpartyQuery{q}
+}
+
+// FindCounterparty retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindCounterparty(ctx context.Context, exec boil.ContextExecutor, iD null.Int64, selectCols ...string) (*Counterparty, error) {
+	counterpartyObj := &Counterparty{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"counterparties\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, counterpartyObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from counterparties")
+	}
+
+	if err = counterpartyObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return counterpartyObj, err
+	}
+
+	return counterpartyObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Counterparty) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no counterparties provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(counterpartyColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	counterpartyInsertCacheMut.RLock()
+	cache, cached := counterpartyInsertCache[key]
+	counterpartyInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			counterpartyAllColumns,
+			counterpartyColumnsWithDefault,
+			counterpartyColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, counterpartyGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(counterpartyType, counterpartyMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(counterpartyType, counterpartyMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"counterparties\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"counterparties\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into counterparties")
+	}
+
+	if !cached {
+		counterpartyInsertCacheMut.Lock()
+		counterpartyInsertCache[key] = cache
+		counterpartyInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Counterparty.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Counterparty) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	counterpartyUpdateCacheMut.RLock()
+	cache, cached := counterpartyUpdateCache[key]
+	counterpartyUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			counterpartyAllColumns,
+			counterpartyPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, counterpartyGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update counterparties, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"counterparties\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, counterpartyPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(counterpartyType, counterpartyMapping, append(wl, counterpartyPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update counterparties row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for counterparties")
+	}
+
+	if !cached {
+		counterpartyUpdateCacheMut.Lock()
+		counterpartyUpdateCache[key] = cache
+		counterpartyUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q counterpartyQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for counterparties")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for counterparties")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o CounterpartySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), counterpartyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"counterparties\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, counterpartyPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in counterparty slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all counterparty")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Counterparty) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no counterparties provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(counterpartyColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	counterpartyUpsertCacheMut.RLock()
+	cache, cached := counterpartyUpsertCache[key]
+	counterpartyUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			counterpartyAllColumns,
+			counterpartyColumnsWithDefault,
+			counterpartyColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			counterpartyAllColumns,
+			counterpartyPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert counterparties, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(counterpartyAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(counterpartyPrimaryKeyColumns))
+			copy(conflict, counterpartyPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"counterparties\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(counterpartyType, counterpartyMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(counterpartyType, counterpartyMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert counterparties")
+	}
+
+	if !cached {
+		counterpartyUpsertCacheMut.Lock()
+		counterpartyUpsertCache[key] = cache
+		counterpartyUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Counterparty record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Counterparty) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Counterparty provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), counterpartyPrimaryKeyMapping)
+	sql := "DELETE FROM \"counterparties\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from counterparties")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for counterparties")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q counterpartyQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no counterpartyQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from counterparties")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for counterparties")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o CounterpartySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(counterpartyBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), counterpartyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"counterparties\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, counterpartyPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from counterparty slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for counterparties")
+	}
+
+	if len(counterpartyAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Counterparty) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindCounterparty(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *CounterpartySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := CounterpartySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), counterpartyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"counterparties\".* FROM \"counterparties\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, counterpartyPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in CounterpartySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// CounterpartyExists checks if the Counterparty row exists.
+func CounterpartyExists(ctx context.Context, exec boil.ContextExecutor, iD null.Int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"counterparties\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if counterparties exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Counterparty row exists.
+func (o *Counterparty) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return CounterpartyExists(ctx, exec, o.ID)
+}
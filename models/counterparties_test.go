@@ -0,0 +1,1179 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testCounterparties(t *testing.T) {
+	t.Parallel()
+
+	query := Counterparties()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testCounterpartiesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Counterparty{}
+	if err = randomize.Struct(seed, o, counterpartyDBTypes, true, counterpartyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Counterparty struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Counterparties().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCounterpartiesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Counterparty{}
+	if err = randomize.Struct(seed, o, counterpartyDBTypes, true, counterpartyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Counterparty struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Counterparties().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Counterparties().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCounterpartiesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Counterparty{}
+	if err = randomize.Struct(seed, o, counterpartyDBTypes, true, counterpartyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Counterparty struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := CounterpartySlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Counterparties().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCounterpartiesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Counterparty{}
+	if err = randomize.Struct(seed, o, counterpartyDBTypes, true, counterpartyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Counterparty struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := CounterpartyExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if Counterparty exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected CounterpartyExists to return true, but got false.")
+	}
+}
+
+func testCounterpartiesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Counterparty{}
+	if err = randomize.Struct(seed, o, counterpartyDBTypes, true, counterpartyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Counterparty struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	counterpartyFound, err := FindCounterparty(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if counterpartyFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testCounterpartiesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Counterparty{}
+	if err = randomize.Struct(seed, o, counterpartyDBTypes, true, counterpartyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Counterparty struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Counterparties().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCounterpartiesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Counterparty{}
+	if err = randomize.Struct(seed, o, counterpartyDBTypes, true, counterpartyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Counterparty struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Counterparties().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testCounterpartiesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	counterpartyOne := &Counterparty{}
+	counterpartyTwo := &Counterparty{}
+	if err = randomize.Struct(seed, counterpartyOne, counterpartyDBTypes, false, counterpartyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Counterparty struct: %s", err)
+	}
+	if err = randomize.Struct(seed, counterpartyTwo, counterpartyDBTypes, false, counterpartyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Counterparty struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = counterpartyOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = counterpartyTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Counterparties().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testCounterpartiesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	counterpartyOne := &Counterparty{}
+	counterpartyTwo := &Counterparty{}
+	if err = randomize.Struct(seed, counterpartyOne, counterpartyDBTypes, false, counterpartyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Counterparty struct: %s", err)
+	}
+	if err = randomize.Struct(seed, counterpartyTwo, counterpartyDBTypes, false, counterpartyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Counterparty struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = counterpartyOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = counterpartyTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Counterparties().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func counterpartyBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *Counterparty) error {
+	*o = Counterparty{}
+	return nil
+}
+
+func counterpartyAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *Counterparty) error {
+	*o = Counterparty{}
+	return nil
+}
+
+func counterpartyAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *Counterparty) error {
+	*o = Counterparty{}
+	return nil
+}
+
+func counterpartyBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Counterparty) error {
+	*o = Counterparty{}
+	return nil
+}
+
+func counterpartyAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Counterparty) error {
+	*o = Counterparty{}
+	return nil
+}
+
+func counterpartyBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Counterparty) error {
+	*o = Counterparty{}
+	return nil
+}
+
+func counterpartyAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Counterparty) error {
+	*o = Counterparty{}
+	return nil
+}
+
+func counterpartyBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Counterparty) error {
+	*o = Counterparty{}
+	return nil
+}
+
+func counterpartyAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Counterparty) error {
+	*o = Counterparty{}
+	return nil
+}
+
+func testCounterpartiesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &Counterparty{}
+	o := &Counterparty{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, counterpartyDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize Counterparty object: %s", err)
+	}
+
+	AddCounterpartyHook(boil.BeforeInsertHook, counterpartyBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	counterpartyBeforeInsertHooks = []CounterpartyHook{}
+
+	AddCounterpartyHook(boil.AfterInsertHook, counterpartyAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	counterpartyAfterInsertHooks = []CounterpartyHook{}
+
+	AddCounterpartyHook(boil.AfterSelectHook, counterpartyAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	counterpartyAfterSelectHooks = []CounterpartyHook{}
+
+	AddCounterpartyHook(boil.BeforeUpdateHook, counterpartyBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	counterpartyBeforeUpdateHooks = []CounterpartyHook{}
+
+	AddCounterpartyHook(boil.AfterUpdateHook, counterpartyAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	counterpartyAfterUpdateHooks = []CounterpartyHook{}
+
+	AddCounterpartyHook(boil.BeforeDeleteHook, counterpartyBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	counterpartyBeforeDeleteHooks = []CounterpartyHook{}
+
+	AddCounterpartyHook(boil.AfterDeleteHook, counterpartyAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	counterpartyAfterDeleteHooks = []CounterpartyHook{}
+
+	AddCounterpartyHook(boil.BeforeUpsertHook, counterpartyBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	counterpartyBeforeUpsertHooks = []CounterpartyHook{}
+
+	AddCounterpartyHook(boil.AfterUpsertHook, counterpartyAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	counterpartyAfterUpsertHooks = []CounterpartyHook{}
+}
+
+func testCounterpartiesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Counterparty{}
+	if err = randomize.Struct(seed, o, counterpartyDBTypes, true, counterpartyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Counterparty struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Counterparties().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testCounterpartiesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Counterparty{}
+	if err = randomize.Struct(seed, o, counterpartyDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Counterparty struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(counterpartyPrimaryKeyColumns, counterpartyColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Counterparties().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testCounterpartyToManyTransactions(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Counterparty
+	var b, c Transaction
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, counterpartyDBTypes, true, counterpartyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Counterparty struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, transactionDBTypes, false, transactionColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, transactionDBTypes, false, transactionColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.CounterpartyID, a.ID)
+	queries.Assign(&c.CounterpartyID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Transactions().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.CounterpartyID, b.CounterpartyID) {
+			bFound = true
+		}
+		if queries.Equal(v.CounterpartyID, c.CounterpartyID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := CounterpartySlice{&a}
+	if err = a.L.LoadTransactions(ctx, tx, false, (*[]*Counterparty)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Transactions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Transactions = nil
+	if err = a.L.LoadTransactions(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Transactions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testCounterpartyToManyAddOpTransactions(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Counterparty
+	var b, c, d, e Transaction
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, counterpartyDBTypes, false, strmangle.SetComplement(counterpartyPrimaryKeyColumns, counterpartyColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Transaction{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Transaction{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddTransactions(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.CounterpartyID) {
+			t.Error("foreign key was wrong value", a.ID, first.CounterpartyID)
+		}
+		if !queries.Equal(a.ID, second.CounterpartyID) {
+			t.Error("foreign key was wrong value", a.ID, second.CounterpartyID)
+		}
+
+		if first.R.Counterparty != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Counterparty != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Transactions[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Transactions[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Transactions().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testCounterpartyToManySetOpTransactions(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Counterparty
+	var b, c, d, e Transaction
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, counterpartyDBTypes, false, strmangle.SetComplement(counterpartyPrimaryKeyColumns, counterpartyColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Transaction{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetTransactions(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Transactions().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetTransactions(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Transactions().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.CounterpartyID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.CounterpartyID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.CounterpartyID) {
+		t.Error("foreign key was wrong value", a.ID, d.CounterpartyID)
+	}
+	if !queries.Equal(a.ID, e.CounterpartyID) {
+		t.Error("foreign key was wrong value", a.ID, e.CounterpartyID)
+	}
+
+	if b.R.Counterparty != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Counterparty != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Counterparty != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Counterparty != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.Transactions[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.Transactions[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testCounterpartyToManyRemoveOpTransactions(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Counterparty
+	var b, c, d, e Transaction
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, counterpartyDBTypes, false, strmangle.SetComplement(counterpartyPrimaryKeyColumns, counterpartyColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Transaction{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddTransactions(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Transactions().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveTransactions(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Transactions().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.CounterpartyID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.CounterpartyID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Counterparty != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Counterparty != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Counterparty != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Counterparty != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.Transactions) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.Transactions[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.Transactions[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testCounterpartyToOneLedgerUsingLedger(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Counterparty
+	var foreign Ledger
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, counterpartyDBTypes, false, counterpartyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Counterparty struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, ledgerDBTypes, true, ledgerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Ledger struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.LedgerID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Ledger().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddLedgerHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Ledger) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := CounterpartySlice{&local}
+	if err = local.L.LoadLedger(ctx, tx, false, (*[]*Counterparty)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Ledger == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Ledger = nil
+	if err = local.L.LoadLedger(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Ledger == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testCounterpartyToOneSetOpLedgerUsingLedger(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Counterparty
+	var b, c Ledger
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, counterpartyDBTypes, false, strmangle.SetComplement(counterpartyPrimaryKeyColumns, counterpartyColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, ledgerDBTypes, false, strmangle.SetComplement(ledgerPrimaryKeyColumns, ledgerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, ledgerDBTypes, false, strmangle.SetComplement(ledgerPrimaryKeyColumns, ledgerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Ledger{&b, &c} {
+		err = a.SetLedger(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Ledger != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Counterparties[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.LedgerID, x.ID) {
+			t.Error("foreign key was wrong value", a.LedgerID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.LedgerID))
+		reflect.Indirect(reflect.ValueOf(&a.LedgerID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.LedgerID, x.ID) {
+			t.Error("foreign key was wrong value", a.LedgerID, x.ID)
+		}
+	}
+}
+
+func testCounterpartiesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Counterparty{}
+	if err = randomize.Struct(seed, o, counterpartyDBTypes, true, counterpartyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Counterparty struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCounterpartiesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Counterparty{}
+	if err = randomize.Struct(seed, o, counterpartyDBTypes, true, counterpartyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Counterparty struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := CounterpartySlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCounterpartiesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Counterparty{}
+	if err = randomize.Struct(seed, o, counterpartyDBTypes, true, counterpartyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Counterparty struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Counterparties().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	counterpartyDBTypes = map[string]string{`ID`: `INTEGER`, `LedgerID`: `INTEGER`, `Name`: `TEXT`, `DueDate`: `DATETIME`, `Chat`: `TEXT`, `RemindedAt`: `DATETIME`, `CreatedAt`: `DATETIME`}
+	_                   = bytes.MinRead
+)
+
+func testCounterpartiesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(counterpartyPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(counterpartyAllColumns) == len(counterpartyPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Counterparty{}
+	if err = randomize.Struct(seed, o, counterpartyDBTypes, true, counterpartyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Counterparty struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Counterparties().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, counterpartyDBTypes, true, counterpartyPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Counterparty struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testCounterpartiesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(counterpartyAllColumns) == len(counterpartyPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Counterparty{}
+	if err = randomize.Struct(seed, o, counterpartyDBTypes, true, counterpartyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Counterparty struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Counterparties().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, counterpartyDBTypes, true, counterpartyPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Counterparty struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(counterpartyAllColumns, counterpartyPrimaryKeyColumns) {
+		fields = counterpartyAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			counterpartyAllColumns,
+			counterpartyPrimaryKeyColumns,
+		)
+		fields = strmangle.SetComplement(fields, counterpartyGeneratedColumns)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := CounterpartySlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testCounterpartiesUpsert(t *testing.T) {
+	t.Parallel()
+	if len(counterpartyAllColumns) == len(counterpartyPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := Counterparty{}
+	if err = randomize.Struct(seed, &o, counterpartyDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Counterparty struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Counterparty: %s", err)
+	}
+
+	count, err := Counterparties().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, counterpartyDBTypes, false, counterpartyPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Counterparty struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Counterparty: %s", err)
+	}
+
+	count, err = Counterparties().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1443 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// Goal is an object representing the database table.
+type Goal struct {
+	ID        null.Int64 `boil:"id" json:"id,omitempty" toml:"id" yaml:"id,omitempty"`
+	LedgerID  int64      `boil:"ledger_id" json:"ledger_id" toml:"ledger_id" yaml:"ledger_id"`
+	Name      string     `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Target    int64      `boil:"target" json:"target" toml:"target" yaml:"target"`
+	Deadline  null.Time  `boil:"deadline" json:"deadline,omitempty" toml:"deadline" yaml:"deadline,omitempty"`
+	CreatedAt time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *goalR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L goalL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var GoalColumns = struct {
+	ID        string
+	LedgerID  string
+	Name      string
+	Target    string
+	Deadline  string
+	CreatedAt string
+}{
+	ID:        "id",
+	LedgerID:  "ledger_id",
+	Name:      "name",
+	Target:    "target",
+	Deadline:  "deadline",
+	CreatedAt: "created_at",
+}
+
+var GoalTableColumns = struct {
+	ID        string
+	LedgerID  string
+	Name      string
+	Target    string
+	Deadline  string
+	CreatedAt string
+}{
+	ID:        "goals.id",
+	LedgerID:  "goals.ledger_id",
+	Name:      "goals.name",
+	Target:    "goals.target",
+	Deadline:  "goals.deadline",
+	CreatedAt: "goals.created_at",
+}
+
+// Generated where
+
+var GoalWhere = struct {
+	ID        whereHelpernull_Int64
+	LedgerID  whereHelperint64
+	Name      whereHelperstring
+	Target    whereHelperint64
+	Deadline  whereHelpernull_Time
+	CreatedAt whereHelpertime_Time
+}{
+	ID:        whereHelpernull_Int64{field: "\"goals\".\"id\""},
+	LedgerID:  whereHelperint64{field: "\"goals\".\"ledger_id\""},
+	Name:      whereHelperstring{field: "\"goals\".\"name\""},
+	Target:    whereHelperint64{field: "\"goals\".\"target\""},
+	Deadline:  whereHelpernull_Time{field: "\"goals\".\"deadline\""},
+	CreatedAt: whereHelpertime_Time{field: "\"goals\".\"created_at\""},
+}
+
+// GoalRels is where relationship names are stored.
+var GoalRels = struct {
+	Ledger       string
+	Transactions string
+}{
+	Ledger:       "Ledger",
+	Transactions: "Transactions",
+}
+
+// goalR is where relationships are stored.
+type goalR struct {
+	Ledger       *Ledger          `boil:"Ledger" json:"Ledger" toml:"Ledger" yaml:"Ledger"`
+	Transactions TransactionSlice `boil:"Transactions" json:"Transactions" toml:"Transactions" yaml:"Transactions"`
+}
+
+// NewStruct creates a new relationship struct
+func (*goalR) NewStruct() *goalR {
+	return &goalR{}
+}
+
+func (o *Goal) GetLedger() *Ledger {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetLedger()
+}
+
+func (r *goalR) GetLedger() *Ledger {
+	if r == nil {
+		return nil
+	}
+
+	return r.Ledger
+}
+
+func (o *Goal) GetTransactions() TransactionSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTransactions()
+}
+
+func (r *goalR) GetTransactions() TransactionSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Transactions
+}
+
+// goalL is where Load methods for each relationship are stored.
+type goalL struct{}
+
+var (
+	goalAllColumns            = []string{"id", "ledger_id", "name", "target", "deadline", "created_at"}
+	goalColumnsWithoutDefault = []string{"ledger_id", "name", "target"}
+	goalColumnsWithDefault    = []string{"id", "deadline", "created_at"}
+	goalPrimaryKeyColumns     = []string{"id"}
+	goalGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// GoalSlice is an alias for a slice of pointers to Goal.
+	// This should almost always be used instead of []Goal.
+	GoalSlice []*Goal
+	// GoalHook is the signature for custom Goal hook methods
+	GoalHook func(context.Context, boil.ContextExecutor, *Goal) error
+
+	goalQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	goalType                 = reflect.TypeOf(&Goal{})
+	goalMapping              = queries.MakeStructMapping(goalType)
+	goalPrimaryKeyMapping, _ = queries.BindMapping(goalType, goalMapping, goalPrimaryKeyColumns)
+	goalInsertCacheMut       sync.RWMutex
+	goalInsertCache          = make(map[string]insertCache)
+	goalUpdateCacheMut       sync.RWMutex
+	goalUpdateCache          = make(map[string]updateCache)
+	goalUpsertCacheMut       sync.RWMutex
+	goalUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var goalAfterSelectMu sync.Mutex
+var goalAfterSelectHooks []GoalHook
+
+var goalBeforeInsertMu sync.Mutex
+var goalBeforeInsertHooks []GoalHook
+var goalAfterInsertMu sync.Mutex
+var goalAfterInsertHooks []GoalHook
+
+var goalBeforeUpdateMu sync.Mutex
+var goalBeforeUpdateHooks []GoalHook
+var goalAfterUpdateMu sync.Mutex
+var goalAfterUpdateHooks []GoalHook
+
+var goalBeforeDeleteMu sync.Mutex
+var goalBeforeDeleteHooks []GoalHook
+var goalAfterDeleteMu sync.Mutex
+var goalAfterDeleteHooks []GoalHook
+
+var goalBeforeUpsertMu sync.Mutex
+var goalBeforeUpsertHooks []GoalHook
+var goalAfterUpsertMu sync.Mutex
+var goalAfterUpsertHooks []GoalHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Goal) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range goalAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Goal) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range goalBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Goal) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range goalAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Goal) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range goalBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Goal) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range goalAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Goal) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range goalBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Goal) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range goalAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Goal) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range goalBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Goal) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range goalAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddGoalHook registers your hook function for all future operations.
+func AddGoalHook(hookPoint boil.HookPoint, goalHook GoalHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		goalAfterSelectMu.Lock()
+		goalAfterSelectHooks = append(goalAfterSelectHooks, goalHook)
+		goalAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		goalBeforeInsertMu.Lock()
+		goalBeforeInsertHooks = append(goalBeforeInsertHooks, goalHook)
+		goalBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		goalAfterInsertMu.Lock()
+		goalAfterInsertHooks = append(goalAfterInsertHooks, goalHook)
+		goalAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		goalBeforeUpdateMu.Lock()
+		goalBeforeUpdateHooks = append(goalBeforeUpdateHooks, goalHook)
+		goalBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		goalAfterUpdateMu.Lock()
+		goalAfterUpdateHooks = append(goalAfterUpdateHooks, goalHook)
+		goalAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		goalBeforeDeleteMu.Lock()
+		goalBeforeDeleteHooks = append(goalBeforeDeleteHooks, goalHook)
+		goalBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		goalAfterDeleteMu.Lock()
+		goalAfterDeleteHooks = append(goalAfterDeleteHooks, goalHook)
+		goalAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		goalBeforeUpsertMu.Lock()
+		goalBeforeUpsertHooks = append(goalBeforeUpsertHooks, goalHook)
+		goalBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		goalAfterUpsertMu.Lock()
+		goalAfterUpsertHooks = append(goalAfterUpsertHooks, goalHook)
+		goalAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single goal record from the query.
+func (q goalQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Goal, error) {
+	o := &Goal{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for goals")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Goal records from the query.
+func (q goalQuery) All(ctx context.Context, exec boil.ContextExecutor) (GoalSlice, error) {
+	var o []*Goal
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Goal slice")
+	}
+
+	if len(goalAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Goal records in the query.
+func (q goalQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count goals rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q goalQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if goals exists")
+	}
+
+	return count > 0, nil
+}
+
+// Ledger pointed to by the foreign key.
+func (o *Goal) Ledger(mods ...qm.QueryMod) ledgerQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.LedgerID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Ledgers(queryMods...)
+}
+
+// Transactions retrieves all the transaction's Transactions with an executor.
+func (o *Goal) Transactions(mods ...qm.QueryMod) transactionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"transactions\".\"goal_id\"=?", o.ID),
+	)
+
+	return Transactions(queryMods...)
+}
+
+// LoadLedger allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (goalL) LoadLedger(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGoal interface{}, mods queries.Applicator) error {
+	var slice []*Goal
+	var object *Goal
+
+	if singular {
+		var ok bool
+		object, ok = maybeGoal.(*Goal)
+		if !ok {
+			object = new(Goal)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeGoal)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeGoal))
+			}
+		}
+	} else {
+		s, ok := maybeGoal.(*[]*Goal)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeGoal)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeGoal))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &goalR{}
+		}
+		if !queries.IsNil(object.LedgerID) {
+			args[object.LedgerID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &goalR{}
+			}
+
+			if !queries.IsNil(obj.LedgerID) {
+				args[obj.LedgerID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`ledgers`),
+		qm.WhereIn(`ledgers.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Ledger")
+	}
+
+	var resultSlice []*Ledger
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Ledger")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for ledgers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for ledgers")
+	}
+
+	if len(ledgerAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Ledger = foreign
+		if foreign.R == nil {
+			foreign.R = &ledgerR{}
+		}
+		foreign.R.Goals = append(foreign.R.Goals, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.LedgerID, foreign.ID) {
+				local.R.Ledger = foreign
+				if foreign.R == nil {
+					foreign.R = &ledgerR{}
+				}
+				foreign.R.Goals = append(foreign.R.Goals, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTransactions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (goalL) LoadTransactions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGoal interface{}, mods queries.Applicator) error {
+	var slice []*Goal
+	var object *Goal
+
+	if singular {
+		var ok bool
+		object, ok = maybeGoal.(*Goal)
+		if !ok {
+			object = new(Goal)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeGoal)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeGoal))
+			}
+		}
+	} else {
+		s, ok := maybeGoal.(*[]*Goal)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeGoal)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeGoal))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &goalR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &goalR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`transactions`),
+		qm.WhereIn(`transactions.goal_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load transactions")
+	}
+
+	var resultSlice []*Transaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice transactions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on transactions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transactions")
+	}
+
+	if len(transactionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Transactions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &transactionR{}
+			}
+			foreign.R.Goal = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.GoalID) {
+				local.R.Transactions = append(local.R.Transactions, foreign)
+				if foreign.R == nil {
+					foreign.R = &transactionR{}
+				}
+				foreign.R.Goal = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetLedger of the goal to the related item.
+// Sets o.R.Ledger to related.
+// Adds o to related.R.Goals.
+func (o *Goal) SetLedger(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Ledger) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"goals\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"ledger_id"}),
+		strmangle.WhereClause("\"", "\"", 0, goalPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.LedgerID, related.ID)
+	if o.R == nil {
+		o.R = &goalR{
+			Ledger: related,
+		}
+	} else {
+		o.R.Ledger = related
+	}
+
+	if related.R == nil {
+		related.R = &ledgerR{
+			Goals: GoalSlice{o},
+		}
+	} else {
+		related.R.Goals = append(related.R.Goals, o)
+	}
+
+	return nil
+}
+
+// AddTransactions adds the given related objects to the existing relationships
+// of the goal, optionally inserting them as new records.
+// Appends related to o.R.Transactions.
+// Sets related.R.Goal appropriately.
+func (o *Goal) AddTransactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Transaction) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.GoalID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"transactions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"goal_id"}),
+				strmangle.WhereClause("\"", "\"", 0, transactionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.GoalID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &goalR{
+			Transactions: related,
+		}
+	} else {
+		o.R.Transactions = append(o.R.Transactions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &transactionR{
+				Goal: o,
+			}
+		} else {
+			rel.R.Goal = o
+		}
+	}
+	return nil
+}
+
+// SetTransactions removes all previously related items of the
+// goal replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Goal's Transactions accordingly.
+// Replaces o.R.Transactions with related.
+// Sets related.R.Goal's Transactions accordingly.
+func (o *Goal) SetTransactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Transaction) error {
+	query := "update \"transactions\" set \"goal_id\" = null where \"goal_id\" = ?"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Transactions {
+			queries.SetScanner(&rel.GoalID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Goal = nil
+		}
+		o.R.Transactions = nil
+	}
+
+	return o.AddTransactions(ctx, exec, insert, related...)
+}
+
+// RemoveTransactions relationships from objects passed in.
+// Removes related items from R.Transactions (uses pointer comparison, removal does not keep order)
+// Sets related.R.Goal.
+func (o *Goal) RemoveTransactions(ctx context.Context, exec boil.ContextExecutor, related ...*Transaction) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.GoalID, nil)
+		if rel.R != nil {
+			rel.R.Goal = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("goal_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Transactions {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Transactions)
+			if ln > 1 && i < ln-1 {
+				o.R.Transactions[i] = o.R.Transactions[ln-1]
+			}
+			o.R.Transactions = o.R.Transactions[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// Goals retrieves all the records using an executor.
+func Goals(mods ...qm.QueryMod) goalQuery {
+	mods = append(mods, qm.From("\"goals\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"goals\".*"})
+	}
+
+	return goalQuery{q}
+}
+
+// FindGoal retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindGoal(ctx context.Context, exec boil.ContextExecutor, iD null.Int64, selectCols ...string) (*Goal, error) {
+	goalObj := &Goal{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"goals\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, goalObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from goals")
+	}
+
+	if err = goalObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return goalObj, err
+	}
+
+	return goalObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Goal) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no goals provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(goalColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	goalInsertCacheMut.RLock()
+	cache, cached := goalInsertCache[key]
+	goalInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			goalAllColumns,
+			goalColumnsWithDefault,
+			goalColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, goalGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(goalType, goalMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(goalType, goalMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"goals\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"goals\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into goals")
+	}
+
+	if !cached {
+		goalInsertCacheMut.Lock()
+		goalInsertCache[key] = cache
+		goalInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Goal.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Goal) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	goalUpdateCacheMut.RLock()
+	cache, cached := goalUpdateCache[key]
+	goalUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			goalAllColumns,
+			goalPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, goalGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update goals, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"goals\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, goalPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(goalType, goalMapping, append(wl, goalPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update goals row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for goals")
+	}
+
+	if !cached {
+		goalUpdateCacheMut.Lock()
+		goalUpdateCache[key] = cache
+		goalUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q goalQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for goals")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for goals")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o GoalSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), goalPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"goals\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, goalPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in goal slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all goal")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Goal) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no goals provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(goalColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	goalUpsertCacheMut.RLock()
+	cache, cached := goalUpsertCache[key]
+	goalUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			goalAllColumns,
+			goalColumnsWithDefault,
+			goalColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			goalAllColumns,
+			goalPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert goals, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(goalAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(goalPrimaryKeyColumns))
+			copy(conflict, goalPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"goals\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(goalType, goalMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(goalType, goalMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert goals")
+	}
+
+	if !cached {
+		goalUpsertCacheMut.Lock()
+		goalUpsertCache[key] = cache
+		goalUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Goal record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Goal) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Goal provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), goalPrimaryKeyMapping)
+	sql := "DELETE FROM \"goals\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from goals")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for goals")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q goalQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no goalQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from goals")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for goals")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o GoalSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(goalBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), goalPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"goals\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, goalPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from goal slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for goals")
+	}
+
+	if len(goalAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Goal) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindGoal(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *GoalSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := GoalSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), goalPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"goals\".* FROM \"goals\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, goalPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in GoalSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// GoalExists checks if the Goal row exists.
+func GoalExists(ctx context.Context, exec boil.ContextExecutor, iD null.Int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"goals\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if goals exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Goal row exists.
+func (o *Goal) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return GoalExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,2614 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// Ledger is an object representing the database table.
+type Ledger struct {
+	ID        null.Int64 `boil:"id" json:"id,omitempty" toml:"id" yaml:"id,omitempty"`
+	Name      string     `boil:"name" json:"name" toml:"name" yaml:"name"`
+	CreatedAt time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *ledgerR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L ledgerL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var LedgerColumns = struct {
+	ID        string
+	Name      string
+	CreatedAt string
+}{
+	ID:        "id",
+	Name:      "name",
+	CreatedAt: "created_at",
+}
+
+var LedgerTableColumns = struct {
+	ID        string
+	Name      string
+	CreatedAt string
+}{
+	ID:        "ledgers.id",
+	Name:      "ledgers.name",
+	CreatedAt: "ledgers.created_at",
+}
+
+// Generated where
+
+var LedgerWhere = struct {
+	ID        whereHelpernull_Int64
+	Name      whereHelperstring
+	CreatedAt whereHelpertime_Time
+}{
+	ID:        whereHelpernull_Int64{field: "\"ledgers\".\"id\""},
+	Name:      whereHelperstring{field: "\"ledgers\".\"name\""},
+	CreatedAt: whereHelpertime_Time{field: "\"ledgers\".\"created_at\""},
+}
+
+// LedgerRels is where relationship names are stored.
+var LedgerRels = struct {
+	Batches               string
+	Budgets               string
+	Chats                 string
+	Counterparties        string
+	Goals                 string
+	Members               string
+	RecurringTransactions string
+	Transactions          string
+}{
+	Batches:               "Batches",
+	Budgets:               "Budgets",
+	Chats:                 "Chats",
+	Counterparties:        "Counterparties",
+	Goals:                 "Goals",
+	Members:               "Members",
+	RecurringTransactions: "RecurringTransactions",
+	Transactions:          "Transactions",
+}
+
+// ledgerR is where relationships are stored.
+type ledgerR struct {
+	Batches               BatchSlice                `boil:"Batches" json:"Batches" toml:"Batches" yaml:"Batches"`
+	Budgets               BudgetSlice               `boil:"Budgets" json:"Budgets" toml:"Budgets" yaml:"Budgets"`
+	Chats                 ChatSlice                 `boil:"Chats" json:"Chats" toml:"Chats" yaml:"Chats"`
+	Counterparties        CounterpartySlice         `boil:"Counterparties" json:"Counterparties" toml:"Counterparties" yaml:"Counterparties"`
+	Goals                 GoalSlice                 `boil:"Goals" json:"Goals" toml:"Goals" yaml:"Goals"`
+	Members               MemberSlice               `boil:"Members" json:"Members" toml:"Members" yaml:"Members"`
+	RecurringTransactions RecurringTransactionSlice `boil:"RecurringTransactions" json:"RecurringTransactions" toml:"RecurringTransactions" yaml:"RecurringTransactions"`
+	Transactions          TransactionSlice          `boil:"Transactions" json:"Transactions" toml:"Transactions" yaml:"Transactions"`
+}
+
+// NewStruct creates a new relationship struct
+func (*ledgerR) NewStruct() *ledgerR {
+	return &ledgerR{}
+}
+
+func (o *Ledger) GetBatches() BatchSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetBatches()
+}
+
+func (r *ledgerR) GetBatches() BatchSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Batches
+}
+
+func (o *Ledger) GetBudgets() BudgetSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetBudgets()
+}
+
+func (r *ledgerR) GetBudgets() BudgetSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Budgets
+}
+
+func (o *Ledger) GetChats() ChatSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetChats()
+}
+
+func (r *ledgerR) GetChats() ChatSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Chats
+}
+
+func (o *Ledger) GetCounterparties() CounterpartySlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCounterparties()
+}
+
+func (r *ledgerR) GetCounterparties() CounterpartySlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Counterparties
+}
+
+func (o *Ledger) GetGoals() GoalSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetGoals()
+}
+
+func (r *ledgerR) GetGoals() GoalSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Goals
+}
+
+func (o *Ledger) GetMembers() MemberSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetMembers()
+}
+
+func (r *ledgerR) GetMembers() MemberSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Members
+}
+
+func (o *Ledger) GetRecurringTransactions() RecurringTransactionSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetRecurringTransactions()
+}
+
+func (r *ledgerR) GetRecurringTransactions() RecurringTransactionSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.RecurringTransactions
+}
+
+func (o *Ledger) GetTransactions() TransactionSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTransactions()
+}
+
+func (r *ledgerR) GetTransactions() TransactionSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Transactions
+}
+
+// ledgerL is where Load methods for each relationship are stored.
+type ledgerL struct{}
+
+var (
+	ledgerAllColumns            = []string{"id", "name", "created_at"}
+	ledgerColumnsWithoutDefault = []string{"name"}
+	ledgerColumnsWithDefault    = []string{"id", "created_at"}
+	ledgerPrimaryKeyColumns     = []string{"id"}
+	ledgerGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// LedgerSlice is an alias for a slice of pointers to Ledger.
+	// This should almost always be used instead of []Ledger.
+	LedgerSlice []*Ledger
+	// LedgerHook is the signature for custom Ledger hook methods
+	LedgerHook func(context.Context, boil.ContextExecutor, *Ledger) error
+
+	ledgerQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	ledgerType                 = reflect.TypeOf(&Ledger{})
+	ledgerMapping              = queries.MakeStructMapping(ledgerType)
+	ledgerPrimaryKeyMapping, _ = queries.BindMapping(ledgerType, ledgerMapping, ledgerPrimaryKeyColumns)
+	ledgerInsertCacheMut       sync.RWMutex
+	ledgerInsertCache          = make(map[string]insertCache)
+	ledgerUpdateCacheMut       sync.RWMutex
+	ledgerUpdateCache          = make(map[string]updateCache)
+	ledgerUpsertCacheMut       sync.RWMutex
+	ledgerUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var ledgerAfterSelectMu sync.Mutex
+var ledgerAfterSelectHooks []LedgerHook
+
+var ledgerBeforeInsertMu sync.Mutex
+var ledgerBeforeInsertHooks []LedgerHook
+var ledgerAfterInsertMu sync.Mutex
+var ledgerAfterInsertHooks []LedgerHook
+
+var ledgerBeforeUpdateMu sync.Mutex
+var ledgerBeforeUpdateHooks []LedgerHook
+var ledgerAfterUpdateMu sync.Mutex
+var ledgerAfterUpdateHooks []LedgerHook
+
+var ledgerBeforeDeleteMu sync.Mutex
+var ledgerBeforeDeleteHooks []LedgerHook
+var ledgerAfterDeleteMu sync.Mutex
+var ledgerAfterDeleteHooks []LedgerHook
+
+var ledgerBeforeUpsertMu sync.Mutex
+var ledgerBeforeUpsertHooks []LedgerHook
+var ledgerAfterUpsertMu sync.Mutex
+var ledgerAfterUpsertHooks []LedgerHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Ledger) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ledgerAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Ledger) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ledgerBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Ledger) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ledgerAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Ledger) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ledgerBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Ledger) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ledgerAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Ledger) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ledgerBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Ledger) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ledgerAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Ledger) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ledgerBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Ledger) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ledgerAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddLedgerHook registers your hook function for all future operations.
+func AddLedgerHook(hookPoint boil.HookPoint, ledgerHook LedgerHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		ledgerAfterSelectMu.Lock()
+		ledgerAfterSelectHooks = append(ledgerAfterSelectHooks, ledgerHook)
+		ledgerAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		ledgerBeforeInsertMu.Lock()
+		ledgerBeforeInsertHooks = append(ledgerBeforeInsertHooks, ledgerHook)
+		ledgerBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		ledgerAfterInsertMu.Lock()
+		ledgerAfterInsertHooks = append(ledgerAfterInsertHooks, ledgerHook)
+		ledgerAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		ledgerBeforeUpdateMu.Lock()
+		ledgerBeforeUpdateHooks = append(ledgerBeforeUpdateHooks, ledgerHook)
+		ledgerBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		ledgerAfterUpdateMu.Lock()
+		ledgerAfterUpdateHooks = append(ledgerAfterUpdateHooks, ledgerHook)
+		ledgerAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		ledgerBeforeDeleteMu.Lock()
+		ledgerBeforeDeleteHooks = append(ledgerBeforeDeleteHooks, ledgerHook)
+		ledgerBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		ledgerAfterDeleteMu.Lock()
+		ledgerAfterDeleteHooks = append(ledgerAfterDeleteHooks, ledgerHook)
+		ledgerAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		ledgerBeforeUpsertMu.Lock()
+		ledgerBeforeUpsertHooks = append(ledgerBeforeUpsertHooks, ledgerHook)
+		ledgerBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		ledgerAfterUpsertMu.Lock()
+		ledgerAfterUpsertHooks = append(ledgerAfterUpsertHooks, ledgerHook)
+		ledgerAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single ledger record from the query.
+func (q ledgerQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Ledger, error) {
+	o := &Ledger{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for ledgers")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Ledger records from the query.
+func (q ledgerQuery) All(ctx context.Context, exec boil.ContextExecutor) (LedgerSlice, error) {
+	var o []*Ledger
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Ledger slice")
+	}
+
+	if len(ledgerAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Ledger records in the query.
+func (q ledgerQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count ledgers rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q ledgerQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if ledgers exists")
+	}
+
+	return count > 0, nil
+}
+
+// Batches retrieves all the batch's Batches with an executor.
+func (o *Ledger) Batches(mods ...qm.QueryMod) batchQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"batches\".\"ledger_id\"=?", o.ID),
+	)
+
+	return Batches(queryMods...)
+}
+
+// Budgets retrieves all the budget's Budgets with an executor.
+func (o *Ledger) Budgets(mods ...qm.QueryMod) budgetQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"budgets\".\"ledger_id\"=?", o.ID),
+	)
+
+	return Budgets(queryMods...)
+}
+
+// Chats retrieves all the chat's Chats with an executor.
+func (o *Ledger) Chats(mods ...qm.QueryMod) chatQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"chats\".\"ledger_id\"=?", o.ID),
+	)
+
+	return Chats(queryMods...)
+}
+
+// Counterparties retrieves all the counterparty's Counterparties with an executor.
+func (o *Ledger) Counterparties(mods ...qm.QueryMod) counterpartyQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"counterparties\".\"ledger_id\"=?", o.ID),
+	)
+
+	return Counterparties(queryMods...)
+}
+
+// Goals retrieves all the goal's Goals with an executor.
+func (o *Ledger) Goals(mods ...qm.QueryMod) goalQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"goals\".\"ledger_id\"=?", o.ID),
+	)
+
+	return Goals(queryMods...)
+}
+
+// Members retrieves all the member's Members with an executor.
+func (o *Ledger) Members(mods ...qm.QueryMod) memberQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"members\".\"ledger_id\"=?", o.ID),
+	)
+
+	return Members(queryMods...)
+}
+
+// RecurringTransactions retrieves all the recurring_transaction's RecurringTransactions with an executor.
+func (o *Ledger) RecurringTransactions(mods ...qm.QueryMod) recurringTransactionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"recurring_transactions\".\"ledger_id\"=?", o.ID),
+	)
+
+	return RecurringTransactions(queryMods...)
+}
+
+// Transactions retrieves all the transaction's Transactions with an executor.
+func (o *Ledger) Transactions(mods ...qm.QueryMod) transactionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"transactions\".\"ledger_id\"=?", o.ID),
+	)
+
+	return Transactions(queryMods...)
+}
+
+// LoadBatches allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (ledgerL) LoadBatches(ctx context.Context, e boil.ContextExecutor, singular bool, maybeLedger interface{}, mods queries.Applicator) error {
+	var slice []*Ledger
+	var object *Ledger
+
+	if singular {
+		var ok bool
+		object, ok = maybeLedger.(*Ledger)
+		if !ok {
+			object = new(Ledger)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeLedger)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeLedger))
+			}
+		}
+	} else {
+		s, ok := maybeLedger.(*[]*Ledger)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeLedger)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeLedger))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &ledgerR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &ledgerR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`batches`),
+		qm.WhereIn(`batches.ledger_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load batches")
+	}
+
+	var resultSlice []*Batch
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice batches")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on batches")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for batches")
+	}
+
+	if len(batchAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Batches = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &batchR{}
+			}
+			foreign.R.Ledger = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.LedgerID) {
+				local.R.Batches = append(local.R.Batches, foreign)
+				if foreign.R == nil {
+					foreign.R = &batchR{}
+				}
+				foreign.R.Ledger = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadBudgets allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (ledgerL) LoadBudgets(ctx context.Context, e boil.ContextExecutor, singular bool, maybeLedger interface{}, mods queries.Applicator) error {
+	var slice []*Ledger
+	var object *Ledger
+
+	if singular {
+		var ok bool
+		object, ok = maybeLedger.(*Ledger)
+		if !ok {
+			object = new(Ledger)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeLedger)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeLedger))
+			}
+		}
+	} else {
+		s, ok := maybeLedger.(*[]*Ledger)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeLedger)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeLedger))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &ledgerR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &ledgerR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`budgets`),
+		qm.WhereIn(`budgets.ledger_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load budgets")
+	}
+
+	var resultSlice []*Budget
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice budgets")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on budgets")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for budgets")
+	}
+
+	if len(budgetAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Budgets = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &budgetR{}
+			}
+			foreign.R.Ledger = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.LedgerID) {
+				local.R.Budgets = append(local.R.Budgets, foreign)
+				if foreign.R == nil {
+					foreign.R = &budgetR{}
+				}
+				foreign.R.Ledger = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadChats allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (ledgerL) LoadChats(ctx context.Context, e boil.ContextExecutor, singular bool, maybeLedger interface{}, mods queries.Applicator) error {
+	var slice []*Ledger
+	var object *Ledger
+
+	if singular {
+		var ok bool
+		object, ok = maybeLedger.(*Ledger)
+		if !ok {
+			object = new(Ledger)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeLedger)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeLedger))
+			}
+		}
+	} else {
+		s, ok := maybeLedger.(*[]*Ledger)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeLedger)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeLedger))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &ledgerR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &ledgerR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`chats`),
+		qm.WhereIn(`chats.ledger_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load chats")
+	}
+
+	var resultSlice []*Chat
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice chats")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on chats")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chats")
+	}
+
+	if len(chatAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Chats = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &chatR{}
+			}
+			foreign.R.Ledger = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.LedgerID) {
+				local.R.Chats = append(local.R.Chats, foreign)
+				if foreign.R == nil {
+					foreign.R = &chatR{}
+				}
+				foreign.R.Ledger = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCounterparties allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (ledgerL) LoadCounterparties(ctx context.Context, e boil.ContextExecutor, singular bool, maybeLedger interface{}, mods queries.Applicator) error {
+	var slice []*Ledger
+	var object *Ledger
+
+	if singular {
+		var ok bool
+		object, ok = maybeLedger.(*Ledger)
+		if !ok {
+			object = new(Ledger)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeLedger)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeLedger))
+			}
+		}
+	} else {
+		s, ok := maybeLedger.(*[]*Ledger)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeLedger)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeLedger))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &ledgerR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &ledgerR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`counterparties`),
+		qm.WhereIn(`counterparties.ledger_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load counterparties")
+	}
+
+	var resultSlice []*Counterparty
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice counterparties")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on counterparties")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for counterparties")
+	}
+
+	if len(counterpartyAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Counterparties = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &counterpartyR{}
+			}
+			foreign.R.Ledger = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.LedgerID) {
+				local.R.Counterparties = append(local.R.Counterparties, foreign)
+				if foreign.R == nil {
+					foreign.R = &counterpartyR{}
+				}
+				foreign.R.Ledger = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadGoals allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (ledgerL) LoadGoals(ctx context.Context, e boil.ContextExecutor, singular bool, maybeLedger interface{}, mods queries.Applicator) error {
+	var slice []*Ledger
+	var object *Ledger
+
+	if singular {
+		var ok bool
+		object, ok = maybeLedger.(*Ledger)
+		if !ok {
+			object = new(Ledger)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeLedger)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeLedger))
+			}
+		}
+	} else {
+		s, ok := maybeLedger.(*[]*Ledger)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeLedger)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeLedger))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &ledgerR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &ledgerR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`goals`),
+		qm.WhereIn(`goals.ledger_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load goals")
+	}
+
+	var resultSlice []*Goal
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice goals")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on goals")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for goals")
+	}
+
+	if len(goalAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Goals = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &goalR{}
+			}
+			foreign.R.Ledger = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.LedgerID) {
+				local.R.Goals = append(local.R.Goals, foreign)
+				if foreign.R == nil {
+					foreign.R = &goalR{}
+				}
+				foreign.R.Ledger = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMembers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (ledgerL) LoadMembers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeLedger interface{}, mods queries.Applicator) error {
+	var slice []*Ledger
+	var object *Ledger
+
+	if singular {
+		var ok bool
+		object, ok = maybeLedger.(*Ledger)
+		if !ok {
+			object = new(Ledger)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeLedger)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeLedger))
+			}
+		}
+	} else {
+		s, ok := maybeLedger.(*[]*Ledger)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeLedger)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeLedger))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &ledgerR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &ledgerR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`members`),
+		qm.WhereIn(`members.ledger_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load members")
+	}
+
+	var resultSlice []*Member
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice members")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on members")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for members")
+	}
+
+	if len(memberAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Members = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &memberR{}
+			}
+			foreign.R.Ledger = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.LedgerID) {
+				local.R.Members = append(local.R.Members, foreign)
+				if foreign.R == nil {
+					foreign.R = &memberR{}
+				}
+				foreign.R.Ledger = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRecurringTransactions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (ledgerL) LoadRecurringTransactions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeLedger interface{}, mods queries.Applicator) error {
+	var slice []*Ledger
+	var object *Ledger
+
+	if singular {
+		var ok bool
+		object, ok = maybeLedger.(*Ledger)
+		if !ok {
+			object = new(Ledger)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeLedger)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeLedger))
+			}
+		}
+	} else {
+		s, ok := maybeLedger.(*[]*Ledger)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeLedger)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeLedger))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &ledgerR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &ledgerR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`recurring_transactions`),
+		qm.WhereIn(`recurring_transactions.ledger_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load recurring_transactions")
+	}
+
+	var resultSlice []*RecurringTransaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice recurring_transactions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on recurring_transactions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for recurring_transactions")
+	}
+
+	if len(recurringTransactionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RecurringTransactions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &recurringTransactionR{}
+			}
+			foreign.R.Ledger = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.LedgerID) {
+				local.R.RecurringTransactions = append(local.R.RecurringTransactions, foreign)
+				if foreign.R == nil {
+					foreign.R = &recurringTransactionR{}
+				}
+				foreign.R.Ledger = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTransactions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (ledgerL) LoadTransactions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeLedger interface{}, mods queries.Applicator) error {
+	var slice []*Ledger
+	var object *Ledger
+
+	if singular {
+		var ok bool
+		object, ok = maybeLedger.(*Ledger)
+		if !ok {
+			object = new(Ledger)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeLedger)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeLedger))
+			}
+		}
+	} else {
+		s, ok := maybeLedger.(*[]*Ledger)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeLedger)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeLedger))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &ledgerR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &ledgerR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`transactions`),
+		qm.WhereIn(`transactions.ledger_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load transactions")
+	}
+
+	var resultSlice []*Transaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice transactions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on transactions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transactions")
+	}
+
+	if len(transactionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Transactions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &transactionR{}
+			}
+			foreign.R.Ledger = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.LedgerID) {
+				local.R.Transactions = append(local.R.Transactions, foreign)
+				if foreign.R == nil {
+					foreign.R = &transactionR{}
+				}
+				foreign.R.Ledger = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddBatches adds the given related objects to the existing relationships
+// of the ledger, optionally inserting them as new records.
+// Appends related to o.R.Batches.
+// Sets related.R.Ledger appropriately.
+func (o *Ledger) AddBatches(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Batch) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.LedgerID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"batches\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"ledger_id"}),
+				strmangle.WhereClause("\"", "\"", 0, batchPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.LedgerID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &ledgerR{
+			Batches: related,
+		}
+	} else {
+		o.R.Batches = append(o.R.Batches, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &batchR{
+				Ledger: o,
+			}
+		} else {
+			rel.R.Ledger = o
+		}
+	}
+	return nil
+}
+
+// SetBatches removes all previously related items of the
+// ledger replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Ledger's Batches accordingly.
+// Replaces o.R.Batches with related.
+// Sets related.R.Ledger's Batches accordingly.
+func (o *Ledger) SetBatches(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Batch) error {
+	query := "update \"batches\" set \"ledger_id\" = null where \"ledger_id\" = ?"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Batches {
+			queries.SetScanner(&rel.LedgerID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Ledger = nil
+		}
+		o.R.Batches = nil
+	}
+
+	return o.AddBatches(ctx, exec, insert, related...)
+}
+
+// RemoveBatches relationships from objects passed in.
+// Removes related items from R.Batches (uses pointer comparison, removal does not keep order)
+// Sets related.R.Ledger.
+func (o *Ledger) RemoveBatches(ctx context.Context, exec boil.ContextExecutor, related ...*Batch) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.LedgerID, nil)
+		if rel.R != nil {
+			rel.R.Ledger = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("ledger_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Batches {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Batches)
+			if ln > 1 && i < ln-1 {
+				o.R.Batches[i] = o.R.Batches[ln-1]
+			}
+			o.R.Batches = o.R.Batches[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddBudgets adds the given related objects to the existing relationships
+// of the ledger, optionally inserting them as new records.
+// Appends related to o.R.Budgets.
+// Sets related.R.Ledger appropriately.
+func (o *Ledger) AddBudgets(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Budget) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.LedgerID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"budgets\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"ledger_id"}),
+				strmangle.WhereClause("\"", "\"", 0, budgetPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.LedgerID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &ledgerR{
+			Budgets: related,
+		}
+	} else {
+		o.R.Budgets = append(o.R.Budgets, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &budgetR{
+				Ledger: o,
+			}
+		} else {
+			rel.R.Ledger = o
+		}
+	}
+	return nil
+}
+
+// AddChats adds the given related objects to the existing relationships
+// of the ledger, optionally inserting them as new records.
+// Appends related to o.R.Chats.
+// Sets related.R.Ledger appropriately.
+func (o *Ledger) AddChats(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Chat) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.LedgerID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"chats\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"ledger_id"}),
+				strmangle.WhereClause("\"", "\"", 0, chatPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.LedgerID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &ledgerR{
+			Chats: related,
+		}
+	} else {
+		o.R.Chats = append(o.R.Chats, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &chatR{
+				Ledger: o,
+			}
+		} else {
+			rel.R.Ledger = o
+		}
+	}
+	return nil
+}
+
+// AddCounterparties adds the given related objects to the existing relationships
+// of the ledger, optionally inserting them as new records.
+// Appends related to o.R.Counterparties.
+// Sets related.R.Ledger appropriately.
+func (o *Ledger) AddCounterparties(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Counterparty) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.LedgerID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"counterparties\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"ledger_id"}),
+				strmangle.WhereClause("\"", "\"", 0, counterpartyPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.LedgerID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &ledgerR{
+			Counterparties: related,
+		}
+	} else {
+		o.R.Counterparties = append(o.R.Counterparties, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &counterpartyR{
+				Ledger: o,
+			}
+		} else {
+			rel.R.Ledger = o
+		}
+	}
+	return nil
+}
+
+// AddGoals adds the given related objects to the existing relationships
+// of the ledger, optionally inserting them as new records.
+// Appends related to o.R.Goals.
+// Sets related.R.Ledger appropriately.
+func (o *Ledger) AddGoals(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Goal) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.LedgerID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"goals\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"ledger_id"}),
+				strmangle.WhereClause("\"", "\"", 0, goalPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.LedgerID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &ledgerR{
+			Goals: related,
+		}
+	} else {
+		o.R.Goals = append(o.R.Goals, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &goalR{
+				Ledger: o,
+			}
+		} else {
+			rel.R.Ledger = o
+		}
+	}
+	return nil
+}
+
+// AddMembers adds the given related objects to the existing relationships
+// of the ledger, optionally inserting them as new records.
+// Appends related to o.R.Members.
+// Sets related.R.Ledger appropriately.
+func (o *Ledger) AddMembers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Member) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.LedgerID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"members\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"ledger_id"}),
+				strmangle.WhereClause("\"", "\"", 0, memberPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.LedgerID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &ledgerR{
+			Members: related,
+		}
+	} else {
+		o.R.Members = append(o.R.Members, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &memberR{
+				Ledger: o,
+			}
+		} else {
+			rel.R.Ledger = o
+		}
+	}
+	return nil
+}
+
+// AddRecurringTransactions adds the given related objects to the existing relationships
+// of the ledger, optionally inserting them as new records.
+// Appends related to o.R.RecurringTransactions.
+// Sets related.R.Ledger appropriately.
+func (o *Ledger) AddRecurringTransactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RecurringTransaction) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.LedgerID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"recurring_transactions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"ledger_id"}),
+				strmangle.WhereClause("\"", "\"", 0, recurringTransactionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.LedgerID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &ledgerR{
+			RecurringTransactions: related,
+		}
+	} else {
+		o.R.RecurringTransactions = append(o.R.RecurringTransactions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &recurringTransactionR{
+				Ledger: o,
+			}
+		} else {
+			rel.R.Ledger = o
+		}
+	}
+	return nil
+}
+
+// AddTransactions adds the given related objects to the existing relationships
+// of the ledger, optionally inserting them as new records.
+// Appends related to o.R.Transactions.
+// Sets related.R.Ledger appropriately.
+func (o *Ledger) AddTransactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Transaction) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.LedgerID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"transactions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"ledger_id"}),
+				strmangle.WhereClause("\"", "\"", 0, transactionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.LedgerID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &ledgerR{
+			Transactions: related,
+		}
+	} else {
+		o.R.Transactions = append(o.R.Transactions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &transactionR{
+				Ledger: o,
+			}
+		} else {
+			rel.R.Ledger = o
+		}
+	}
+	return nil
+}
+
+// Ledgers retrieves all the records using an executor.
+func Ledgers(mods ...qm.QueryMod) ledgerQuery {
+	mods = append(mods, qm.From("\"ledgers\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"ledgers\".*"})
+	}
+
+	return ledgerQuery{q}
+}
+
+// FindLedger retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindLedger(ctx context.Context, exec boil.ContextExecutor, iD null.Int64, selectCols ...string) (*Ledger, error) {
+	ledgerObj := &Ledger{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"ledgers\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, ledgerObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from ledgers")
+	}
+
+	if err = ledgerObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return ledgerObj, err
+	}
+
+	return ledgerObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Ledger) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no ledgers provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(ledgerColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	ledgerInsertCacheMut.RLock()
+	cache, cached := ledgerInsertCache[key]
+	ledgerInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			ledgerAllColumns,
+			ledgerColumnsWithDefault,
+			ledgerColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, ledgerGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(ledgerType, ledgerMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(ledgerType, ledgerMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"ledgers\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"ledgers\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into ledgers")
+	}
+
+	if !cached {
+		ledgerInsertCacheMut.Lock()
+		ledgerInsertCache[key] = cache
+		ledgerInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Ledger.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Ledger) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	ledgerUpdateCacheMut.RLock()
+	cache, cached := ledgerUpdateCache[key]
+	ledgerUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			ledgerAllColumns,
+			ledgerPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, ledgerGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update ledgers, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"ledgers\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, ledgerPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(ledgerType, ledgerMapping, append(wl, ledgerPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update ledgers row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for ledgers")
+	}
+
+	if !cached {
+		ledgerUpdateCacheMut.Lock()
+		ledgerUpdateCache[key] = cache
+		ledgerUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q ledgerQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for ledgers")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for ledgers")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o LedgerSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), ledgerPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"ledgers\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, ledgerPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in ledger slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all ledger")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Ledger) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no ledgers provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(ledgerColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	ledgerUpsertCacheMut.RLock()
+	cache, cached := ledgerUpsertCache[key]
+	ledgerUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			ledgerAllColumns,
+			ledgerColumnsWithDefault,
+			ledgerColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			ledgerAllColumns,
+			ledgerPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert ledgers, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(ledgerAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(ledgerPrimaryKeyColumns))
+			copy(conflict, ledgerPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"ledgers\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(ledgerType, ledgerMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(ledgerType, ledgerMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert ledgers")
+	}
+
+	if !cached {
+		ledgerUpsertCacheMut.Lock()
+		ledgerUpsertCache[key] = cache
+		ledgerUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Ledger record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Ledger) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Ledger provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), ledgerPrimaryKeyMapping)
+	sql := "DELETE FROM \"ledgers\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from ledgers")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for ledgers")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q ledgerQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no ledgerQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from ledgers")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for ledgers")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o LedgerSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(ledgerBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), ledgerPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"ledgers\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, ledgerPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from ledger slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for ledgers")
+	}
+
+	if len(ledgerAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Ledger) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindLedger(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *LedgerSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := LedgerSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), ledgerPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"ledgers\".* FROM \"ledgers\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, ledgerPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in LedgerSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// LedgerExists checks if the Ledger row exists.
+func LedgerExists(ctx context.Context, exec boil.ContextExecutor, iD null.Int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"ledgers\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if ledgers exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Ledger row exists.
+func (o *Ledger) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return LedgerExists(ctx, exec, o.ID)
+}
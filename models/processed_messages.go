@@ -0,0 +1,961 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// ProcessedMessage is an object representing the database table.
+type ProcessedMessage struct {
+	ID          null.Int64 `boil:"id" json:"id,omitempty" toml:"id" yaml:"id,omitempty"`
+	Chat        string     `boil:"chat" json:"chat" toml:"chat" yaml:"chat"`
+	MessageID   string     `boil:"message_id" json:"message_id" toml:"message_id" yaml:"message_id"`
+	Outcome     string     `boil:"outcome" json:"outcome" toml:"outcome" yaml:"outcome"`
+	Duplicates  int64      `boil:"duplicates" json:"duplicates" toml:"duplicates" yaml:"duplicates"`
+	ProcessedAt time.Time  `boil:"processed_at" json:"processed_at" toml:"processed_at" yaml:"processed_at"`
+	SentAt      null.Time  `boil:"sent_at" json:"sent_at,omitempty" toml:"sent_at" yaml:"sent_at,omitempty"`
+
+	R *processedMessageR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L processedMessageL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ProcessedMessageColumns = struct {
+	ID          string
+	Chat        string
+	MessageID   string
+	Outcome     string
+	Duplicates  string
+	ProcessedAt string
+	SentAt      string
+}{
+	ID:          "id",
+	Chat:        "chat",
+	MessageID:   "message_id",
+	Outcome:     "outcome",
+	Duplicates:  "duplicates",
+	ProcessedAt: "processed_at",
+	SentAt:      "sent_at",
+}
+
+var ProcessedMessageTableColumns = struct {
+	ID          string
+	Chat        string
+	MessageID   string
+	Outcome     string
+	Duplicates  string
+	ProcessedAt string
+	SentAt      string
+}{
+	ID:          "processed_messages.id",
+	Chat:        "processed_messages.chat",
+	MessageID:   "processed_messages.message_id",
+	Outcome:     "processed_messages.outcome",
+	Duplicates:  "processed_messages.duplicates",
+	ProcessedAt: "processed_messages.processed_at",
+	SentAt:      "processed_messages.sent_at",
+}
+
+// Generated where
+
+var ProcessedMessageWhere = struct {
+	ID          whereHelpernull_Int64
+	Chat        whereHelperstring
+	MessageID   whereHelperstring
+	Outcome     whereHelperstring
+	Duplicates  whereHelperint64
+	ProcessedAt whereHelpertime_Time
+	SentAt      whereHelpernull_Time
+}{
+	ID:          whereHelpernull_Int64{field: "\"processed_messages\".\"id\""},
+	Chat:        whereHelperstring{field: "\"processed_messages\".\"chat\""},
+	MessageID:   whereHelperstring{field: "\"processed_messages\".\"message_id\""},
+	Outcome:     whereHelperstring{field: "\"processed_messages\".\"outcome\""},
+	Duplicates:  whereHelperint64{field: "\"processed_messages\".\"duplicates\""},
+	ProcessedAt: whereHelpertime_Time{field: "\"processed_messages\".\"processed_at\""},
+	SentAt:      whereHelpernull_Time{field: "\"processed_messages\".\"sent_at\""},
+}
+
+// ProcessedMessageRels is where relationship names are stored.
+var ProcessedMessageRels = struct {
+}{}
+
+// processedMessageR is where relationships are stored.
+type processedMessageR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*processedMessageR) NewStruct() *processedMessageR {
+	return &processedMessageR{}
+}
+
+// processedMessageL is where Load methods for each relationship are stored.
+type processedMessageL struct{}
+
+var (
+	processedMessageAllColumns            = []string{"id", "chat", "message_id", "outcome", "duplicates", "processed_at", "sent_at"}
+	processedMessageColumnsWithoutDefault = []string{"chat", "message_id", "outcome"}
+	processedMessageColumnsWithDefault    = []string{"id", "duplicates", "processed_at", "sent_at"}
+	processedMessagePrimaryKeyColumns     = []string{"id"}
+	processedMessageGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// ProcessedMessageSlice is an alias for a slice of pointers to ProcessedMessage.
+	// This should almost always be used instead of []ProcessedMessage.
+	ProcessedMessageSlice []*ProcessedMessage
+	// ProcessedMessageHook is the signature for custom ProcessedMessage hook methods
+	ProcessedMessageHook func(context.Context, boil.ContextExecutor, *ProcessedMessage) error
+
+	processedMessageQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	processedMessageType                 = reflect.TypeOf(&ProcessedMessage{})
+	processedMessageMapping              = queries.MakeStructMapping(processedMessageType)
+	processedMessagePrimaryKeyMapping, _ = queries.BindMapping(processedMessageType, processedMessageMapping, processedMessagePrimaryKeyColumns)
+	processedMessageInsertCacheMut       sync.RWMutex
+	processedMessageInsertCache          = make(map[string]insertCache)
+	processedMessageUpdateCacheMut       sync.RWMutex
+	processedMessageUpdateCache          = make(map[string]updateCache)
+	processedMessageUpsertCacheMut       sync.RWMutex
+	processedMessageUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var processedMessageAfterSelectMu sync.Mutex
+var processedMessageAfterSelectHooks []ProcessedMessageHook
+
+var processedMessageBeforeInsertMu sync.Mutex
+var processedMessageBeforeInsertHooks []ProcessedMessageHook
+var processedMessageAfterInsertMu sync.Mutex
+var processedMessageAfterInsertHooks []ProcessedMessageHook
+
+var processedMessageBeforeUpdateMu sync.Mutex
+var processedMessageBeforeUpdateHooks []ProcessedMessageHook
+var processedMessageAfterUpdateMu sync.Mutex
+var processedMessageAfterUpdateHooks []ProcessedMessageHook
+
+var processedMessageBeforeDeleteMu sync.Mutex
+var processedMessageBeforeDeleteHooks []ProcessedMessageHook
+var processedMessageAfterDeleteMu sync.Mutex
+var processedMessageAfterDeleteHooks []ProcessedMessageHook
+
+var processedMessageBeforeUpsertMu sync.Mutex
+var processedMessageBeforeUpsertHooks []ProcessedMessageHook
+var processedMessageAfterUpsertMu sync.Mutex
+var processedMessageAfterUpsertHooks []ProcessedMessageHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ProcessedMessage) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range processedMessageAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ProcessedMessage) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range processedMessageBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ProcessedMessage) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range processedMessageAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ProcessedMessage) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range processedMessageBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ProcessedMessage) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range processedMessageAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ProcessedMessage) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range processedMessageBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ProcessedMessage) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range processedMessageAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ProcessedMessage) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range processedMessageBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ProcessedMessage) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range processedMessageAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddProcessedMessageHook registers your hook function for all future operations.
+func AddProcessedMessageHook(hookPoint boil.HookPoint, processedMessageHook ProcessedMessageHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		processedMessageAfterSelectMu.Lock()
+		processedMessageAfterSelectHooks = append(processedMessageAfterSelectHooks, processedMessageHook)
+		processedMessageAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		processedMessageBeforeInsertMu.Lock()
+		processedMessageBeforeInsertHooks = append(processedMessageBeforeInsertHooks, processedMessageHook)
+		processedMessageBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		processedMessageAfterInsertMu.Lock()
+		processedMessageAfterInsertHooks = append(processedMessageAfterInsertHooks, processedMessageHook)
+		processedMessageAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		processedMessageBeforeUpdateMu.Lock()
+		processedMessageBeforeUpdateHooks = append(processedMessageBeforeUpdateHooks, processedMessageHook)
+		processedMessageBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		processedMessageAfterUpdateMu.Lock()
+		processedMessageAfterUpdateHooks = append(processedMessageAfterUpdateHooks, processedMessageHook)
+		processedMessageAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		processedMessageBeforeDeleteMu.Lock()
+		processedMessageBeforeDeleteHooks = append(processedMessageBeforeDeleteHooks, processedMessageHook)
+		processedMessageBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		processedMessageAfterDeleteMu.Lock()
+		processedMessageAfterDeleteHooks = append(processedMessageAfterDeleteHooks, processedMessageHook)
+		processedMessageAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		processedMessageBeforeUpsertMu.Lock()
+		processedMessageBeforeUpsertHooks = append(processedMessageBeforeUpsertHooks, processedMessageHook)
+		processedMessageBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		processedMessageAfterUpsertMu.Lock()
+		processedMessageAfterUpsertHooks = append(processedMessageAfterUpsertHooks, processedMessageHook)
+		processedMessageAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single processedMessage record from the query.
+func (q processedMessageQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ProcessedMessage, error) {
+	o := &ProcessedMessage{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for processed_messages")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ProcessedMessage records from the query.
+func (q processedMessageQuery) All(ctx context.Context, exec boil.ContextExecutor) (ProcessedMessageSlice, error) {
+	var o []*ProcessedMessage
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ProcessedMessage slice")
+	}
+
+	if len(processedMessageAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ProcessedMessage records in the query.
+func (q processedMessageQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count processed_messages rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q processedMessageQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if processed_messages exists")
+	}
+
+	return count > 0, nil
+}
+
+// ProcessedMessages retrieves all the records using an executor.
+func ProcessedMessages(mods ...qm.QueryMod) processedMessageQuery {
+	mods = append(mods, qm.From("\"processed_messages\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"processed_messages\".*"})
+	}
+
+	return processedMessageQuery{q}
+}
+
+// FindProcessedMessage retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindProcessedMessage(ctx context.Context, exec boil.ContextExecutor, iD null.Int64, selectCols ...string) (*ProcessedMessage, error) {
+	processedMessageObj := &ProcessedMessage{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"processed_messages\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, processedMessageObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from processed_messages")
+	}
+
+	if err = processedMessageObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return processedMessageObj, err
+	}
+
+	return processedMessageObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ProcessedMessage) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no processed_messages provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(processedMessageColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	processedMessageInsertCacheMut.RLock()
+	cache, cached := processedMessageInsertCache[key]
+	processedMessageInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			processedMessageAllColumns,
+			processedMessageColumnsWithDefault,
+			processedMessageColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, processedMessageGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(processedMessageType, processedMessageMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(processedMessageType, processedMessageMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"processed_messages\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"processed_messages\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into processed_messages")
+	}
+
+	if !cached {
+		processedMessageInsertCacheMut.Lock()
+		processedMessageInsertCache[key] = cache
+		processedMessageInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ProcessedMessage.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ProcessedMessage) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	processedMessageUpdateCacheMut.RLock()
+	cache, cached := processedMessageUpdateCache[key]
+	processedMessageUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			processedMessageAllColumns,
+			processedMessagePrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, processedMessageGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update processed_messages, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"processed_messages\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, processedMessagePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(processedMessageType, processedMessageMapping, append(wl, processedMessagePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update processed_messages row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for processed_messages")
+	}
+
+	if !cached {
+		processedMessageUpdateCacheMut.Lock()
+		processedMessageUpdateCache[key] = cache
+		processedMessageUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q processedMessageQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for processed_messages")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for processed_messages")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ProcessedMessageSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), processedMessagePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"processed_messages\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, processedMessagePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in processedMessage slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all processedMessage")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ProcessedMessage) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no processed_messages provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(processedMessageColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	processedMessageUpsertCacheMut.RLock()
+	cache, cached := processedMessageUpsertCache[key]
+	processedMessageUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			processedMessageAllColumns,
+			processedMessageColumnsWithDefault,
+			processedMessageColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			processedMessageAllColumns,
+			processedMessagePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert processed_messages, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(processedMessageAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(processedMessagePrimaryKeyColumns))
+			copy(conflict, processedMessagePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"processed_messages\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(processedMessageType, processedMessageMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(processedMessageType, processedMessageMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert processed_messages")
+	}
+
+	if !cached {
+		processedMessageUpsertCacheMut.Lock()
+		processedMessageUpsertCache[key] = cache
+		processedMessageUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ProcessedMessage record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ProcessedMessage) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ProcessedMessage provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), processedMessagePrimaryKeyMapping)
+	sql := "DELETE FROM \"processed_messages\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from processed_messages")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for processed_messages")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q processedMessageQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no processedMessageQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from processed_messages")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for processed_messages")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ProcessedMessageSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(processedMessageBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), processedMessagePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"processed_messages\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, processedMessagePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from processedMessage slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for processed_messages")
+	}
+
+	if len(processedMessageAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ProcessedMessage) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindProcessedMessage(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ProcessedMessageSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ProcessedMessageSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), processedMessagePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"processed_messages\".* FROM \"processed_messages\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, processedMessagePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ProcessedMessageSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ProcessedMessageExists checks if the ProcessedMessage row exists.
+func ProcessedMessageExists(ctx context.Context, exec boil.ContextExecutor, iD null.Int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"processed_messages\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if processed_messages exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ProcessedMessage row exists.
+func (o *ProcessedMessage) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ProcessedMessageExists(ctx, exec, o.ID)
+}
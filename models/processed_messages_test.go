@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testProcessedMessages(t *testing.T) {
+	t.Parallel()
+
+	query := ProcessedMessages()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testProcessedMessagesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ProcessedMessage{}
+	if err = randomize.Struct(seed, o, processedMessageDBTypes, true, processedMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ProcessedMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ProcessedMessages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testProcessedMessagesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ProcessedMessage{}
+	if err = randomize.Struct(seed, o, processedMessageDBTypes, true, processedMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ProcessedMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := ProcessedMessages().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ProcessedMessages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testProcessedMessagesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ProcessedMessage{}
+	if err = randomize.Struct(seed, o, processedMessageDBTypes, true, processedMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ProcessedMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ProcessedMessageSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ProcessedMessages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testProcessedMessagesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ProcessedMessage{}
+	if err = randomize.Struct(seed, o, processedMessageDBTypes, true, processedMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ProcessedMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ProcessedMessageExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if ProcessedMessage exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ProcessedMessageExists to return true, but got false.")
+	}
+}
+
+func testProcessedMessagesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ProcessedMessage{}
+	if err = randomize.Struct(seed, o, processedMessageDBTypes, true, processedMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ProcessedMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	processedMessageFound, err := FindProcessedMessage(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if processedMessageFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testProcessedMessagesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ProcessedMessage{}
+	if err = randomize.Struct(seed, o, processedMessageDBTypes, true, processedMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ProcessedMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = ProcessedMessages().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testProcessedMessagesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ProcessedMessage{}
+	if err = randomize.Struct(seed, o, processedMessageDBTypes, true, processedMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ProcessedMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := ProcessedMessages().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testProcessedMessagesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	processedMessageOne := &ProcessedMessage{}
+	processedMessageTwo := &ProcessedMessage{}
+	if err = randomize.Struct(seed, processedMessageOne, processedMessageDBTypes, false, processedMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ProcessedMessage struct: %s", err)
+	}
+	if err = randomize.Struct(seed, processedMessageTwo, processedMessageDBTypes, false, processedMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ProcessedMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = processedMessageOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = processedMessageTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ProcessedMessages().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testProcessedMessagesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	processedMessageOne := &ProcessedMessage{}
+	processedMessageTwo := &ProcessedMessage{}
+	if err = randomize.Struct(seed, processedMessageOne, processedMessageDBTypes, false, processedMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ProcessedMessage struct: %s", err)
+	}
+	if err = randomize.Struct(seed, processedMessageTwo, processedMessageDBTypes, false, processedMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ProcessedMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = processedMessageOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = processedMessageTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ProcessedMessages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func processedMessageBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *ProcessedMessage) error {
+	*o = ProcessedMessage{}
+	return nil
+}
+
+func processedMessageAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *ProcessedMessage) error {
+	*o = ProcessedMessage{}
+	return nil
+}
+
+func processedMessageAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *ProcessedMessage) error {
+	*o = ProcessedMessage{}
+	return nil
+}
+
+func processedMessageBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ProcessedMessage) error {
+	*o = ProcessedMessage{}
+	return nil
+}
+
+func processedMessageAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ProcessedMessage) error {
+	*o = ProcessedMessage{}
+	return nil
+}
+
+func processedMessageBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ProcessedMessage) error {
+	*o = ProcessedMessage{}
+	return nil
+}
+
+func processedMessageAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ProcessedMessage) error {
+	*o = ProcessedMessage{}
+	return nil
+}
+
+func processedMessageBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ProcessedMessage) error {
+	*o = ProcessedMessage{}
+	return nil
+}
+
+func processedMessageAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ProcessedMessage) error {
+	*o = ProcessedMessage{}
+	return nil
+}
+
+func testProcessedMessagesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &ProcessedMessage{}
+	o := &ProcessedMessage{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, processedMessageDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize ProcessedMessage object: %s", err)
+	}
+
+	AddProcessedMessageHook(boil.BeforeInsertHook, processedMessageBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	processedMessageBeforeInsertHooks = []ProcessedMessageHook{}
+
+	AddProcessedMessageHook(boil.AfterInsertHook, processedMessageAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	processedMessageAfterInsertHooks = []ProcessedMessageHook{}
+
+	AddProcessedMessageHook(boil.AfterSelectHook, processedMessageAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	processedMessageAfterSelectHooks = []ProcessedMessageHook{}
+
+	AddProcessedMessageHook(boil.BeforeUpdateHook, processedMessageBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	processedMessageBeforeUpdateHooks = []ProcessedMessageHook{}
+
+	AddProcessedMessageHook(boil.AfterUpdateHook, processedMessageAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	processedMessageAfterUpdateHooks = []ProcessedMessageHook{}
+
+	AddProcessedMessageHook(boil.BeforeDeleteHook, processedMessageBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	processedMessageBeforeDeleteHooks = []ProcessedMessageHook{}
+
+	AddProcessedMessageHook(boil.AfterDeleteHook, processedMessageAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	processedMessageAfterDeleteHooks = []ProcessedMessageHook{}
+
+	AddProcessedMessageHook(boil.BeforeUpsertHook, processedMessageBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	processedMessageBeforeUpsertHooks = []ProcessedMessageHook{}
+
+	AddProcessedMessageHook(boil.AfterUpsertHook, processedMessageAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	processedMessageAfterUpsertHooks = []ProcessedMessageHook{}
+}
+
+func testProcessedMessagesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ProcessedMessage{}
+	if err = randomize.Struct(seed, o, processedMessageDBTypes, true, processedMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ProcessedMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ProcessedMessages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testProcessedMessagesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ProcessedMessage{}
+	if err = randomize.Struct(seed, o, processedMessageDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ProcessedMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(processedMessagePrimaryKeyColumns, processedMessageColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ProcessedMessages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testProcessedMessagesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ProcessedMessage{}
+	if err = randomize.Struct(seed, o, processedMessageDBTypes, true, processedMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ProcessedMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testProcessedMessagesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ProcessedMessage{}
+	if err = randomize.Struct(seed, o, processedMessageDBTypes, true, processedMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ProcessedMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ProcessedMessageSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testProcessedMessagesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ProcessedMessage{}
+	if err = randomize.Struct(seed, o, processedMessageDBTypes, true, processedMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ProcessedMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ProcessedMessages().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	processedMessageDBTypes = map[string]string{`ID`: `INTEGER`, `Chat`: `TEXT`, `MessageID`: `TEXT`, `Outcome`: `TEXT`, `Duplicates`: `INTEGER`, `ProcessedAt`: `DATETIME`, `SentAt`: `DATETIME`}
+	_                       = bytes.MinRead
+)
+
+func testProcessedMessagesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(processedMessagePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(processedMessageAllColumns) == len(processedMessagePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ProcessedMessage{}
+	if err = randomize.Struct(seed, o, processedMessageDBTypes, true, processedMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ProcessedMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ProcessedMessages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, processedMessageDBTypes, true, processedMessagePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ProcessedMessage struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testProcessedMessagesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(processedMessageAllColumns) == len(processedMessagePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ProcessedMessage{}
+	if err = randomize.Struct(seed, o, processedMessageDBTypes, true, processedMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ProcessedMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ProcessedMessages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, processedMessageDBTypes, true, processedMessagePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ProcessedMessage struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(processedMessageAllColumns, processedMessagePrimaryKeyColumns) {
+		fields = processedMessageAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			processedMessageAllColumns,
+			processedMessagePrimaryKeyColumns,
+		)
+		fields = strmangle.SetComplement(fields, processedMessageGeneratedColumns)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ProcessedMessageSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testProcessedMessagesUpsert(t *testing.T) {
+	t.Parallel()
+	if len(processedMessageAllColumns) == len(processedMessagePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := ProcessedMessage{}
+	if err = randomize.Struct(seed, &o, processedMessageDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ProcessedMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ProcessedMessage: %s", err)
+	}
+
+	count, err := ProcessedMessages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, processedMessageDBTypes, false, processedMessagePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ProcessedMessage struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ProcessedMessage: %s", err)
+	}
+
+	count, err = ProcessedMessages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
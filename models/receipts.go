@@ -0,0 +1,1235 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// Receipt is an object representing the database table.
+type Receipt struct {
+	ID        null.Int64 `boil:"id" json:"id,omitempty" toml:"id" yaml:"id,omitempty"`
+	Hash      string     `boil:"hash" json:"hash" toml:"hash" yaml:"hash"`
+	FileName  string     `boil:"file_name" json:"file_name" toml:"file_name" yaml:"file_name"`
+	MimeType  string     `boil:"mime_type" json:"mime_type" toml:"mime_type" yaml:"mime_type"`
+	CreatedAt time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *receiptR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L receiptL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ReceiptColumns = struct {
+	ID        string
+	Hash      string
+	FileName  string
+	MimeType  string
+	CreatedAt string
+}{
+	ID:        "id",
+	Hash:      "hash",
+	FileName:  "file_name",
+	MimeType:  "mime_type",
+	CreatedAt: "created_at",
+}
+
+var ReceiptTableColumns = struct {
+	ID        string
+	Hash      string
+	FileName  string
+	MimeType  string
+	CreatedAt string
+}{
+	ID:        "receipts.id",
+	Hash:      "receipts.hash",
+	FileName:  "receipts.file_name",
+	MimeType:  "receipts.mime_type",
+	CreatedAt: "receipts.created_at",
+}
+
+// Generated where
+
+var ReceiptWhere = struct {
+	ID        whereHelpernull_Int64
+	Hash      whereHelperstring
+	FileName  whereHelperstring
+	MimeType  whereHelperstring
+	CreatedAt whereHelpertime_Time
+}{
+	ID:        whereHelpernull_Int64{field: "\"receipts\".\"id\""},
+	Hash:      whereHelperstring{field: "\"receipts\".\"hash\""},
+	FileName:  whereHelperstring{field: "\"receipts\".\"file_name\""},
+	MimeType:  whereHelperstring{field: "\"receipts\".\"mime_type\""},
+	CreatedAt: whereHelpertime_Time{field: "\"receipts\".\"created_at\""},
+}
+
+// ReceiptRels is where relationship names are stored.
+var ReceiptRels = struct {
+	Transactions string
+}{
+	Transactions: "Transactions",
+}
+
+// receiptR is where relationships are stored.
+type receiptR struct {
+	Transactions TransactionSlice `boil:"Transactions" json:"Transactions" toml:"Transactions" yaml:"Transactions"`
+}
+
+// NewStruct creates a new relationship struct
+func (*receiptR) NewStruct() *receiptR {
+	return &receiptR{}
+}
+
+func (o *Receipt) GetTransactions() TransactionSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTransactions()
+}
+
+func (r *receiptR) GetTransactions() TransactionSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Transactions
+}
+
+// receiptL is where Load methods for each relationship are stored.
+type receiptL struct{}
+
+var (
+	receiptAllColumns            = []string{"id", "hash", "file_name", "mime_type", "created_at"}
+	receiptColumnsWithoutDefault = []string{"hash", "file_name", "mime_type"}
+	receiptColumnsWithDefault    = []string{"id", "created_at"}
+	receiptPrimaryKeyColumns     = []string{"id"}
+	receiptGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// ReceiptSlice is an alias for a slice of pointers to Receipt.
+	// This should almost always be used instead of []Receipt.
+	ReceiptSlice []*Receipt
+	// ReceiptHook is the signature for custom Receipt hook methods
+	ReceiptHook func(context.Context, boil.ContextExecutor, *Receipt) error
+
+	receiptQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	receiptType                 = reflect.TypeOf(&Receipt{})
+	receiptMapping              = queries.MakeStructMapping(receiptType)
+	receiptPrimaryKeyMapping, _ = queries.BindMapping(receiptType, receiptMapping, receiptPrimaryKeyColumns)
+	receiptInsertCacheMut       sync.RWMutex
+	receiptInsertCache          = make(map[string]insertCache)
+	receiptUpdateCacheMut       sync.RWMutex
+	receiptUpdateCache          = make(map[string]updateCache)
+	receiptUpsertCacheMut       sync.RWMutex
+	receiptUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var receiptAfterSelectMu sync.Mutex
+var receiptAfterSelectHooks []ReceiptHook
+
+var receiptBeforeInsertMu sync.Mutex
+var receiptBeforeInsertHooks []ReceiptHook
+var receiptAfterInsertMu sync.Mutex
+var receiptAfterInsertHooks []ReceiptHook
+
+var receiptBeforeUpdateMu sync.Mutex
+var receiptBeforeUpdateHooks []ReceiptHook
+var receiptAfterUpdateMu sync.Mutex
+var receiptAfterUpdateHooks []ReceiptHook
+
+var receiptBeforeDeleteMu sync.Mutex
+var receiptBeforeDeleteHooks []ReceiptHook
+var receiptAfterDeleteMu sync.Mutex
+var receiptAfterDeleteHooks []ReceiptHook
+
+var receiptBeforeUpsertMu sync.Mutex
+var receiptBeforeUpsertHooks []ReceiptHook
+var receiptAfterUpsertMu sync.Mutex
+var receiptAfterUpsertHooks []ReceiptHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Receipt) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range receiptAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Receipt) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range receiptBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Receipt) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range receiptAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Receipt) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range receiptBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Receipt) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range receiptAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Receipt) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range receiptBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Receipt) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range receiptAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Receipt) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range receiptBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Receipt) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range receiptAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddReceiptHook registers your hook function for all future operations.
+func AddReceiptHook(hookPoint boil.HookPoint, receiptHook ReceiptHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		receiptAfterSelectMu.Lock()
+		receiptAfterSelectHooks = append(receiptAfterSelectHooks, receiptHook)
+		receiptAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		receiptBeforeInsertMu.Lock()
+		receiptBeforeInsertHooks = append(receiptBeforeInsertHooks, receiptHook)
+		receiptBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		receiptAfterInsertMu.Lock()
+		receiptAfterInsertHooks = append(receiptAfterInsertHooks, receiptHook)
+		receiptAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		receiptBeforeUpdateMu.Lock()
+		receiptBeforeUpdateHooks = append(receiptBeforeUpdateHooks, receiptHook)
+		receiptBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		receiptAfterUpdateMu.Lock()
+		receiptAfterUpdateHooks = append(receiptAfterUpdateHooks, receiptHook)
+		receiptAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		receiptBeforeDeleteMu.Lock()
+		receiptBeforeDeleteHooks = append(receiptBeforeDeleteHooks, receiptHook)
+		receiptBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		receiptAfterDeleteMu.Lock()
+		receiptAfterDeleteHooks = append(receiptAfterDeleteHooks, receiptHook)
+		receiptAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		receiptBeforeUpsertMu.Lock()
+		receiptBeforeUpsertHooks = append(receiptBeforeUpsertHooks, receiptHook)
+		receiptBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		receiptAfterUpsertMu.Lock()
+		receiptAfterUpsertHooks = append(receiptAfterUpsertHooks, receiptHook)
+		receiptAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single receipt record from the query.
+func (q receiptQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Receipt, error) {
+	o := &Receipt{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for receipts")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Receipt records from the query.
+func (q receiptQuery) All(ctx context.Context, exec boil.ContextExecutor) (ReceiptSlice, error) {
+	var o []*Receipt
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Receipt slice")
+	}
+
+	if len(receiptAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Receipt records in the query.
+func (q receiptQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count receipts rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q receiptQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if receipts exists")
+	}
+
+	return count > 0, nil
+}
+
+// Transactions retrieves all the transaction's Transactions with an executor.
+func (o *Receipt) Transactions(mods ...qm.QueryMod) transactionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"transactions\".\"receipt_id\"=?", o.ID),
+	)
+
+	return Transactions(queryMods...)
+}
+
+// LoadTransactions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (receiptL) LoadTransactions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeReceipt interface{}, mods queries.Applicator) error {
+	var slice []*Receipt
+	var object *Receipt
+
+	if singular {
+		var ok bool
+		object, ok = maybeReceipt.(*Receipt)
+		if !ok {
+			object = new(Receipt)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeReceipt)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeReceipt))
+			}
+		}
+	} else {
+		s, ok := maybeReceipt.(*[]*Receipt)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeReceipt)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeReceipt))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &receiptR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &receiptR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`transactions`),
+		qm.WhereIn(`transactions.receipt_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load transactions")
+	}
+
+	var resultSlice []*Transaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice transactions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on transactions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transactions")
+	}
+
+	if len(transactionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Transactions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &transactionR{}
+			}
+			foreign.R.Receipt = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ReceiptID) {
+				local.R.Transactions = append(local.R.Transactions, foreign)
+				if foreign.R == nil {
+					foreign.R = &transactionR{}
+				}
+				foreign.R.Receipt = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddTransactions adds the given related objects to the existing relationships
+// of the receipt, optionally inserting them as new records.
+// Appends related to o.R.Transactions.
+// Sets related.R.Receipt appropriately.
+func (o *Receipt) AddTransactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Transaction) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ReceiptID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"transactions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"receipt_id"}),
+				strmangle.WhereClause("\"", "\"", 0, transactionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ReceiptID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &receiptR{
+			Transactions: related,
+		}
+	} else {
+		o.R.Transactions = append(o.R.Transactions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &transactionR{
+				Receipt: o,
+			}
+		} else {
+			rel.R.Receipt = o
+		}
+	}
+	return nil
+}
+
+// SetTransactions removes all previously related items of the
+// receipt replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Receipt's Transactions accordingly.
+// Replaces o.R.Transactions with related.
+// Sets related.R.Receipt's Transactions accordingly.
+func (o *Receipt) SetTransactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Transaction) error {
+	query := "update \"transactions\" set \"receipt_id\" = null where \"receipt_id\" = ?"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Transactions {
+			queries.SetScanner(&rel.ReceiptID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Receipt = nil
+		}
+		o.R.Transactions = nil
+	}
+
+	return o.AddTransactions(ctx, exec, insert, related...)
+}
+
+// RemoveTransactions relationships from objects passed in.
+// Removes related items from R.Transactions (uses pointer comparison, removal does not keep order)
+// Sets related.R.Receipt.
+func (o *Receipt) RemoveTransactions(ctx context.Context, exec boil.ContextExecutor, related ...*Transaction) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ReceiptID, nil)
+		if rel.R != nil {
+			rel.R.Receipt = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("receipt_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Transactions {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Transactions)
+			if ln > 1 && i < ln-1 {
+				o.R.Transactions[i] = o.R.Transactions[ln-1]
+			}
+			o.R.Transactions = o.R.Transactions[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// Receipts retrieves all the records using an executor.
+func Receipts(mods ...qm.QueryMod) receiptQuery {
+	mods = append(mods, qm.From("\"receipts\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"receipts\".*"})
+	}
+
+	return receiptQuery{q}
+}
+
+// FindReceipt retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindReceipt(ctx context.Context, exec boil.ContextExecutor, iD null.Int64, selectCols ...string) (*Receipt, error) {
+	receiptObj := &Receipt{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"receipts\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, receiptObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from receipts")
+	}
+
+	if err = receiptObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return receiptObj, err
+	}
+
+	return receiptObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Receipt) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no receipts provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(receiptColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	receiptInsertCacheMut.RLock()
+	cache, cached := receiptInsertCache[key]
+	receiptInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			receiptAllColumns,
+			receiptColumnsWithDefault,
+			receiptColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, receiptGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(receiptType, receiptMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(receiptType, receiptMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"receipts\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"receipts\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into receipts")
+	}
+
+	if !cached {
+		receiptInsertCacheMut.Lock()
+		receiptInsertCache[key] = cache
+		receiptInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Receipt.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Receipt) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	receiptUpdateCacheMut.RLock()
+	cache, cached := receiptUpdateCache[key]
+	receiptUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			receiptAllColumns,
+			receiptPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, receiptGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update receipts, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"receipts\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, receiptPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(receiptType, receiptMapping, append(wl, receiptPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update receipts row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for receipts")
+	}
+
+	if !cached {
+		receiptUpdateCacheMut.Lock()
+		receiptUpdateCache[key] = cache
+		receiptUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q receiptQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for receipts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for receipts")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ReceiptSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), receiptPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"receipts\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, receiptPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in receipt slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all receipt")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Receipt) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no receipts provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(receiptColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	receiptUpsertCacheMut.RLock()
+	cache, cached := receiptUpsertCache[key]
+	receiptUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			receiptAllColumns,
+			receiptColumnsWithDefault,
+			receiptColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			receiptAllColumns,
+			receiptPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert receipts, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(receiptAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(receiptPrimaryKeyColumns))
+			copy(conflict, receiptPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"receipts\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(receiptType, receiptMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(receiptType, receiptMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert receipts")
+	}
+
+	if !cached {
+		receiptUpsertCacheMut.Lock()
+		receiptUpsertCache[key] = cache
+		receiptUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Receipt record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Receipt) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Receipt provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), receiptPrimaryKeyMapping)
+	sql := "DELETE FROM \"receipts\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from receipts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for receipts")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q receiptQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no receiptQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from receipts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for receipts")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ReceiptSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(receiptBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), receiptPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"receipts\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, receiptPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from receipt slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for receipts")
+	}
+
+	if len(receiptAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Receipt) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindReceipt(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ReceiptSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ReceiptSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), receiptPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"receipts\".* FROM \"receipts\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, receiptPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ReceiptSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ReceiptExists checks if the Receipt row exists.
+func ReceiptExists(ctx context.Context, exec boil.ContextExecutor, iD null.Int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"receipts\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if receipts exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Receipt row exists.
+func (o *Receipt) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ReceiptExists(ctx, exec, o.ID)
+}
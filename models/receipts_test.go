@@ -0,0 +1,1060 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testReceipts(t *testing.T) {
+	t.Parallel()
+
+	query := Receipts()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testReceiptsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Receipt{}
+	if err = randomize.Struct(seed, o, receiptDBTypes, true, receiptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Receipt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Receipts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testReceiptsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Receipt{}
+	if err = randomize.Struct(seed, o, receiptDBTypes, true, receiptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Receipt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Receipts().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Receipts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testReceiptsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Receipt{}
+	if err = randomize.Struct(seed, o, receiptDBTypes, true, receiptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Receipt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ReceiptSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Receipts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testReceiptsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Receipt{}
+	if err = randomize.Struct(seed, o, receiptDBTypes, true, receiptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Receipt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ReceiptExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if Receipt exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ReceiptExists to return true, but got false.")
+	}
+}
+
+func testReceiptsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Receipt{}
+	if err = randomize.Struct(seed, o, receiptDBTypes, true, receiptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Receipt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	receiptFound, err := FindReceipt(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if receiptFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testReceiptsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Receipt{}
+	if err = randomize.Struct(seed, o, receiptDBTypes, true, receiptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Receipt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Receipts().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testReceiptsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Receipt{}
+	if err = randomize.Struct(seed, o, receiptDBTypes, true, receiptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Receipt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Receipts().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testReceiptsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	receiptOne := &Receipt{}
+	receiptTwo := &Receipt{}
+	if err = randomize.Struct(seed, receiptOne, receiptDBTypes, false, receiptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Receipt struct: %s", err)
+	}
+	if err = randomize.Struct(seed, receiptTwo, receiptDBTypes, false, receiptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Receipt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = receiptOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = receiptTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Receipts().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testReceiptsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	receiptOne := &Receipt{}
+	receiptTwo := &Receipt{}
+	if err = randomize.Struct(seed, receiptOne, receiptDBTypes, false, receiptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Receipt struct: %s", err)
+	}
+	if err = randomize.Struct(seed, receiptTwo, receiptDBTypes, false, receiptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Receipt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = receiptOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = receiptTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Receipts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func receiptBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *Receipt) error {
+	*o = Receipt{}
+	return nil
+}
+
+func receiptAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *Receipt) error {
+	*o = Receipt{}
+	return nil
+}
+
+func receiptAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *Receipt) error {
+	*o = Receipt{}
+	return nil
+}
+
+func receiptBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Receipt) error {
+	*o = Receipt{}
+	return nil
+}
+
+func receiptAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Receipt) error {
+	*o = Receipt{}
+	return nil
+}
+
+func receiptBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Receipt) error {
+	*o = Receipt{}
+	return nil
+}
+
+func receiptAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Receipt) error {
+	*o = Receipt{}
+	return nil
+}
+
+func receiptBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Receipt) error {
+	*o = Receipt{}
+	return nil
+}
+
+func receiptAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Receipt) error {
+	*o = Receipt{}
+	return nil
+}
+
+func testReceiptsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &Receipt{}
+	o := &Receipt{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, receiptDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize Receipt object: %s", err)
+	}
+
+	AddReceiptHook(boil.BeforeInsertHook, receiptBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	receiptBeforeInsertHooks = []ReceiptHook{}
+
+	AddReceiptHook(boil.AfterInsertHook, receiptAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	receiptAfterInsertHooks = []ReceiptHook{}
+
+	AddReceiptHook(boil.AfterSelectHook, receiptAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	receiptAfterSelectHooks = []ReceiptHook{}
+
+	AddReceiptHook(boil.BeforeUpdateHook, receiptBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	receiptBeforeUpdateHooks = []ReceiptHook{}
+
+	AddReceiptHook(boil.AfterUpdateHook, receiptAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	receiptAfterUpdateHooks = []ReceiptHook{}
+
+	AddReceiptHook(boil.BeforeDeleteHook, receiptBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	receiptBeforeDeleteHooks = []ReceiptHook{}
+
+	AddReceiptHook(boil.AfterDeleteHook, receiptAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	receiptAfterDeleteHooks = []ReceiptHook{}
+
+	AddReceiptHook(boil.BeforeUpsertHook, receiptBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	receiptBeforeUpsertHooks = []ReceiptHook{}
+
+	AddReceiptHook(boil.AfterUpsertHook, receiptAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	receiptAfterUpsertHooks = []ReceiptHook{}
+}
+
+func testReceiptsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Receipt{}
+	if err = randomize.Struct(seed, o, receiptDBTypes, true, receiptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Receipt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Receipts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testReceiptsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Receipt{}
+	if err = randomize.Struct(seed, o, receiptDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Receipt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(receiptPrimaryKeyColumns, receiptColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Receipts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testReceiptToManyTransactions(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Receipt
+	var b, c Transaction
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, receiptDBTypes, true, receiptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Receipt struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, transactionDBTypes, false, transactionColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, transactionDBTypes, false, transactionColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.ReceiptID, a.ID)
+	queries.Assign(&c.ReceiptID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Transactions().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.ReceiptID, b.ReceiptID) {
+			bFound = true
+		}
+		if queries.Equal(v.ReceiptID, c.ReceiptID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := ReceiptSlice{&a}
+	if err = a.L.LoadTransactions(ctx, tx, false, (*[]*Receipt)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Transactions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Transactions = nil
+	if err = a.L.LoadTransactions(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Transactions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testReceiptToManyAddOpTransactions(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Receipt
+	var b, c, d, e Transaction
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, receiptDBTypes, false, strmangle.SetComplement(receiptPrimaryKeyColumns, receiptColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Transaction{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Transaction{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddTransactions(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.ReceiptID) {
+			t.Error("foreign key was wrong value", a.ID, first.ReceiptID)
+		}
+		if !queries.Equal(a.ID, second.ReceiptID) {
+			t.Error("foreign key was wrong value", a.ID, second.ReceiptID)
+		}
+
+		if first.R.Receipt != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Receipt != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Transactions[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Transactions[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Transactions().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testReceiptToManySetOpTransactions(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Receipt
+	var b, c, d, e Transaction
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, receiptDBTypes, false, strmangle.SetComplement(receiptPrimaryKeyColumns, receiptColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Transaction{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetTransactions(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Transactions().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetTransactions(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Transactions().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.ReceiptID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.ReceiptID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.ReceiptID) {
+		t.Error("foreign key was wrong value", a.ID, d.ReceiptID)
+	}
+	if !queries.Equal(a.ID, e.ReceiptID) {
+		t.Error("foreign key was wrong value", a.ID, e.ReceiptID)
+	}
+
+	if b.R.Receipt != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Receipt != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Receipt != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Receipt != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.Transactions[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.Transactions[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testReceiptToManyRemoveOpTransactions(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Receipt
+	var b, c, d, e Transaction
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, receiptDBTypes, false, strmangle.SetComplement(receiptPrimaryKeyColumns, receiptColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Transaction{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, transactionDBTypes, false, strmangle.SetComplement(transactionPrimaryKeyColumns, transactionColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddTransactions(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Transactions().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveTransactions(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Transactions().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.ReceiptID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.ReceiptID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Receipt != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Receipt != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Receipt != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Receipt != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.Transactions) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.Transactions[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.Transactions[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testReceiptsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Receipt{}
+	if err = randomize.Struct(seed, o, receiptDBTypes, true, receiptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Receipt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testReceiptsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Receipt{}
+	if err = randomize.Struct(seed, o, receiptDBTypes, true, receiptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Receipt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ReceiptSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testReceiptsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Receipt{}
+	if err = randomize.Struct(seed, o, receiptDBTypes, true, receiptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Receipt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Receipts().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	receiptDBTypes = map[string]string{`ID`: `INTEGER`, `Hash`: `TEXT`, `FileName`: `TEXT`, `MimeType`: `TEXT`, `CreatedAt`: `DATETIME`}
+	_              = bytes.MinRead
+)
+
+func testReceiptsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(receiptPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(receiptAllColumns) == len(receiptPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Receipt{}
+	if err = randomize.Struct(seed, o, receiptDBTypes, true, receiptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Receipt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Receipts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, receiptDBTypes, true, receiptPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Receipt struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testReceiptsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(receiptAllColumns) == len(receiptPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Receipt{}
+	if err = randomize.Struct(seed, o, receiptDBTypes, true, receiptColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Receipt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Receipts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, receiptDBTypes, true, receiptPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Receipt struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(receiptAllColumns, receiptPrimaryKeyColumns) {
+		fields = receiptAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			receiptAllColumns,
+			receiptPrimaryKeyColumns,
+		)
+		fields = strmangle.SetComplement(fields, receiptGeneratedColumns)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ReceiptSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testReceiptsUpsert(t *testing.T) {
+	t.Parallel()
+	if len(receiptAllColumns) == len(receiptPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := Receipt{}
+	if err = randomize.Struct(seed, &o, receiptDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Receipt struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Receipt: %s", err)
+	}
+
+	count, err := Receipts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, receiptDBTypes, false, receiptPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Receipt struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Receipt: %s", err)
+	}
+
+	count, err = Receipts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
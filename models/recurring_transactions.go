@@ -0,0 +1,1654 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// RecurringTransaction is an object representing the database table.
+type RecurringTransaction struct {
+	ID          null.Int64 `boil:"id" json:"id,omitempty" toml:"id" yaml:"id,omitempty"`
+	Type        string     `boil:"type" json:"type" toml:"type" yaml:"type"`
+	Description string     `boil:"description" json:"description" toml:"description" yaml:"description"`
+	Amount      int64      `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	AccountID   int64      `boil:"account_id" json:"account_id" toml:"account_id" yaml:"account_id"`
+	CategoryID  null.Int64 `boil:"category_id" json:"category_id,omitempty" toml:"category_id" yaml:"category_id,omitempty"`
+	Frequency   string     `boil:"frequency" json:"frequency" toml:"frequency" yaml:"frequency"`
+	Day         int64      `boil:"day" json:"day" toml:"day" yaml:"day"`
+	Month       int64      `boil:"month" json:"month" toml:"month" yaml:"month"`
+	NextRun     time.Time  `boil:"next_run" json:"next_run" toml:"next_run" yaml:"next_run"`
+	Chat        string     `boil:"chat" json:"chat" toml:"chat" yaml:"chat"`
+	CreatedAt   time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	LedgerID    int64      `boil:"ledger_id" json:"ledger_id" toml:"ledger_id" yaml:"ledger_id"`
+
+	R *recurringTransactionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L recurringTransactionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var RecurringTransactionColumns = struct {
+	ID          string
+	Type        string
+	Description string
+	Amount      string
+	AccountID   string
+	CategoryID  string
+	Frequency   string
+	Day         string
+	Month       string
+	NextRun     string
+	Chat        string
+	CreatedAt   string
+	LedgerID    string
+}{
+	ID:          "id",
+	Type:        "type",
+	Description: "description",
+	Amount:      "amount",
+	AccountID:   "account_id",
+	CategoryID:  "category_id",
+	Frequency:   "frequency",
+	Day:         "day",
+	Month:       "month",
+	NextRun:     "next_run",
+	Chat:        "chat",
+	CreatedAt:   "created_at",
+	LedgerID:    "ledger_id",
+}
+
+var RecurringTransactionTableColumns = struct {
+	ID          string
+	Type        string
+	Description string
+	Amount      string
+	AccountID   string
+	CategoryID  string
+	Frequency   string
+	Day         string
+	Month       string
+	NextRun     string
+	Chat        string
+	CreatedAt   string
+	LedgerID    string
+}{
+	ID:          "recurring_transactions.id",
+	Type:        "recurring_transactions.type",
+	Description: "recurring_transactions.description",
+	Amount:      "recurring_transactions.amount",
+	AccountID:   "recurring_transactions.account_id",
+	CategoryID:  "recurring_transactions.category_id",
+	Frequency:   "recurring_transactions.frequency",
+	Day:         "recurring_transactions.day",
+	Month:       "recurring_transactions.month",
+	NextRun:     "recurring_transactions.next_run",
+	Chat:        "recurring_transactions.chat",
+	CreatedAt:   "recurring_transactions.created_at",
+	LedgerID:    "recurring_transactions.ledger_id",
+}
+
+// Generated where
+
+var RecurringTransactionWhere = struct {
+	ID          whereHelpernull_Int64
+	Type        whereHelperstring
+	Description whereHelperstring
+	Amount      whereHelperint64
+	AccountID   whereHelperint64
+	CategoryID  whereHelpernull_Int64
+	Frequency   whereHelperstring
+	Day         whereHelperint64
+	Month       whereHelperint64
+	NextRun     whereHelpertime_Time
+	Chat        whereHelperstring
+	CreatedAt   whereHelpertime_Time
+	LedgerID    whereHelperint64
+}{
+	ID:          whereHelpernull_Int64{field: "\"recurring_transactions\".\"id\""},
+	Type:        whereHelperstring{field: "\"recurring_transactions\".\"type\""},
+	Description: whereHelperstring{field: "\"recurring_transactions\".\"description\""},
+	Amount:      whereHelperint64{field: "\"recurring_transactions\".\"amount\""},
+	AccountID:   whereHelperint64{field: "\"recurring_transactions\".\"account_id\""},
+	CategoryID:  whereHelpernull_Int64{field: "\"recurring_transactions\".\"category_id\""},
+	Frequency:   whereHelperstring{field: "\"recurring_transactions\".\"frequency\""},
+	Day:         whereHelperint64{field: "\"recurring_transactions\".\"day\""},
+	Month:       whereHelperint64{field: "\"recurring_transactions\".\"month\""},
+	NextRun:     whereHelpertime_Time{field: "\"recurring_transactions\".\"next_run\""},
+	Chat:        whereHelperstring{field: "\"recurring_transactions\".\"chat\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"recurring_transactions\".\"created_at\""},
+	LedgerID:    whereHelperint64{field: "\"recurring_transactions\".\"ledger_id\""},
+}
+
+// RecurringTransactionRels is where relationship names are stored.
+var RecurringTransactionRels = struct {
+	Ledger   string
+	Category string
+	Account  string
+}{
+	Ledger:   "Ledger",
+	Category: "Category",
+	Account:  "Account",
+}
+
+// recurringTransactionR is where relationships are stored.
+type recurringTransactionR struct {
+	Ledger   *Ledger   `boil:"Ledger" json:"Ledger" toml:"Ledger" yaml:"Ledger"`
+	Category *Category `boil:"Category" json:"Category" toml:"Category" yaml:"Category"`
+	Account  *Account  `boil:"Account" json:"Account" toml:"Account" yaml:"Account"`
+}
+
+// NewStruct creates a new relationship struct
+func (*recurringTransactionR) NewStruct() *recurringTransactionR {
+	return &recurringTransactionR{}
+}
+
+func (o *RecurringTransaction) GetLedger() *Ledger {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetLedger()
+}
+
+func (r *recurringTransactionR) GetLedger() *Ledger {
+	if r == nil {
+		return nil
+	}
+
+	return r.Ledger
+}
+
+func (o *RecurringTransaction) GetCategory() *Category {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCategory()
+}
+
+func (r *recurringTransactionR) GetCategory() *Category {
+	if r == nil {
+		return nil
+	}
+
+	return r.Category
+}
+
+func (o *RecurringTransaction) GetAccount() *Account {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetAccount()
+}
+
+func (r *recurringTransactionR) GetAccount() *Account {
+	if r == nil {
+		return nil
+	}
+
+	return r.Account
+}
+
+// recurringTransactionL is where Load methods for each relationship are stored.
+type recurringTransactionL struct{}
+
+var (
+	recurringTransactionAllColumns            = []string{"id", "type", "description", "amount", "account_id", "category_id", "frequency", "day", "month", "next_run", "chat", "created_at", "ledger_id"}
+	recurringTransactionColumnsWithoutDefault = []string{"type", "description", "amount", "account_id", "frequency", "day", "next_run", "chat", "ledger_id"}
+	recurringTransactionColumnsWithDefault    = []string{"id", "category_id", "month", "created_at"}
+	recurringTransactionPrimaryKeyColumns     = []string{"id"}
+	recurringTransactionGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// RecurringTransactionSlice is an alias for a slice of pointers to RecurringTransaction.
+	// This should almost always be used instead of []RecurringTransaction.
+	RecurringTransactionSlice []*RecurringTransaction
+	// RecurringTransactionHook is the signature for custom RecurringTransaction hook methods
+	RecurringTransactionHook func(context.Context, boil.ContextExecutor, *RecurringTransaction) error
+
+	recurringTransactionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	recurringTransactionType                 = reflect.TypeOf(&RecurringTransaction{})
+	recurringTransactionMapping              = queries.MakeStructMapping(recurringTransactionType)
+	recurringTransactionPrimaryKeyMapping, _ = queries.BindMapping(recurringTransactionType, recurringTransactionMapping, recurringTransactionPrimaryKeyColumns)
+	recurringTransactionInsertCacheMut       sync.RWMutex
+	recurringTransactionInsertCache          = make(map[string]insertCache)
+	recurringTransactionUpdateCacheMut       sync.RWMutex
+	recurringTransactionUpdateCache          = make(map[string]updateCache)
+	recurringTransactionUpsertCacheMut       sync.RWMutex
+	recurringTransactionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var recurringTransactionAfterSelectMu sync.Mutex
+var recurringTransactionAfterSelectHooks []RecurringTransactionHook
+
+var recurringTransactionBeforeInsertMu sync.Mutex
+var recurringTransactionBeforeInsertHooks []RecurringTransactionHook
+var recurringTransactionAfterInsertMu sync.Mutex
+var recurringTransactionAfterInsertHooks []RecurringTransactionHook
+
+var recurringTransactionBeforeUpdateMu sync.Mutex
+var recurringTransactionBeforeUpdateHooks []RecurringTransactionHook
+var recurringTransactionAfterUpdateMu sync.Mutex
+var recurringTransactionAfterUpdateHooks []RecurringTransactionHook
+
+var recurringTransactionBeforeDeleteMu sync.Mutex
+var recurringTransactionBeforeDeleteHooks []RecurringTransactionHook
+var recurringTransactionAfterDeleteMu sync.Mutex
+var recurringTransactionAfterDeleteHooks []RecurringTransactionHook
+
+var recurringTransactionBeforeUpsertMu sync.Mutex
+var recurringTransactionBeforeUpsertHooks []RecurringTransactionHook
+var recurringTransactionAfterUpsertMu sync.Mutex
+var recurringTransactionAfterUpsertHooks []RecurringTransactionHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *RecurringTransaction) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range recurringTransactionAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *RecurringTransaction) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range recurringTransactionBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *RecurringTransaction) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range recurringTransactionAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *RecurringTransaction) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range recurringTransactionBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *RecurringTransaction) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range recurringTransactionAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *RecurringTransaction) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range recurringTransactionBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *RecurringTransaction) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range recurringTransactionAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *RecurringTransaction) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range recurringTransactionBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *RecurringTransaction) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range recurringTransactionAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddRecurringTransactionHook registers your hook function for all future operations.
+func AddRecurringTransactionHook(hookPoint boil.HookPoint, recurringTransactionHook RecurringTransactionHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		recurringTransactionAfterSelectMu.Lock()
+		recurringTransactionAfterSelectHooks = append(recurringTransactionAfterSelectHooks, recurringTransactionHook)
+		recurringTransactionAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		recurringTransactionBeforeInsertMu.Lock()
+		recurringTransactionBeforeInsertHooks = append(recurringTransactionBeforeInsertHooks, recurringTransactionHook)
+		recurringTransactionBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		recurringTransactionAfterInsertMu.Lock()
+		recurringTransactionAfterInsertHooks = append(recurringTransactionAfterInsertHooks, recurringTransactionHook)
+		recurringTransactionAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		recurringTransactionBeforeUpdateMu.Lock()
+		recurringTransactionBeforeUpdateHooks = append(recurringTransactionBeforeUpdateHooks, recurringTransactionHook)
+		recurringTransactionBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		recurringTransactionAfterUpdateMu.Lock()
+		recurringTransactionAfterUpdateHooks = append(recurringTransactionAfterUpdateHooks, recurringTransactionHook)
+		recurringTransactionAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		recurringTransactionBeforeDeleteMu.Lock()
+		recurringTransactionBeforeDeleteHooks = append(recurringTransactionBeforeDeleteHooks, recurringTransactionHook)
+		recurringTransactionBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		recurringTransactionAfterDeleteMu.Lock()
+		recurringTransactionAfterDeleteHooks = append(recurringTransactionAfterDeleteHooks, recurringTransactionHook)
+		recurringTransactionAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		recurringTransactionBeforeUpsertMu.Lock()
+		recurringTransactionBeforeUpsertHooks = append(recurringTransactionBeforeUpsertHooks, recurringTransactionHook)
+		recurringTransactionBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		recurringTransactionAfterUpsertMu.Lock()
+		recurringTransactionAfterUpsertHooks = append(recurringTransactionAfterUpsertHooks, recurringTransactionHook)
+		recurringTransactionAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single recurringTransaction record from the query.
+func (q recurringTransactionQuery) One(ctx context.Context, exec boil.ContextExecutor) (*RecurringTransaction, error) {
+	o := &RecurringTransaction{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for recurring_transactions")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all RecurringTransaction records from the query.
+func (q recurringTransactionQuery) All(ctx context.Context, exec boil.ContextExecutor) (RecurringTransactionSlice, error) {
+	var o []*RecurringTransaction
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to RecurringTransaction slice")
+	}
+
+	if len(recurringTransactionAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all RecurringTransaction records in the query.
+func (q recurringTransactionQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count recurring_transactions rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q recurringTransactionQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if recurring_transactions exists")
+	}
+
+	return count > 0, nil
+}
+
+// Ledger pointed to by the foreign key.
+func (o *RecurringTransaction) Ledger(mods ...qm.QueryMod) ledgerQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.LedgerID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Ledgers(queryMods...)
+}
+
+// Category pointed to by the foreign key.
+func (o *RecurringTransaction) Category(mods ...qm.QueryMod) categoryQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CategoryID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Categories(queryMods...)
+}
+
+// Account pointed to by the foreign key.
+func (o *RecurringTransaction) Account(mods ...qm.QueryMod) accountQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AccountID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Accounts(queryMods...)
+}
+
+// LoadLedger allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (recurringTransactionL) LoadLedger(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRecurringTransaction interface{}, mods queries.Applicator) error {
+	var slice []*RecurringTransaction
+	var object *RecurringTransaction
+
+	if singular {
+		var ok bool
+		object, ok = maybeRecurringTransaction.(*RecurringTransaction)
+		if !ok {
+			object = new(RecurringTransaction)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRecurringTransaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRecurringTransaction))
+			}
+		}
+	} else {
+		s, ok := maybeRecurringTransaction.(*[]*RecurringTransaction)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRecurringTransaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRecurringTransaction))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &recurringTransactionR{}
+		}
+		if !queries.IsNil(object.LedgerID) {
+			args[object.LedgerID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &recurringTransactionR{}
+			}
+
+			if !queries.IsNil(obj.LedgerID) {
+				args[obj.LedgerID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`ledgers`),
+		qm.WhereIn(`ledgers.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Ledger")
+	}
+
+	var resultSlice []*Ledger
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Ledger")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for ledgers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for ledgers")
+	}
+
+	if len(ledgerAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Ledger = foreign
+		if foreign.R == nil {
+			foreign.R = &ledgerR{}
+		}
+		foreign.R.RecurringTransactions = append(foreign.R.RecurringTransactions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.LedgerID, foreign.ID) {
+				local.R.Ledger = foreign
+				if foreign.R == nil {
+					foreign.R = &ledgerR{}
+				}
+				foreign.R.RecurringTransactions = append(foreign.R.RecurringTransactions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCategory allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (recurringTransactionL) LoadCategory(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRecurringTransaction interface{}, mods queries.Applicator) error {
+	var slice []*RecurringTransaction
+	var object *RecurringTransaction
+
+	if singular {
+		var ok bool
+		object, ok = maybeRecurringTransaction.(*RecurringTransaction)
+		if !ok {
+			object = new(RecurringTransaction)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRecurringTransaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRecurringTransaction))
+			}
+		}
+	} else {
+		s, ok := maybeRecurringTransaction.(*[]*RecurringTransaction)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRecurringTransaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRecurringTransaction))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &recurringTransactionR{}
+		}
+		if !queries.IsNil(object.CategoryID) {
+			args[object.CategoryID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &recurringTransactionR{}
+			}
+
+			if !queries.IsNil(obj.CategoryID) {
+				args[obj.CategoryID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`categories`),
+		qm.WhereIn(`categories.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Category")
+	}
+
+	var resultSlice []*Category
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Category")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for categories")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for categories")
+	}
+
+	if len(categoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Category = foreign
+		if foreign.R == nil {
+			foreign.R = &categoryR{}
+		}
+		foreign.R.RecurringTransactions = append(foreign.R.RecurringTransactions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.CategoryID, foreign.ID) {
+				local.R.Category = foreign
+				if foreign.R == nil {
+					foreign.R = &categoryR{}
+				}
+				foreign.R.RecurringTransactions = append(foreign.R.RecurringTransactions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAccount allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (recurringTransactionL) LoadAccount(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRecurringTransaction interface{}, mods queries.Applicator) error {
+	var slice []*RecurringTransaction
+	var object *RecurringTransaction
+
+	if singular {
+		var ok bool
+		object, ok = maybeRecurringTransaction.(*RecurringTransaction)
+		if !ok {
+			object = new(RecurringTransaction)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRecurringTransaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRecurringTransaction))
+			}
+		}
+	} else {
+		s, ok := maybeRecurringTransaction.(*[]*RecurringTransaction)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRecurringTransaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRecurringTransaction))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &recurringTransactionR{}
+		}
+		if !queries.IsNil(object.AccountID) {
+			args[object.AccountID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &recurringTransactionR{}
+			}
+
+			if !queries.IsNil(obj.AccountID) {
+				args[obj.AccountID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`accounts`),
+		qm.WhereIn(`accounts.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Account")
+	}
+
+	var resultSlice []*Account
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Account")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for accounts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for accounts")
+	}
+
+	if len(accountAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Account = foreign
+		if foreign.R == nil {
+			foreign.R = &accountR{}
+		}
+		foreign.R.RecurringTransactions = append(foreign.R.RecurringTransactions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.AccountID, foreign.ID) {
+				local.R.Account = foreign
+				if foreign.R == nil {
+					foreign.R = &accountR{}
+				}
+				foreign.R.RecurringTransactions = append(foreign.R.RecurringTransactions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetLedger of the recurringTransaction to the related item.
+// Sets o.R.Ledger to related.
+// Adds o to related.R.RecurringTransactions.
+func (o *RecurringTransaction) SetLedger(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Ledger) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"recurring_transactions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"ledger_id"}),
+		strmangle.WhereClause("\"", "\"", 0, recurringTransactionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.LedgerID, related.ID)
+	if o.R == nil {
+		o.R = &recurringTransactionR{
+			Ledger: related,
+		}
+	} else {
+		o.R.Ledger = related
+	}
+
+	if related.R == nil {
+		related.R = &ledgerR{
+			RecurringTransactions: RecurringTransactionSlice{o},
+		}
+	} else {
+		related.R.RecurringTransactions = append(related.R.RecurringTransactions, o)
+	}
+
+	return nil
+}
+
+// SetCategory of the recurringTransaction to the related item.
+// Sets o.R.Category to related.
+// Adds o to related.R.RecurringTransactions.
+func (o *RecurringTransaction) SetCategory(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Category) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"recurring_transactions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"category_id"}),
+		strmangle.WhereClause("\"", "\"", 0, recurringTransactionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.CategoryID, related.ID)
+	if o.R == nil {
+		o.R = &recurringTransactionR{
+			Category: related,
+		}
+	} else {
+		o.R.Category = related
+	}
+
+	if related.R == nil {
+		related.R = &categoryR{
+			RecurringTransactions: RecurringTransactionSlice{o},
+		}
+	} else {
+		related.R.RecurringTransactions = append(related.R.RecurringTransactions, o)
+	}
+
+	return nil
+}
+
+// RemoveCategory relationship.
+// Sets o.R.Category to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *RecurringTransaction) RemoveCategory(ctx context.Context, exec boil.ContextExecutor, related *Category) error {
+	var err error
+
+	queries.SetScanner(&o.CategoryID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("category_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Category = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.RecurringTransactions {
+		if queries.Equal(o.CategoryID, ri.CategoryID) {
+			continue
+		}
+
+		ln := len(related.R.RecurringTransactions)
+		if ln > 1 && i < ln-1 {
+			related.R.RecurringTransactions[i] = related.R.RecurringTransactions[ln-1]
+		}
+		related.R.RecurringTransactions = related.R.RecurringTransactions[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetAccount of the recurringTransaction to the related item.
+// Sets o.R.Account to related.
+// Adds o to related.R.RecurringTransactions.
+func (o *RecurringTransaction) SetAccount(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Account) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"recurring_transactions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"account_id"}),
+		strmangle.WhereClause("\"", "\"", 0, recurringTransactionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.AccountID, related.ID)
+	if o.R == nil {
+		o.R = &recurringTransactionR{
+			Account: related,
+		}
+	} else {
+		o.R.Account = related
+	}
+
+	if related.R == nil {
+		related.R = &accountR{
+			RecurringTransactions: RecurringTransactionSlice{o},
+		}
+	} else {
+		related.R.RecurringTransactions = append(related.R.RecurringTransactions, o)
+	}
+
+	return nil
+}
+
+// RecurringTransactions retrieves all the records using an executor.
+func RecurringTransactions(mods ...qm.QueryMod) recurringTransactionQuery {
+	mods = append(mods, qm.From("\"recurring_transactions\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"recurring_transactions\".*"})
+	}
+
+	return recurringTransactionQuery{q}
+}
+
+// FindRecurringTransaction retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindRecurringTransaction(ctx context.Context, exec boil.ContextExecutor, iD null.Int64, selectCols ...string) (*RecurringTransaction, error) {
+	recurringTransactionObj := &RecurringTransaction{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"recurring_transactions\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, recurringTransactionObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from recurring_transactions")
+	}
+
+	if err = recurringTransactionObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return recurringTransactionObj, err
+	}
+
+	return recurringTransactionObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *RecurringTransaction) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no recurring_transactions provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(recurringTransactionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	recurringTransactionInsertCacheMut.RLock()
+	cache, cached := recurringTransactionInsertCache[key]
+	recurringTransactionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			recurringTransactionAllColumns,
+			recurringTransactionColumnsWithDefault,
+			recurringTransactionColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, recurringTransactionGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(recurringTransactionType, recurringTransactionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(recurringTransactionType, recurringTransactionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"recurring_transactions\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"recurring_transactions\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into recurring_transactions")
+	}
+
+	if !cached {
+		recurringTransactionInsertCacheMut.Lock()
+		recurringTransactionInsertCache[key] = cache
+		recurringTransactionInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the RecurringTransaction.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *RecurringTransaction) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	recurringTransactionUpdateCacheMut.RLock()
+	cache, cached := recurringTransactionUpdateCache[key]
+	recurringTransactionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			recurringTransactionAllColumns,
+			recurringTransactionPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, recurringTransactionGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update recurring_transactions, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"recurring_transactions\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, recurringTransactionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(recurringTransactionType, recurringTransactionMapping, append(wl, recurringTransactionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update recurring_transactions row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for recurring_transactions")
+	}
+
+	if !cached {
+		recurringTransactionUpdateCacheMut.Lock()
+		recurringTransactionUpdateCache[key] = cache
+		recurringTransactionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q recurringTransactionQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for recurring_transactions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for recurring_transactions")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o RecurringTransactionSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), recurringTransactionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"recurring_transactions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, recurringTransactionPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in recurringTransaction slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all recurringTransaction")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *RecurringTransaction) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no recurring_transactions provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(recurringTransactionColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	recurringTransactionUpsertCacheMut.RLock()
+	cache, cached := recurringTransactionUpsertCache[key]
+	recurringTransactionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			recurringTransactionAllColumns,
+			recurringTransactionColumnsWithDefault,
+			recurringTransactionColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			recurringTransactionAllColumns,
+			recurringTransactionPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert recurring_transactions, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(recurringTransactionAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(recurringTransactionPrimaryKeyColumns))
+			copy(conflict, recurringTransactionPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"recurring_transactions\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(recurringTransactionType, recurringTransactionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(recurringTransactionType, recurringTransactionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert recurring_transactions")
+	}
+
+	if !cached {
+		recurringTransactionUpsertCacheMut.Lock()
+		recurringTransactionUpsertCache[key] = cache
+		recurringTransactionUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single RecurringTransaction record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *RecurringTransaction) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no RecurringTransaction provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), recurringTransactionPrimaryKeyMapping)
+	sql := "DELETE FROM \"recurring_transactions\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from recurring_transactions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for recurring_transactions")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q recurringTransactionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no recurringTransactionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from recurring_transactions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for recurring_transactions")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o RecurringTransactionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(recurringTransactionBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), recurringTransactionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"recurring_transactions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, recurringTransactionPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from recurringTransaction slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for recurring_transactions")
+	}
+
+	if len(recurringTransactionAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *RecurringTransaction) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindRecurringTransaction(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RecurringTransactionSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := RecurringTransactionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), recurringTransactionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"recurring_transactions\".* FROM \"recurring_transactions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, recurringTransactionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in RecurringTransactionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// RecurringTransactionExists checks if the RecurringTransaction row exists.
+func RecurringTransactionExists(ctx context.Context, exec boil.ContextExecutor, iD null.Int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"recurring_transactions\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if recurring_transactions exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the RecurringTransaction row exists.
+func (o *RecurringTransaction) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return RecurringTransactionExists(ctx, exec, o.ID)
+}
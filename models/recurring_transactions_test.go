@@ -0,0 +1,1139 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testRecurringTransactions(t *testing.T) {
+	t.Parallel()
+
+	query := RecurringTransactions()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testRecurringTransactionsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RecurringTransaction{}
+	if err = randomize.Struct(seed, o, recurringTransactionDBTypes, true, recurringTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransaction struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := RecurringTransactions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRecurringTransactionsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RecurringTransaction{}
+	if err = randomize.Struct(seed, o, recurringTransactionDBTypes, true, recurringTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransaction struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := RecurringTransactions().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := RecurringTransactions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRecurringTransactionsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RecurringTransaction{}
+	if err = randomize.Struct(seed, o, recurringTransactionDBTypes, true, recurringTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransaction struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := RecurringTransactionSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := RecurringTransactions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRecurringTransactionsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RecurringTransaction{}
+	if err = randomize.Struct(seed, o, recurringTransactionDBTypes, true, recurringTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransaction struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := RecurringTransactionExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if RecurringTransaction exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected RecurringTransactionExists to return true, but got false.")
+	}
+}
+
+func testRecurringTransactionsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RecurringTransaction{}
+	if err = randomize.Struct(seed, o, recurringTransactionDBTypes, true, recurringTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransaction struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	recurringTransactionFound, err := FindRecurringTransaction(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if recurringTransactionFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testRecurringTransactionsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RecurringTransaction{}
+	if err = randomize.Struct(seed, o, recurringTransactionDBTypes, true, recurringTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransaction struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = RecurringTransactions().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRecurringTransactionsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RecurringTransaction{}
+	if err = randomize.Struct(seed, o, recurringTransactionDBTypes, true, recurringTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransaction struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := RecurringTransactions().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testRecurringTransactionsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	recurringTransactionOne := &RecurringTransaction{}
+	recurringTransactionTwo := &RecurringTransaction{}
+	if err = randomize.Struct(seed, recurringTransactionOne, recurringTransactionDBTypes, false, recurringTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransaction struct: %s", err)
+	}
+	if err = randomize.Struct(seed, recurringTransactionTwo, recurringTransactionDBTypes, false, recurringTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransaction struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = recurringTransactionOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = recurringTransactionTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := RecurringTransactions().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testRecurringTransactionsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	recurringTransactionOne := &RecurringTransaction{}
+	recurringTransactionTwo := &RecurringTransaction{}
+	if err = randomize.Struct(seed, recurringTransactionOne, recurringTransactionDBTypes, false, recurringTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransaction struct: %s", err)
+	}
+	if err = randomize.Struct(seed, recurringTransactionTwo, recurringTransactionDBTypes, false, recurringTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransaction struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = recurringTransactionOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = recurringTransactionTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RecurringTransactions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func recurringTransactionBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *RecurringTransaction) error {
+	*o = RecurringTransaction{}
+	return nil
+}
+
+func recurringTransactionAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *RecurringTransaction) error {
+	*o = RecurringTransaction{}
+	return nil
+}
+
+func recurringTransactionAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *RecurringTransaction) error {
+	*o = RecurringTransaction{}
+	return nil
+}
+
+func recurringTransactionBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *RecurringTransaction) error {
+	*o = RecurringTransaction{}
+	return nil
+}
+
+func recurringTransactionAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *RecurringTransaction) error {
+	*o = RecurringTransaction{}
+	return nil
+}
+
+func recurringTransactionBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *RecurringTransaction) error {
+	*o = RecurringTransaction{}
+	return nil
+}
+
+func recurringTransactionAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *RecurringTransaction) error {
+	*o = RecurringTransaction{}
+	return nil
+}
+
+func recurringTransactionBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *RecurringTransaction) error {
+	*o = RecurringTransaction{}
+	return nil
+}
+
+func recurringTransactionAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *RecurringTransaction) error {
+	*o = RecurringTransaction{}
+	return nil
+}
+
+func testRecurringTransactionsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &RecurringTransaction{}
+	o := &RecurringTransaction{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, recurringTransactionDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize RecurringTransaction object: %s", err)
+	}
+
+	AddRecurringTransactionHook(boil.BeforeInsertHook, recurringTransactionBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	recurringTransactionBeforeInsertHooks = []RecurringTransactionHook{}
+
+	AddRecurringTransactionHook(boil.AfterInsertHook, recurringTransactionAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	recurringTransactionAfterInsertHooks = []RecurringTransactionHook{}
+
+	AddRecurringTransactionHook(boil.AfterSelectHook, recurringTransactionAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	recurringTransactionAfterSelectHooks = []RecurringTransactionHook{}
+
+	AddRecurringTransactionHook(boil.BeforeUpdateHook, recurringTransactionBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	recurringTransactionBeforeUpdateHooks = []RecurringTransactionHook{}
+
+	AddRecurringTransactionHook(boil.AfterUpdateHook, recurringTransactionAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	recurringTransactionAfterUpdateHooks = []RecurringTransactionHook{}
+
+	AddRecurringTransactionHook(boil.BeforeDeleteHook, recurringTransactionBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	recurringTransactionBeforeDeleteHooks = []RecurringTransactionHook{}
+
+	AddRecurringTransactionHook(boil.AfterDeleteHook, recurringTransactionAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	recurringTransactionAfterDeleteHooks = []RecurringTransactionHook{}
+
+	AddRecurringTransactionHook(boil.BeforeUpsertHook, recurringTransactionBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	recurringTransactionBeforeUpsertHooks = []RecurringTransactionHook{}
+
+	AddRecurringTransactionHook(boil.AfterUpsertHook, recurringTransactionAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	recurringTransactionAfterUpsertHooks = []RecurringTransactionHook{}
+}
+
+func testRecurringTransactionsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RecurringTransaction{}
+	if err = randomize.Struct(seed, o, recurringTransactionDBTypes, true, recurringTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransaction struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RecurringTransactions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testRecurringTransactionsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RecurringTransaction{}
+	if err = randomize.Struct(seed, o, recurringTransactionDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize RecurringTransaction struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(recurringTransactionPrimaryKeyColumns, recurringTransactionColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RecurringTransactions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testRecurringTransactionToOneLedgerUsingLedger(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local RecurringTransaction
+	var foreign Ledger
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, recurringTransactionDBTypes, false, recurringTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransaction struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, ledgerDBTypes, true, ledgerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Ledger struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.LedgerID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Ledger().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddLedgerHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Ledger) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := RecurringTransactionSlice{&local}
+	if err = local.L.LoadLedger(ctx, tx, false, (*[]*RecurringTransaction)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Ledger == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Ledger = nil
+	if err = local.L.LoadLedger(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Ledger == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testRecurringTransactionToOneCategoryUsingCategory(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local RecurringTransaction
+	var foreign Category
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, recurringTransactionDBTypes, true, recurringTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransaction struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, categoryDBTypes, true, categoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Category struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.CategoryID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Category().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddCategoryHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Category) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := RecurringTransactionSlice{&local}
+	if err = local.L.LoadCategory(ctx, tx, false, (*[]*RecurringTransaction)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Category == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Category = nil
+	if err = local.L.LoadCategory(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Category == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testRecurringTransactionToOneAccountUsingAccount(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local RecurringTransaction
+	var foreign Account
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, recurringTransactionDBTypes, false, recurringTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransaction struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, accountDBTypes, true, accountColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Account struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.AccountID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Account().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddAccountHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Account) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := RecurringTransactionSlice{&local}
+	if err = local.L.LoadAccount(ctx, tx, false, (*[]*RecurringTransaction)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Account == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Account = nil
+	if err = local.L.LoadAccount(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Account == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testRecurringTransactionToOneSetOpLedgerUsingLedger(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a RecurringTransaction
+	var b, c Ledger
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, recurringTransactionDBTypes, false, strmangle.SetComplement(recurringTransactionPrimaryKeyColumns, recurringTransactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, ledgerDBTypes, false, strmangle.SetComplement(ledgerPrimaryKeyColumns, ledgerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, ledgerDBTypes, false, strmangle.SetComplement(ledgerPrimaryKeyColumns, ledgerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Ledger{&b, &c} {
+		err = a.SetLedger(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Ledger != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.RecurringTransactions[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.LedgerID, x.ID) {
+			t.Error("foreign key was wrong value", a.LedgerID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.LedgerID))
+		reflect.Indirect(reflect.ValueOf(&a.LedgerID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.LedgerID, x.ID) {
+			t.Error("foreign key was wrong value", a.LedgerID, x.ID)
+		}
+	}
+}
+func testRecurringTransactionToOneSetOpCategoryUsingCategory(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a RecurringTransaction
+	var b, c Category
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, recurringTransactionDBTypes, false, strmangle.SetComplement(recurringTransactionPrimaryKeyColumns, recurringTransactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, categoryDBTypes, false, strmangle.SetComplement(categoryPrimaryKeyColumns, categoryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, categoryDBTypes, false, strmangle.SetComplement(categoryPrimaryKeyColumns, categoryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Category{&b, &c} {
+		err = a.SetCategory(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Category != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.RecurringTransactions[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.CategoryID, x.ID) {
+			t.Error("foreign key was wrong value", a.CategoryID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.CategoryID))
+		reflect.Indirect(reflect.ValueOf(&a.CategoryID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.CategoryID, x.ID) {
+			t.Error("foreign key was wrong value", a.CategoryID, x.ID)
+		}
+	}
+}
+
+func testRecurringTransactionToOneRemoveOpCategoryUsingCategory(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a RecurringTransaction
+	var b Category
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, recurringTransactionDBTypes, false, strmangle.SetComplement(recurringTransactionPrimaryKeyColumns, recurringTransactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, categoryDBTypes, false, strmangle.SetComplement(categoryPrimaryKeyColumns, categoryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetCategory(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveCategory(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Category().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Category != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.CategoryID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.RecurringTransactions) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testRecurringTransactionToOneSetOpAccountUsingAccount(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a RecurringTransaction
+	var b, c Account
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, recurringTransactionDBTypes, false, strmangle.SetComplement(recurringTransactionPrimaryKeyColumns, recurringTransactionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, accountDBTypes, false, strmangle.SetComplement(accountPrimaryKeyColumns, accountColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, accountDBTypes, false, strmangle.SetComplement(accountPrimaryKeyColumns, accountColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Account{&b, &c} {
+		err = a.SetAccount(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Account != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.RecurringTransactions[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.AccountID, x.ID) {
+			t.Error("foreign key was wrong value", a.AccountID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.AccountID))
+		reflect.Indirect(reflect.ValueOf(&a.AccountID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.AccountID, x.ID) {
+			t.Error("foreign key was wrong value", a.AccountID, x.ID)
+		}
+	}
+}
+
+func testRecurringTransactionsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RecurringTransaction{}
+	if err = randomize.Struct(seed, o, recurringTransactionDBTypes, true, recurringTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransaction struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRecurringTransactionsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RecurringTransaction{}
+	if err = randomize.Struct(seed, o, recurringTransactionDBTypes, true, recurringTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransaction struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := RecurringTransactionSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRecurringTransactionsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RecurringTransaction{}
+	if err = randomize.Struct(seed, o, recurringTransactionDBTypes, true, recurringTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransaction struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := RecurringTransactions().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	recurringTransactionDBTypes = map[string]string{`ID`: `INTEGER`, `Type`: `TEXT`, `Description`: `TEXT`, `Amount`: `INTEGER`, `AccountID`: `INTEGER`, `CategoryID`: `INTEGER`, `Frequency`: `TEXT`, `Day`: `INTEGER`, `Month`: `INTEGER`, `NextRun`: `DATETIME`, `Chat`: `TEXT`, `CreatedAt`: `DATETIME`, `LedgerID`: `INTEGER`}
+	_                           = bytes.MinRead
+)
+
+func testRecurringTransactionsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(recurringTransactionPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(recurringTransactionAllColumns) == len(recurringTransactionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RecurringTransaction{}
+	if err = randomize.Struct(seed, o, recurringTransactionDBTypes, true, recurringTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransaction struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RecurringTransactions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, recurringTransactionDBTypes, true, recurringTransactionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransaction struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testRecurringTransactionsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(recurringTransactionAllColumns) == len(recurringTransactionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RecurringTransaction{}
+	if err = randomize.Struct(seed, o, recurringTransactionDBTypes, true, recurringTransactionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransaction struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RecurringTransactions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, recurringTransactionDBTypes, true, recurringTransactionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransaction struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(recurringTransactionAllColumns, recurringTransactionPrimaryKeyColumns) {
+		fields = recurringTransactionAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			recurringTransactionAllColumns,
+			recurringTransactionPrimaryKeyColumns,
+		)
+		fields = strmangle.SetComplement(fields, recurringTransactionGeneratedColumns)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := RecurringTransactionSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testRecurringTransactionsUpsert(t *testing.T) {
+	t.Parallel()
+	if len(recurringTransactionAllColumns) == len(recurringTransactionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := RecurringTransaction{}
+	if err = randomize.Struct(seed, &o, recurringTransactionDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize RecurringTransaction struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert RecurringTransaction: %s", err)
+	}
+
+	count, err := RecurringTransactions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, recurringTransactionDBTypes, false, recurringTransactionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize RecurringTransaction struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert RecurringTransaction: %s", err)
+	}
+
+	count, err = RecurringTransactions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -6,5 +6,33 @@ package models
 import "testing"
 
 func TestUpsert(t *testing.T) {
+	t.Run("Accounts", testAccountsUpsert)
+
+	t.Run("Batches", testBatchesUpsert)
+
+	t.Run("Budgets", testBudgetsUpsert)
+
+	t.Run("Categories", testCategoriesUpsert)
+
+	t.Run("Chats", testChatsUpsert)
+
+	t.Run("Counterparties", testCounterpartiesUpsert)
+
+	t.Run("Goals", testGoalsUpsert)
+
+	t.Run("Ledgers", testLedgersUpsert)
+
+	t.Run("Members", testMembersUpsert)
+
+	t.Run("Messages", testMessagesUpsert)
+
+	t.Run("ProcessedMessages", testProcessedMessagesUpsert)
+
+	t.Run("Receipts", testReceiptsUpsert)
+
+	t.Run("RecurringTransactions", testRecurringTransactionsUpsert)
+
+	t.Run("Summaries", testSummariesUpsert)
+
 	t.Run("Transactions", testTransactionsUpsert)
 }
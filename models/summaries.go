@@ -0,0 +1,968 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// Summary is an object representing the database table.
+type Summary struct {
+	ID        null.Int64 `boil:"id" json:"id,omitempty" toml:"id" yaml:"id,omitempty"`
+	Chat      string     `boil:"chat" json:"chat" toml:"chat" yaml:"chat"`
+	Kind      string     `boil:"kind" json:"kind" toml:"kind" yaml:"kind"`
+	Minute    int64      `boil:"minute" json:"minute" toml:"minute" yaml:"minute"`
+	NextRun   time.Time  `boil:"next_run" json:"next_run" toml:"next_run" yaml:"next_run"`
+	CreatedAt time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *summaryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L summaryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var SummaryColumns = struct {
+	ID        string
+	Chat      string
+	Kind      string
+	Minute    string
+	NextRun   string
+	CreatedAt string
+}{
+	ID:        "id",
+	Chat:      "chat",
+	Kind:      "kind",
+	Minute:    "minute",
+	NextRun:   "next_run",
+	CreatedAt: "created_at",
+}
+
+var SummaryTableColumns = struct {
+	ID        string
+	Chat      string
+	Kind      string
+	Minute    string
+	NextRun   string
+	CreatedAt string
+}{
+	ID:        "summaries.id",
+	Chat:      "summaries.chat",
+	Kind:      "summaries.kind",
+	Minute:    "summaries.minute",
+	NextRun:   "summaries.next_run",
+	CreatedAt: "summaries.created_at",
+}
+
+// Generated where
+
+var SummaryWhere = struct {
+	ID        whereHelpernull_Int64
+	Chat      whereHelperstring
+	Kind      whereHelperstring
+	Minute    whereHelperint64
+	NextRun   whereHelpertime_Time
+	CreatedAt whereHelpertime_Time
+}{
+	ID:        whereHelpernull_Int64{field: "\"summaries\".\"id\""},
+	Chat:      whereHelperstring{field: "\"summaries\".\"chat\""},
+	Kind:      whereHelperstring{field: "\"summaries\".\"kind\""},
+	Minute:    whereHelperint64{field: "\"summaries\".\"minute\""},
+	NextRun:   whereHelpertime_Time{field: "\"summaries\".\"next_run\""},
+	CreatedAt: whereHelpertime_Time{field: "\"summaries\".\"created_at\""},
+}
+
+// SummaryRels is where relationship names are stored.
+var SummaryRels = struct {
+}{}
+
+// summaryR is where relationships are stored.
+type summaryR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*summaryR) NewStruct() *summaryR {
+	return &summaryR{}
+}
+
+// summaryL is where Load methods for each relationship are stored.
+type summaryL struct{}
+
+var (
+	summaryAllColumns            = []string{"id", "chat", "kind", "minute", "next_run", "created_at"}
+	summaryColumnsWithoutDefault = []string{"chat", "kind", "minute", "next_run"}
+	summaryColumnsWithDefault    = []string{"id", "created_at"}
+	summaryPrimaryKeyColumns     = []string{"id"}
+	summaryGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// SummarySlice is an alias for a slice of pointers to Summary.
+	// This should almost always be used instead of []Summary.
+	SummarySlice []*Summary
+	// SummaryHook is the signature for custom Summary hook methods
+	SummaryHook func(context.Context, boil.ContextExecutor, *Summary) error
+
+	summaryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	summaryType                 = reflect.TypeOf(&Summary{})
+	summaryMapping              = queries.MakeStructMapping(summaryType)
+	summaryPrimaryKeyMapping, _ = queries.BindMapping(summaryType, summaryMapping, summaryPrimaryKeyColumns)
+	summaryInsertCacheMut       sync.RWMutex
+	summaryInsertCache          = make(map[string]insertCache)
+	summaryUpdateCacheMut       sync.RWMutex
+	summaryUpdateCache          = make(map[string]updateCache)
+	summaryUpsertCacheMut       sync.RWMutex
+	summaryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var summaryAfterSelectMu sync.Mutex
+var summaryAfterSelectHooks []SummaryHook
+
+var summaryBeforeInsertMu sync.Mutex
+var summaryBeforeInsertHooks []SummaryHook
+var summaryAfterInsertMu sync.Mutex
+var summaryAfterInsertHooks []SummaryHook
+
+var summaryBeforeUpdateMu sync.Mutex
+var summaryBeforeUpdateHooks []SummaryHook
+var summaryAfterUpdateMu sync.Mutex
+var summaryAfterUpdateHooks []SummaryHook
+
+var summaryBeforeDeleteMu sync.Mutex
+var summaryBeforeDeleteHooks []SummaryHook
+var summaryAfterDeleteMu sync.Mutex
+var summaryAfterDeleteHooks []SummaryHook
+
+var summaryBeforeUpsertMu sync.Mutex
+var summaryBeforeUpsertHooks []SummaryHook
+var summaryAfterUpsertMu sync.Mutex
+var summaryAfterUpsertHooks []SummaryHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Summary) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range summaryAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Summary) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range summaryBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Summary) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range summaryAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Summary) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range summaryBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Summary) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range summaryAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Summary) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range summaryBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Summary) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range summaryAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Summary) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range summaryBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Summary) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range summaryAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddSummaryHook registers your hook function for all future operations.
+func AddSummaryHook(hookPoint boil.HookPoint, summaryHook SummaryHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		summaryAfterSelectMu.Lock()
+		summaryAfterSelectHooks = append(summaryAfterSelectHooks, summaryHook)
+		summaryAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		summaryBeforeInsertMu.Lock()
+		summaryBeforeInsertHooks = append(summaryBeforeInsertHooks, summaryHook)
+		summaryBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		summaryAfterInsertMu.Lock()
+		summaryAfterInsertHooks = append(summaryAfterInsertHooks, summaryHook)
+		summaryAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		summaryBeforeUpdateMu.Lock()
+		summaryBeforeUpdateHooks = append(summaryBeforeUpdateHooks, summaryHook)
+		summaryBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		summaryAfterUpdateMu.Lock()
+		summaryAfterUpdateHooks = append(summaryAfterUpdateHooks, summaryHook)
+		summaryAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		summaryBeforeDeleteMu.Lock()
+		summaryBeforeDeleteHooks = append(summaryBeforeDeleteHooks, summaryHook)
+		summaryBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		summaryAfterDeleteMu.Lock()
+		summaryAfterDeleteHooks = append(summaryAfterDeleteHooks, summaryHook)
+		summaryAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		summaryBeforeUpsertMu.Lock()
+		summaryBeforeUpsertHooks = append(summaryBeforeUpsertHooks, summaryHook)
+		summaryBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		summaryAfterUpsertMu.Lock()
+		summaryAfterUpsertHooks = append(summaryAfterUpsertHooks, summaryHook)
+		summaryAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single summary record from the query.
+func (q summaryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Summary, error) {
+	o := &Summary{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for summaries")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Summary records from the query.
+func (q summaryQuery) All(ctx context.Context, exec boil.ContextExecutor) (SummarySlice, error) {
+	var o []*Summary
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Summary slice")
+	}
+
+	if len(summaryAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Summary records in the query.
+func (q summaryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count summaries rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q summaryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if summaries exists")
+	}
+
+	return count > 0, nil
+}
+
+// Summaries retrieves all the records using an executor.
+func Summaries(mods ...qm.QueryMod) summaryQuery {
+	mods = append(mods, qm.From("\"summaries\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"summaries\".*"})
+	}
+
+	return summaryQuery{q}
+}
+
+// FindSummary retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindSummary(ctx context.Context, exec boil.ContextExecutor, iD null.Int64, selectCols ...string) (*Summary, error) {
+	summaryObj := &Summary{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"summaries\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, summaryObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from summaries")
+	}
+
+	if err = summaryObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return summaryObj, err
+	}
+
+	return summaryObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Summary) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no summaries provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(summaryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	summaryInsertCacheMut.RLock()
+	cache, cached := summaryInsertCache[key]
+	summaryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			summaryAllColumns,
+			summaryColumnsWithDefault,
+			summaryColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, summaryGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(summaryType, summaryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(summaryType, summaryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"summaries\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"summaries\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into summaries")
+	}
+
+	if !cached {
+		summaryInsertCacheMut.Lock()
+		summaryInsertCache[key] = cache
+		summaryInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Summary.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Summary) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	summaryUpdateCacheMut.RLock()
+	cache, cached := summaryUpdateCache[key]
+	summaryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			summaryAllColumns,
+			summaryPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, summaryGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update summaries, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"summaries\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, summaryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(summaryType, summaryMapping, append(wl, summaryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update summaries row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for summaries")
+	}
+
+	if !cached {
+		summaryUpdateCacheMut.Lock()
+		summaryUpdateCache[key] = cache
+		summaryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q summaryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for summaries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for summaries")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o SummarySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), summaryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"summaries\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, summaryPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in summary slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all summary")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Summary) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no summaries provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(summaryColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	summaryUpsertCacheMut.RLock()
+	cache, cached := summaryUpsertCache[key]
+	summaryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			summaryAllColumns,
+			summaryColumnsWithDefault,
+			summaryColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			summaryAllColumns,
+			summaryPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert summaries, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(summaryAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(summaryPrimaryKeyColumns))
+			copy(conflict, summaryPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"summaries\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(summaryType, summaryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(summaryType, summaryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert summaries")
+	}
+
+	if !cached {
+		summaryUpsertCacheMut.Lock()
+		summaryUpsertCache[key] = cache
+		summaryUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Summary record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Summary) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Summary provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), summaryPrimaryKeyMapping)
+	sql := "DELETE FROM \"summaries\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from summaries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for summaries")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q summaryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no summaryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from summaries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for summaries")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o SummarySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(summaryBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), summaryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"summaries\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, summaryPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from summary slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for summaries")
+	}
+
+	if len(summaryAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Summary) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindSummary(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SummarySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := SummarySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), summaryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"summaries\".* FROM \"summaries\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, summaryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in SummarySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// SummaryExists checks if the Summary row exists.
+func SummaryExists(ctx context.Context, exec boil.ContextExecutor, iD null.Int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"summaries\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if summaries exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Summary row exists.
+func (o *Summary) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return SummaryExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testSummaries(t *testing.T) {
+	t.Parallel()
+
+	query := Summaries()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testSummariesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Summary{}
+	if err = randomize.Struct(seed, o, summaryDBTypes, true, summaryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Summary struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Summaries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSummariesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Summary{}
+	if err = randomize.Struct(seed, o, summaryDBTypes, true, summaryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Summary struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Summaries().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Summaries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSummariesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Summary{}
+	if err = randomize.Struct(seed, o, summaryDBTypes, true, summaryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Summary struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := SummarySlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Summaries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSummariesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Summary{}
+	if err = randomize.Struct(seed, o, summaryDBTypes, true, summaryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Summary struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := SummaryExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if Summary exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected SummaryExists to return true, but got false.")
+	}
+}
+
+func testSummariesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Summary{}
+	if err = randomize.Struct(seed, o, summaryDBTypes, true, summaryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Summary struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	summaryFound, err := FindSummary(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if summaryFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testSummariesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Summary{}
+	if err = randomize.Struct(seed, o, summaryDBTypes, true, summaryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Summary struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Summaries().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSummariesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Summary{}
+	if err = randomize.Struct(seed, o, summaryDBTypes, true, summaryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Summary struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Summaries().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testSummariesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	summaryOne := &Summary{}
+	summaryTwo := &Summary{}
+	if err = randomize.Struct(seed, summaryOne, summaryDBTypes, false, summaryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Summary struct: %s", err)
+	}
+	if err = randomize.Struct(seed, summaryTwo, summaryDBTypes, false, summaryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Summary struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = summaryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = summaryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Summaries().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testSummariesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	summaryOne := &Summary{}
+	summaryTwo := &Summary{}
+	if err = randomize.Struct(seed, summaryOne, summaryDBTypes, false, summaryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Summary struct: %s", err)
+	}
+	if err = randomize.Struct(seed, summaryTwo, summaryDBTypes, false, summaryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Summary struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = summaryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = summaryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Summaries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func summaryBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *Summary) error {
+	*o = Summary{}
+	return nil
+}
+
+func summaryAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *Summary) error {
+	*o = Summary{}
+	return nil
+}
+
+func summaryAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *Summary) error {
+	*o = Summary{}
+	return nil
+}
+
+func summaryBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Summary) error {
+	*o = Summary{}
+	return nil
+}
+
+func summaryAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Summary) error {
+	*o = Summary{}
+	return nil
+}
+
+func summaryBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Summary) error {
+	*o = Summary{}
+	return nil
+}
+
+func summaryAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Summary) error {
+	*o = Summary{}
+	return nil
+}
+
+func summaryBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Summary) error {
+	*o = Summary{}
+	return nil
+}
+
+func summaryAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Summary) error {
+	*o = Summary{}
+	return nil
+}
+
+func testSummariesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &Summary{}
+	o := &Summary{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, summaryDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize Summary object: %s", err)
+	}
+
+	AddSummaryHook(boil.BeforeInsertHook, summaryBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	summaryBeforeInsertHooks = []SummaryHook{}
+
+	AddSummaryHook(boil.AfterInsertHook, summaryAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	summaryAfterInsertHooks = []SummaryHook{}
+
+	AddSummaryHook(boil.AfterSelectHook, summaryAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	summaryAfterSelectHooks = []SummaryHook{}
+
+	AddSummaryHook(boil.BeforeUpdateHook, summaryBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	summaryBeforeUpdateHooks = []SummaryHook{}
+
+	AddSummaryHook(boil.AfterUpdateHook, summaryAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	summaryAfterUpdateHooks = []SummaryHook{}
+
+	AddSummaryHook(boil.BeforeDeleteHook, summaryBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	summaryBeforeDeleteHooks = []SummaryHook{}
+
+	AddSummaryHook(boil.AfterDeleteHook, summaryAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	summaryAfterDeleteHooks = []SummaryHook{}
+
+	AddSummaryHook(boil.BeforeUpsertHook, summaryBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	summaryBeforeUpsertHooks = []SummaryHook{}
+
+	AddSummaryHook(boil.AfterUpsertHook, summaryAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	summaryAfterUpsertHooks = []SummaryHook{}
+}
+
+func testSummariesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Summary{}
+	if err = randomize.Struct(seed, o, summaryDBTypes, true, summaryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Summary struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Summaries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testSummariesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Summary{}
+	if err = randomize.Struct(seed, o, summaryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Summary struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(summaryPrimaryKeyColumns, summaryColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Summaries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testSummariesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Summary{}
+	if err = randomize.Struct(seed, o, summaryDBTypes, true, summaryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Summary struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSummariesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Summary{}
+	if err = randomize.Struct(seed, o, summaryDBTypes, true, summaryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Summary struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := SummarySlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSummariesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Summary{}
+	if err = randomize.Struct(seed, o, summaryDBTypes, true, summaryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Summary struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Summaries().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	summaryDBTypes = map[string]string{`ID`: `INTEGER`, `Chat`: `TEXT`, `Kind`: `TEXT`, `Minute`: `INTEGER`, `NextRun`: `DATETIME`, `CreatedAt`: `DATETIME`}
+	_              = bytes.MinRead
+)
+
+func testSummariesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(summaryPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(summaryAllColumns) == len(summaryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Summary{}
+	if err = randomize.Struct(seed, o, summaryDBTypes, true, summaryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Summary struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Summaries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, summaryDBTypes, true, summaryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Summary struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testSummariesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(summaryAllColumns) == len(summaryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Summary{}
+	if err = randomize.Struct(seed, o, summaryDBTypes, true, summaryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Summary struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Summaries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, summaryDBTypes, true, summaryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Summary struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(summaryAllColumns, summaryPrimaryKeyColumns) {
+		fields = summaryAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			summaryAllColumns,
+			summaryPrimaryKeyColumns,
+		)
+		fields = strmangle.SetComplement(fields, summaryGeneratedColumns)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := SummarySlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testSummariesUpsert(t *testing.T) {
+	t.Parallel()
+	if len(summaryAllColumns) == len(summaryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := Summary{}
+	if err = randomize.Struct(seed, &o, summaryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Summary struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Summary: %s", err)
+	}
+
+	count, err := Summaries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, summaryDBTypes, false, summaryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Summary struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Summary: %s", err)
+	}
+
+	count, err = Summaries().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
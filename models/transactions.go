@@ -24,109 +24,91 @@ import (
 
 // Transaction is an object representing the database table.
 type Transaction struct {
-	ID          null.Int64  `boil:"id" json:"id,omitempty" toml:"id" yaml:"id,omitempty"`
-	Type        string      `boil:"type" json:"type" toml:"type" yaml:"type"`
-	Description null.String `boil:"description" json:"description,omitempty" toml:"description" yaml:"description,omitempty"`
-	Amount      int64       `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
-	CreatedAt   time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ID             null.Int64  `boil:"id" json:"id,omitempty" toml:"id" yaml:"id,omitempty"`
+	Type           string      `boil:"type" json:"type" toml:"type" yaml:"type"`
+	Description    null.String `boil:"description" json:"description,omitempty" toml:"description" yaml:"description,omitempty"`
+	Amount         int64       `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	CreatedAt      time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	CategoryID     null.Int64  `boil:"category_id" json:"category_id,omitempty" toml:"category_id" yaml:"category_id,omitempty"`
+	AccountID      int64       `boil:"account_id" json:"account_id" toml:"account_id" yaml:"account_id"`
+	BatchID        null.Int64  `boil:"batch_id" json:"batch_id,omitempty" toml:"batch_id" yaml:"batch_id,omitempty"`
+	Sender         null.String `boil:"sender" json:"sender,omitempty" toml:"sender" yaml:"sender,omitempty"`
+	SenderName     null.String `boil:"sender_name" json:"sender_name,omitempty" toml:"sender_name" yaml:"sender_name,omitempty"`
+	LedgerID       int64       `boil:"ledger_id" json:"ledger_id" toml:"ledger_id" yaml:"ledger_id"`
+	GoalID         null.Int64  `boil:"goal_id" json:"goal_id,omitempty" toml:"goal_id" yaml:"goal_id,omitempty"`
+	CounterpartyID null.Int64  `boil:"counterparty_id" json:"counterparty_id,omitempty" toml:"counterparty_id" yaml:"counterparty_id,omitempty"`
+	ReceiptID      null.Int64  `boil:"receipt_id" json:"receipt_id,omitempty" toml:"receipt_id" yaml:"receipt_id,omitempty"`
 
 	R *transactionR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L transactionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var TransactionColumns = struct {
-	ID          string
-	Type        string
-	Description string
-	Amount      string
-	CreatedAt   string
+	ID             string
+	Type           string
+	Description    string
+	Amount         string
+	CreatedAt      string
+	CategoryID     string
+	AccountID      string
+	BatchID        string
+	Sender         string
+	SenderName     string
+	LedgerID       string
+	GoalID         string
+	CounterpartyID string
+	ReceiptID      string
 }{
-	ID:          "id",
-	Type:        "type",
-	Description: "description",
-	Amount:      "amount",
-	CreatedAt:   "created_at",
+	ID:             "id",
+	Type:           "type",
+	Description:    "description",
+	Amount:         "amount",
+	CreatedAt:      "created_at",
+	CategoryID:     "category_id",
+	AccountID:      "account_id",
+	BatchID:        "batch_id",
+	Sender:         "sender",
+	SenderName:     "sender_name",
+	LedgerID:       "ledger_id",
+	GoalID:         "goal_id",
+	CounterpartyID: "counterparty_id",
+	ReceiptID:      "receipt_id",
 }
 
 var TransactionTableColumns = struct {
-	ID          string
-	Type        string
-	Description string
-	Amount      string
-	CreatedAt   string
+	ID             string
+	Type           string
+	Description    string
+	Amount         string
+	CreatedAt      string
+	CategoryID     string
+	AccountID      string
+	BatchID        string
+	Sender         string
+	SenderName     string
+	LedgerID       string
+	GoalID         string
+	CounterpartyID string
+	ReceiptID      string
 }{
-	ID:          "transactions.id",
-	Type:        "transactions.type",
-	Description: "transactions.description",
-	Amount:      "transactions.amount",
-	CreatedAt:   "transactions.created_at",
+	ID:             "transactions.id",
+	Type:           "transactions.type",
+	Description:    "transactions.description",
+	Amount:         "transactions.amount",
+	CreatedAt:      "transactions.created_at",
+	CategoryID:     "transactions.category_id",
+	AccountID:      "transactions.account_id",
+	BatchID:        "transactions.batch_id",
+	Sender:         "transactions.sender",
+	SenderName:     "transactions.sender_name",
+	LedgerID:       "transactions.ledger_id",
+	GoalID:         "transactions.goal_id",
+	CounterpartyID: "transactions.counterparty_id",
+	ReceiptID:      "transactions.receipt_id",
 }
 
 // Generated where
 
-type whereHelpernull_Int64 struct{ field string }
-
-func (w whereHelpernull_Int64) EQ(x null.Int64) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_Int64) NEQ(x null.Int64) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_Int64) LT(x null.Int64) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_Int64) LTE(x null.Int64) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_Int64) GT(x null.Int64) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_Int64) GTE(x null.Int64) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-func (w whereHelpernull_Int64) IN(slice []int64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelpernull_Int64) NIN(slice []int64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-func (w whereHelpernull_Int64) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Int64) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-
-type whereHelperstring struct{ field string }
-
-func (w whereHelperstring) EQ(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod   { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod   { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod   { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) LIKE(x string) qm.QueryMod  { return qm.Where(w.field+" LIKE ?", x) }
-func (w whereHelperstring) NLIKE(x string) qm.QueryMod { return qm.Where(w.field+" NOT LIKE ?", x) }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
 type whereHelpernull_String struct{ field string }
 
 func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
@@ -171,86 +153,194 @@ func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
 func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
 func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
-type whereHelperint64 struct{ field string }
+var TransactionWhere = struct {
+	ID             whereHelpernull_Int64
+	Type           whereHelperstring
+	Description    whereHelpernull_String
+	Amount         whereHelperint64
+	CreatedAt      whereHelpertime_Time
+	CategoryID     whereHelpernull_Int64
+	AccountID      whereHelperint64
+	BatchID        whereHelpernull_Int64
+	Sender         whereHelpernull_String
+	SenderName     whereHelpernull_String
+	LedgerID       whereHelperint64
+	GoalID         whereHelpernull_Int64
+	CounterpartyID whereHelpernull_Int64
+	ReceiptID      whereHelpernull_Int64
+}{
+	ID:             whereHelpernull_Int64{field: "\"transactions\".\"id\""},
+	Type:           whereHelperstring{field: "\"transactions\".\"type\""},
+	Description:    whereHelpernull_String{field: "\"transactions\".\"description\""},
+	Amount:         whereHelperint64{field: "\"transactions\".\"amount\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"transactions\".\"created_at\""},
+	CategoryID:     whereHelpernull_Int64{field: "\"transactions\".\"category_id\""},
+	AccountID:      whereHelperint64{field: "\"transactions\".\"account_id\""},
+	BatchID:        whereHelpernull_Int64{field: "\"transactions\".\"batch_id\""},
+	Sender:         whereHelpernull_String{field: "\"transactions\".\"sender\""},
+	SenderName:     whereHelpernull_String{field: "\"transactions\".\"sender_name\""},
+	LedgerID:       whereHelperint64{field: "\"transactions\".\"ledger_id\""},
+	GoalID:         whereHelpernull_Int64{field: "\"transactions\".\"goal_id\""},
+	CounterpartyID: whereHelpernull_Int64{field: "\"transactions\".\"counterparty_id\""},
+	ReceiptID:      whereHelpernull_Int64{field: "\"transactions\".\"receipt_id\""},
+}
 
-func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
+// TransactionRels is where relationship names are stored.
+var TransactionRels = struct {
+	Receipt      string
+	Counterparty string
+	Goal         string
+	Ledger       string
+	Batch        string
+	Account      string
+	Category     string
+}{
+	Receipt:      "Receipt",
+	Counterparty: "Counterparty",
+	Goal:         "Goal",
+	Ledger:       "Ledger",
+	Batch:        "Batch",
+	Account:      "Account",
+	Category:     "Category",
+}
+
+// transactionR is where relationships are stored.
+type transactionR struct {
+	Receipt      *Receipt      `boil:"Receipt" json:"Receipt" toml:"Receipt" yaml:"Receipt"`
+	Counterparty *Counterparty `boil:"Counterparty" json:"Counterparty" toml:"Counterparty" yaml:"Counterparty"`
+	Goal         *Goal         `boil:"Goal" json:"Goal" toml:"Goal" yaml:"Goal"`
+	Ledger       *Ledger       `boil:"Ledger" json:"Ledger" toml:"Ledger" yaml:"Ledger"`
+	Batch        *Batch        `boil:"Batch" json:"Batch" toml:"Batch" yaml:"Batch"`
+	Account      *Account      `boil:"Account" json:"Account" toml:"Account" yaml:"Account"`
+	Category     *Category     `boil:"Category" json:"Category" toml:"Category" yaml:"Category"`
+}
+
+// NewStruct creates a new relationship struct
+func (*transactionR) NewStruct() *transactionR {
+	return &transactionR{}
+}
+
+func (o *Transaction) GetReceipt() *Receipt {
+	if o == nil {
+		return nil
 	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+
+	return o.R.GetReceipt()
 }
-func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
+
+func (r *transactionR) GetReceipt() *Receipt {
+	if r == nil {
+		return nil
 	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+
+	return r.Receipt
 }
 
-type whereHelpertime_Time struct{ field string }
+func (o *Transaction) GetCounterparty() *Counterparty {
+	if o == nil {
+		return nil
+	}
 
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
+	return o.R.GetCounterparty()
 }
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+
+func (r *transactionR) GetCounterparty() *Counterparty {
+	if r == nil {
+		return nil
+	}
+
+	return r.Counterparty
 }
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
+
+func (o *Transaction) GetGoal() *Goal {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetGoal()
 }
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
+
+func (r *transactionR) GetGoal() *Goal {
+	if r == nil {
+		return nil
+	}
+
+	return r.Goal
 }
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
+
+func (o *Transaction) GetLedger() *Ledger {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetLedger()
 }
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
+
+func (r *transactionR) GetLedger() *Ledger {
+	if r == nil {
+		return nil
+	}
+
+	return r.Ledger
 }
 
-var TransactionWhere = struct {
-	ID          whereHelpernull_Int64
-	Type        whereHelperstring
-	Description whereHelpernull_String
-	Amount      whereHelperint64
-	CreatedAt   whereHelpertime_Time
-}{
-	ID:          whereHelpernull_Int64{field: "\"transactions\".\"id\""},
-	Type:        whereHelperstring{field: "\"transactions\".\"type\""},
-	Description: whereHelpernull_String{field: "\"transactions\".\"description\""},
-	Amount:      whereHelperint64{field: "\"transactions\".\"amount\""},
-	CreatedAt:   whereHelpertime_Time{field: "\"transactions\".\"created_at\""},
+func (o *Transaction) GetBatch() *Batch {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetBatch()
 }
 
-// TransactionRels is where relationship names are stored.
-var TransactionRels = struct {
-}{}
+func (r *transactionR) GetBatch() *Batch {
+	if r == nil {
+		return nil
+	}
 
-// transactionR is where relationships are stored.
-type transactionR struct {
+	return r.Batch
 }
 
-// NewStruct creates a new relationship struct
-func (*transactionR) NewStruct() *transactionR {
-	return &transactionR{}
+func (o *Transaction) GetAccount() *Account {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetAccount()
+}
+
+func (r *transactionR) GetAccount() *Account {
+	if r == nil {
+		return nil
+	}
+
+	return r.Account
+}
+
+func (o *Transaction) GetCategory() *Category {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCategory()
+}
+
+func (r *transactionR) GetCategory() *Category {
+	if r == nil {
+		return nil
+	}
+
+	return r.Category
 }
 
 // transactionL is where Load methods for each relationship are stored.
 type transactionL struct{}
 
 var (
-	transactionAllColumns            = []string{"id", "type", "description", "amount", "created_at"}
-	transactionColumnsWithoutDefault = []string{}
-	transactionColumnsWithDefault    = []string{"id", "type", "description", "amount", "created_at"}
+	transactionAllColumns            = []string{"id", "type", "description", "amount", "created_at", "category_id", "account_id", "batch_id", "sender", "sender_name", "ledger_id", "goal_id", "counterparty_id", "receipt_id"}
+	transactionColumnsWithoutDefault = []string{"type", "amount", "account_id", "ledger_id"}
+	transactionColumnsWithDefault    = []string{"id", "description", "created_at", "category_id", "batch_id", "sender", "sender_name", "goal_id", "counterparty_id", "receipt_id"}
 	transactionPrimaryKeyColumns     = []string{"id"}
-	transactionGeneratedColumns      = []string{}
+	transactionGeneratedColumns      = []string{"id"}
 )
 
 type (
@@ -550,12 +640,1451 @@ func (q transactionQuery) Exists(ctx context.Context, exec boil.ContextExecutor)
 	queries.SetCount(q.Query)
 	queries.SetLimit(q.Query, 1)
 
-	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
-	if err != nil {
-		return false, errors.Wrap(err, "models: failed to check if transactions exists")
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if transactions exists")
+	}
+
+	return count > 0, nil
+}
+
+// Receipt pointed to by the foreign key.
+func (o *Transaction) Receipt(mods ...qm.QueryMod) receiptQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ReceiptID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Receipts(queryMods...)
+}
+
+// Counterparty pointed to by the foreign key.
+func (o *Transaction) Counterparty(mods ...qm.QueryMod) counterpartyQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CounterpartyID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Counterparties(queryMods...)
+}
+
+// Goal pointed to by the foreign key.
+func (o *Transaction) Goal(mods ...qm.QueryMod) goalQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.GoalID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Goals(queryMods...)
+}
+
+// Ledger pointed to by the foreign key.
+func (o *Transaction) Ledger(mods ...qm.QueryMod) ledgerQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.LedgerID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Ledgers(queryMods...)
+}
+
+// Batch pointed to by the foreign key.
+func (o *Transaction) Batch(mods ...qm.QueryMod) batchQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.BatchID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Batches(queryMods...)
+}
+
+// Account pointed to by the foreign key.
+func (o *Transaction) Account(mods ...qm.QueryMod) accountQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AccountID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Accounts(queryMods...)
+}
+
+// Category pointed to by the foreign key.
+func (o *Transaction) Category(mods ...qm.QueryMod) categoryQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CategoryID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Categories(queryMods...)
+}
+
+// LoadReceipt allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (transactionL) LoadReceipt(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTransaction interface{}, mods queries.Applicator) error {
+	var slice []*Transaction
+	var object *Transaction
+
+	if singular {
+		var ok bool
+		object, ok = maybeTransaction.(*Transaction)
+		if !ok {
+			object = new(Transaction)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTransaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTransaction))
+			}
+		}
+	} else {
+		s, ok := maybeTransaction.(*[]*Transaction)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTransaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTransaction))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &transactionR{}
+		}
+		if !queries.IsNil(object.ReceiptID) {
+			args[object.ReceiptID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &transactionR{}
+			}
+
+			if !queries.IsNil(obj.ReceiptID) {
+				args[obj.ReceiptID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`receipts`),
+		qm.WhereIn(`receipts.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Receipt")
+	}
+
+	var resultSlice []*Receipt
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Receipt")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for receipts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for receipts")
+	}
+
+	if len(receiptAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Receipt = foreign
+		if foreign.R == nil {
+			foreign.R = &receiptR{}
+		}
+		foreign.R.Transactions = append(foreign.R.Transactions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ReceiptID, foreign.ID) {
+				local.R.Receipt = foreign
+				if foreign.R == nil {
+					foreign.R = &receiptR{}
+				}
+				foreign.R.Transactions = append(foreign.R.Transactions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCounterparty allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (transactionL) LoadCounterparty(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTransaction interface{}, mods queries.Applicator) error {
+	var slice []*Transaction
+	var object *Transaction
+
+	if singular {
+		var ok bool
+		object, ok = maybeTransaction.(*Transaction)
+		if !ok {
+			object = new(Transaction)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTransaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTransaction))
+			}
+		}
+	} else {
+		s, ok := maybeTransaction.(*[]*Transaction)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTransaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTransaction))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &transactionR{}
+		}
+		if !queries.IsNil(object.CounterpartyID) {
+			args[object.CounterpartyID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &transactionR{}
+			}
+
+			if !queries.IsNil(obj.CounterpartyID) {
+				args[obj.CounterpartyID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`counterparties`),
+		qm.WhereIn(`counterparties.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Counterparty")
+	}
+
+	var resultSlice []*Counterparty
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Counterparty")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for counterparties")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for counterparties")
+	}
+
+	if len(counterpartyAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Counterparty = foreign
+		if foreign.R == nil {
+			foreign.R = &counterpartyR{}
+		}
+		foreign.R.Transactions = append(foreign.R.Transactions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.CounterpartyID, foreign.ID) {
+				local.R.Counterparty = foreign
+				if foreign.R == nil {
+					foreign.R = &counterpartyR{}
+				}
+				foreign.R.Transactions = append(foreign.R.Transactions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadGoal allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (transactionL) LoadGoal(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTransaction interface{}, mods queries.Applicator) error {
+	var slice []*Transaction
+	var object *Transaction
+
+	if singular {
+		var ok bool
+		object, ok = maybeTransaction.(*Transaction)
+		if !ok {
+			object = new(Transaction)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTransaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTransaction))
+			}
+		}
+	} else {
+		s, ok := maybeTransaction.(*[]*Transaction)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTransaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTransaction))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &transactionR{}
+		}
+		if !queries.IsNil(object.GoalID) {
+			args[object.GoalID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &transactionR{}
+			}
+
+			if !queries.IsNil(obj.GoalID) {
+				args[obj.GoalID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`goals`),
+		qm.WhereIn(`goals.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Goal")
+	}
+
+	var resultSlice []*Goal
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Goal")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for goals")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for goals")
+	}
+
+	if len(goalAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Goal = foreign
+		if foreign.R == nil {
+			foreign.R = &goalR{}
+		}
+		foreign.R.Transactions = append(foreign.R.Transactions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.GoalID, foreign.ID) {
+				local.R.Goal = foreign
+				if foreign.R == nil {
+					foreign.R = &goalR{}
+				}
+				foreign.R.Transactions = append(foreign.R.Transactions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadLedger allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (transactionL) LoadLedger(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTransaction interface{}, mods queries.Applicator) error {
+	var slice []*Transaction
+	var object *Transaction
+
+	if singular {
+		var ok bool
+		object, ok = maybeTransaction.(*Transaction)
+		if !ok {
+			object = new(Transaction)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTransaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTransaction))
+			}
+		}
+	} else {
+		s, ok := maybeTransaction.(*[]*Transaction)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTransaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTransaction))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &transactionR{}
+		}
+		if !queries.IsNil(object.LedgerID) {
+			args[object.LedgerID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &transactionR{}
+			}
+
+			if !queries.IsNil(obj.LedgerID) {
+				args[obj.LedgerID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`ledgers`),
+		qm.WhereIn(`ledgers.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Ledger")
+	}
+
+	var resultSlice []*Ledger
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Ledger")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for ledgers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for ledgers")
+	}
+
+	if len(ledgerAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Ledger = foreign
+		if foreign.R == nil {
+			foreign.R = &ledgerR{}
+		}
+		foreign.R.Transactions = append(foreign.R.Transactions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.LedgerID, foreign.ID) {
+				local.R.Ledger = foreign
+				if foreign.R == nil {
+					foreign.R = &ledgerR{}
+				}
+				foreign.R.Transactions = append(foreign.R.Transactions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadBatch allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (transactionL) LoadBatch(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTransaction interface{}, mods queries.Applicator) error {
+	var slice []*Transaction
+	var object *Transaction
+
+	if singular {
+		var ok bool
+		object, ok = maybeTransaction.(*Transaction)
+		if !ok {
+			object = new(Transaction)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTransaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTransaction))
+			}
+		}
+	} else {
+		s, ok := maybeTransaction.(*[]*Transaction)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTransaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTransaction))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &transactionR{}
+		}
+		if !queries.IsNil(object.BatchID) {
+			args[object.BatchID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &transactionR{}
+			}
+
+			if !queries.IsNil(obj.BatchID) {
+				args[obj.BatchID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`batches`),
+		qm.WhereIn(`batches.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Batch")
+	}
+
+	var resultSlice []*Batch
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Batch")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for batches")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for batches")
+	}
+
+	if len(batchAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Batch = foreign
+		if foreign.R == nil {
+			foreign.R = &batchR{}
+		}
+		foreign.R.Transactions = append(foreign.R.Transactions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.BatchID, foreign.ID) {
+				local.R.Batch = foreign
+				if foreign.R == nil {
+					foreign.R = &batchR{}
+				}
+				foreign.R.Transactions = append(foreign.R.Transactions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAccount allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (transactionL) LoadAccount(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTransaction interface{}, mods queries.Applicator) error {
+	var slice []*Transaction
+	var object *Transaction
+
+	if singular {
+		var ok bool
+		object, ok = maybeTransaction.(*Transaction)
+		if !ok {
+			object = new(Transaction)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTransaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTransaction))
+			}
+		}
+	} else {
+		s, ok := maybeTransaction.(*[]*Transaction)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTransaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTransaction))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &transactionR{}
+		}
+		if !queries.IsNil(object.AccountID) {
+			args[object.AccountID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &transactionR{}
+			}
+
+			if !queries.IsNil(obj.AccountID) {
+				args[obj.AccountID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`accounts`),
+		qm.WhereIn(`accounts.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Account")
+	}
+
+	var resultSlice []*Account
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Account")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for accounts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for accounts")
+	}
+
+	if len(accountAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Account = foreign
+		if foreign.R == nil {
+			foreign.R = &accountR{}
+		}
+		foreign.R.Transactions = append(foreign.R.Transactions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.AccountID, foreign.ID) {
+				local.R.Account = foreign
+				if foreign.R == nil {
+					foreign.R = &accountR{}
+				}
+				foreign.R.Transactions = append(foreign.R.Transactions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCategory allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (transactionL) LoadCategory(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTransaction interface{}, mods queries.Applicator) error {
+	var slice []*Transaction
+	var object *Transaction
+
+	if singular {
+		var ok bool
+		object, ok = maybeTransaction.(*Transaction)
+		if !ok {
+			object = new(Transaction)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTransaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTransaction))
+			}
+		}
+	} else {
+		s, ok := maybeTransaction.(*[]*Transaction)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTransaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTransaction))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &transactionR{}
+		}
+		if !queries.IsNil(object.CategoryID) {
+			args[object.CategoryID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &transactionR{}
+			}
+
+			if !queries.IsNil(obj.CategoryID) {
+				args[obj.CategoryID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`categories`),
+		qm.WhereIn(`categories.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Category")
+	}
+
+	var resultSlice []*Category
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Category")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for categories")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for categories")
+	}
+
+	if len(categoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Category = foreign
+		if foreign.R == nil {
+			foreign.R = &categoryR{}
+		}
+		foreign.R.Transactions = append(foreign.R.Transactions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.CategoryID, foreign.ID) {
+				local.R.Category = foreign
+				if foreign.R == nil {
+					foreign.R = &categoryR{}
+				}
+				foreign.R.Transactions = append(foreign.R.Transactions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetReceipt of the transaction to the related item.
+// Sets o.R.Receipt to related.
+// Adds o to related.R.Transactions.
+func (o *Transaction) SetReceipt(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Receipt) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"transactions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"receipt_id"}),
+		strmangle.WhereClause("\"", "\"", 0, transactionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ReceiptID, related.ID)
+	if o.R == nil {
+		o.R = &transactionR{
+			Receipt: related,
+		}
+	} else {
+		o.R.Receipt = related
+	}
+
+	if related.R == nil {
+		related.R = &receiptR{
+			Transactions: TransactionSlice{o},
+		}
+	} else {
+		related.R.Transactions = append(related.R.Transactions, o)
+	}
+
+	return nil
+}
+
+// RemoveReceipt relationship.
+// Sets o.R.Receipt to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Transaction) RemoveReceipt(ctx context.Context, exec boil.ContextExecutor, related *Receipt) error {
+	var err error
+
+	queries.SetScanner(&o.ReceiptID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("receipt_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Receipt = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Transactions {
+		if queries.Equal(o.ReceiptID, ri.ReceiptID) {
+			continue
+		}
+
+		ln := len(related.R.Transactions)
+		if ln > 1 && i < ln-1 {
+			related.R.Transactions[i] = related.R.Transactions[ln-1]
+		}
+		related.R.Transactions = related.R.Transactions[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetCounterparty of the transaction to the related item.
+// Sets o.R.Counterparty to related.
+// Adds o to related.R.Transactions.
+func (o *Transaction) SetCounterparty(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Counterparty) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"transactions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"counterparty_id"}),
+		strmangle.WhereClause("\"", "\"", 0, transactionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.CounterpartyID, related.ID)
+	if o.R == nil {
+		o.R = &transactionR{
+			Counterparty: related,
+		}
+	} else {
+		o.R.Counterparty = related
+	}
+
+	if related.R == nil {
+		related.R = &counterpartyR{
+			Transactions: TransactionSlice{o},
+		}
+	} else {
+		related.R.Transactions = append(related.R.Transactions, o)
+	}
+
+	return nil
+}
+
+// RemoveCounterparty relationship.
+// Sets o.R.Counterparty to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Transaction) RemoveCounterparty(ctx context.Context, exec boil.ContextExecutor, related *Counterparty) error {
+	var err error
+
+	queries.SetScanner(&o.CounterpartyID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("counterparty_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Counterparty = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Transactions {
+		if queries.Equal(o.CounterpartyID, ri.CounterpartyID) {
+			continue
+		}
+
+		ln := len(related.R.Transactions)
+		if ln > 1 && i < ln-1 {
+			related.R.Transactions[i] = related.R.Transactions[ln-1]
+		}
+		related.R.Transactions = related.R.Transactions[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetGoal of the transaction to the related item.
+// Sets o.R.Goal to related.
+// Adds o to related.R.Transactions.
+func (o *Transaction) SetGoal(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Goal) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"transactions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"goal_id"}),
+		strmangle.WhereClause("\"", "\"", 0, transactionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.GoalID, related.ID)
+	if o.R == nil {
+		o.R = &transactionR{
+			Goal: related,
+		}
+	} else {
+		o.R.Goal = related
+	}
+
+	if related.R == nil {
+		related.R = &goalR{
+			Transactions: TransactionSlice{o},
+		}
+	} else {
+		related.R.Transactions = append(related.R.Transactions, o)
+	}
+
+	return nil
+}
+
+// RemoveGoal relationship.
+// Sets o.R.Goal to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Transaction) RemoveGoal(ctx context.Context, exec boil.ContextExecutor, related *Goal) error {
+	var err error
+
+	queries.SetScanner(&o.GoalID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("goal_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Goal = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Transactions {
+		if queries.Equal(o.GoalID, ri.GoalID) {
+			continue
+		}
+
+		ln := len(related.R.Transactions)
+		if ln > 1 && i < ln-1 {
+			related.R.Transactions[i] = related.R.Transactions[ln-1]
+		}
+		related.R.Transactions = related.R.Transactions[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetLedger of the transaction to the related item.
+// Sets o.R.Ledger to related.
+// Adds o to related.R.Transactions.
+func (o *Transaction) SetLedger(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Ledger) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"transactions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"ledger_id"}),
+		strmangle.WhereClause("\"", "\"", 0, transactionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.LedgerID, related.ID)
+	if o.R == nil {
+		o.R = &transactionR{
+			Ledger: related,
+		}
+	} else {
+		o.R.Ledger = related
+	}
+
+	if related.R == nil {
+		related.R = &ledgerR{
+			Transactions: TransactionSlice{o},
+		}
+	} else {
+		related.R.Transactions = append(related.R.Transactions, o)
+	}
+
+	return nil
+}
+
+// SetBatch of the transaction to the related item.
+// Sets o.R.Batch to related.
+// Adds o to related.R.Transactions.
+func (o *Transaction) SetBatch(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Batch) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"transactions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"batch_id"}),
+		strmangle.WhereClause("\"", "\"", 0, transactionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.BatchID, related.ID)
+	if o.R == nil {
+		o.R = &transactionR{
+			Batch: related,
+		}
+	} else {
+		o.R.Batch = related
+	}
+
+	if related.R == nil {
+		related.R = &batchR{
+			Transactions: TransactionSlice{o},
+		}
+	} else {
+		related.R.Transactions = append(related.R.Transactions, o)
+	}
+
+	return nil
+}
+
+// RemoveBatch relationship.
+// Sets o.R.Batch to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Transaction) RemoveBatch(ctx context.Context, exec boil.ContextExecutor, related *Batch) error {
+	var err error
+
+	queries.SetScanner(&o.BatchID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("batch_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Batch = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Transactions {
+		if queries.Equal(o.BatchID, ri.BatchID) {
+			continue
+		}
+
+		ln := len(related.R.Transactions)
+		if ln > 1 && i < ln-1 {
+			related.R.Transactions[i] = related.R.Transactions[ln-1]
+		}
+		related.R.Transactions = related.R.Transactions[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetAccount of the transaction to the related item.
+// Sets o.R.Account to related.
+// Adds o to related.R.Transactions.
+func (o *Transaction) SetAccount(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Account) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"transactions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"account_id"}),
+		strmangle.WhereClause("\"", "\"", 0, transactionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.AccountID, related.ID)
+	if o.R == nil {
+		o.R = &transactionR{
+			Account: related,
+		}
+	} else {
+		o.R.Account = related
+	}
+
+	if related.R == nil {
+		related.R = &accountR{
+			Transactions: TransactionSlice{o},
+		}
+	} else {
+		related.R.Transactions = append(related.R.Transactions, o)
+	}
+
+	return nil
+}
+
+// SetCategory of the transaction to the related item.
+// Sets o.R.Category to related.
+// Adds o to related.R.Transactions.
+func (o *Transaction) SetCategory(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Category) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"transactions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"category_id"}),
+		strmangle.WhereClause("\"", "\"", 0, transactionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.CategoryID, related.ID)
+	if o.R == nil {
+		o.R = &transactionR{
+			Category: related,
+		}
+	} else {
+		o.R.Category = related
+	}
+
+	if related.R == nil {
+		related.R = &categoryR{
+			Transactions: TransactionSlice{o},
+		}
+	} else {
+		related.R.Transactions = append(related.R.Transactions, o)
+	}
+
+	return nil
+}
+
+// RemoveCategory relationship.
+// Sets o.R.Category to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Transaction) RemoveCategory(ctx context.Context, exec boil.ContextExecutor, related *Category) error {
+	var err error
+
+	queries.SetScanner(&o.CategoryID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("category_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
 	}
 
-	return count > 0, nil
+	if o.R != nil {
+		o.R.Category = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Transactions {
+		if queries.Equal(o.CategoryID, ri.CategoryID) {
+			continue
+		}
+
+		ln := len(related.R.Transactions)
+		if ln > 1 && i < ln-1 {
+			related.R.Transactions[i] = related.R.Transactions[ln-1]
+		}
+		related.R.Transactions = related.R.Transactions[:ln-1]
+		break
+	}
+	return nil
 }
 
 // Transactions retrieves all the records using an executor.
@@ -633,6 +2162,7 @@ func (o *Transaction) Insert(ctx context.Context, exec boil.ContextExecutor, col
 			transactionColumnsWithoutDefault,
 			nzDefaults,
 		)
+		wl = strmangle.SetComplement(wl, transactionGeneratedColumns)
 
 		cache.valueMapping, err = queries.BindMapping(transactionType, transactionMapping, wl)
 		if err != nil {
@@ -703,6 +2233,7 @@ func (o *Transaction) Update(ctx context.Context, exec boil.ContextExecutor, col
 			transactionAllColumns,
 			transactionPrimaryKeyColumns,
 		)
+		wl = strmangle.SetComplement(wl, transactionGeneratedColumns)
 
 		if !columns.IsWhitelist() {
 			wl = strmangle.SetComplement(wl, []string{"created_at"})
@@ -813,6 +2344,130 @@ func (o TransactionSlice) UpdateAll(ctx context.Context, exec boil.ContextExecut
 	return rowsAff, nil
 }
 
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Transaction) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no transactions provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(transactionColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	transactionUpsertCacheMut.RLock()
+	cache, cached := transactionUpsertCache[key]
+	transactionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			transactionAllColumns,
+			transactionColumnsWithDefault,
+			transactionColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			transactionAllColumns,
+			transactionPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert transactions, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(transactionAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(transactionPrimaryKeyColumns))
+			copy(conflict, transactionPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"transactions\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(transactionType, transactionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(transactionType, transactionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert transactions")
+	}
+
+	if !cached {
+		transactionUpsertCacheMut.Lock()
+		transactionUpsertCache[key] = cache
+		transactionUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
 // Delete deletes a single Transaction record with an executor.
 // Delete will match against the primary key column to find the record to delete.
 func (o *Transaction) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
@@ -984,125 +2639,3 @@ func TransactionExists(ctx context.Context, exec boil.ContextExecutor, iD null.I
 func (o *Transaction) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
 	return TransactionExists(ctx, exec, o.ID)
 }
-
-// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
-// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
-func (o *Transaction) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
-	if o == nil {
-		return errors.New("models: no transactions provided for upsert")
-	}
-	if !boil.TimestampsAreSkipped(ctx) {
-		currTime := time.Now().In(boil.GetLocation())
-
-		if o.CreatedAt.IsZero() {
-			o.CreatedAt = currTime
-		}
-	}
-
-	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
-		return err
-	}
-
-	nzDefaults := queries.NonZeroDefaultSet(transactionColumnsWithDefault, o)
-
-	// Build cache key in-line uglily - mysql vs psql problems
-	buf := strmangle.GetBuffer()
-	if updateOnConflict {
-		buf.WriteByte('t')
-	} else {
-		buf.WriteByte('f')
-	}
-	buf.WriteByte('.')
-	for _, c := range conflictColumns {
-		buf.WriteString(c)
-	}
-	buf.WriteByte('.')
-	buf.WriteString(strconv.Itoa(updateColumns.Kind))
-	for _, c := range updateColumns.Cols {
-		buf.WriteString(c)
-	}
-	buf.WriteByte('.')
-	buf.WriteString(strconv.Itoa(insertColumns.Kind))
-	for _, c := range insertColumns.Cols {
-		buf.WriteString(c)
-	}
-	buf.WriteByte('.')
-	for _, c := range nzDefaults {
-		buf.WriteString(c)
-	}
-	key := buf.String()
-	strmangle.PutBuffer(buf)
-
-	transactionUpsertCacheMut.RLock()
-	cache, cached := transactionUpsertCache[key]
-	transactionUpsertCacheMut.RUnlock()
-
-	var err error
-
-	if !cached {
-		insert, ret := insertColumns.InsertColumnSet(
-			transactionAllColumns,
-			transactionColumnsWithDefault,
-			transactionColumnsWithoutDefault,
-			nzDefaults,
-		)
-		update := updateColumns.UpdateColumnSet(
-			transactionAllColumns,
-			transactionPrimaryKeyColumns,
-		)
-
-		if updateOnConflict && len(update) == 0 {
-			return errors.New("models: unable to upsert transactions, could not build update column list")
-		}
-
-		conflict := conflictColumns
-		if len(conflict) == 0 {
-			conflict = make([]string, len(transactionPrimaryKeyColumns))
-			copy(conflict, transactionPrimaryKeyColumns)
-		}
-		cache.query = buildUpsertQuerySQLite(dialect, "\"transactions\"", updateOnConflict, ret, update, conflict, insert)
-
-		cache.valueMapping, err = queries.BindMapping(transactionType, transactionMapping, insert)
-		if err != nil {
-			return err
-		}
-		if len(ret) != 0 {
-			cache.retMapping, err = queries.BindMapping(transactionType, transactionMapping, ret)
-			if err != nil {
-				return err
-			}
-		}
-	}
-
-	value := reflect.Indirect(reflect.ValueOf(o))
-	vals := queries.ValuesFromMapping(value, cache.valueMapping)
-	var returns []interface{}
-	if len(cache.retMapping) != 0 {
-		returns = queries.PtrsFromMapping(value, cache.retMapping)
-	}
-
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, cache.query)
-		fmt.Fprintln(writer, vals)
-	}
-	if len(cache.retMapping) != 0 {
-		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
-		if err == sql.ErrNoRows {
-			err = nil // Postgres doesn't return anything when there's no update
-		}
-	} else {
-		_, err = exec.ExecContext(ctx, cache.query, vals...)
-	}
-	if err != nil {
-		return errors.Wrap(err, "models: unable to upsert transactions")
-	}
-
-	if !cached {
-		transactionUpsertCacheMut.Lock()
-		transactionUpsertCache[key] = cache
-		transactionUpsertCacheMut.Unlock()
-	}
-
-	return o.doAfterUpsertHooks(ctx, exec)
-}